@@ -53,3 +53,93 @@ func (t *RUNNER_TYPE) UnmarshalJSON(b []byte) error {
 	*t = ins
 	return nil
 }
+
+// ParsePARAM_VALUE_TYPEFromString Parse PARAM_VALUE_TYPE from string
+func ParsePARAM_VALUE_TYPEFromString(str string) (PARAM_VALUE_TYPE, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := PARAM_VALUE_TYPE_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown PARAM_VALUE_TYPE: %s", str)
+	}
+
+	return PARAM_VALUE_TYPE(v), nil
+}
+
+// Equal type compare
+func (t PARAM_VALUE_TYPE) Equal(target PARAM_VALUE_TYPE) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t PARAM_VALUE_TYPE) IsIn(targets ...PARAM_VALUE_TYPE) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t PARAM_VALUE_TYPE) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *PARAM_VALUE_TYPE) UnmarshalJSON(b []byte) error {
+	ins, err := ParsePARAM_VALUE_TYPEFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
+
+// ParseLIFECYCLEFromString Parse LIFECYCLE from string
+func ParseLIFECYCLEFromString(str string) (LIFECYCLE, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := LIFECYCLE_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown LIFECYCLE: %s", str)
+	}
+
+	return LIFECYCLE(v), nil
+}
+
+// Equal type compare
+func (t LIFECYCLE) Equal(target LIFECYCLE) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t LIFECYCLE) IsIn(targets ...LIFECYCLE) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t LIFECYCLE) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *LIFECYCLE) UnmarshalJSON(b []byte) error {
+	ins, err := ParseLIFECYCLEFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/infraboard/keyauth/app/token"
 	"github.com/infraboard/mcube/http/request"
+	"github.com/infraboard/mcube/pb/resource"
 	"github.com/rs/xid"
 )
 
@@ -29,7 +30,10 @@ func NewQueryActionRequest(page *request.PageRequest) *QueryActionRequest {
 }
 
 func (req *CreateActionRequest) Validate() error {
-	return validate.Struct(req)
+	if err := validate.Struct(req); err != nil {
+		return err
+	}
+	return validateRunParamDesc(req.RunParams)
 }
 
 func (req *CreateActionRequest) UpdateOwner(tk *token.Token) {
@@ -119,25 +123,6 @@ func (a *Action) RunnerParam() map[string]string {
 	return param
 }
 
-// ValidateParam 按照action的定义, 检查必传参数是否传人
-func (a *Action) ValidateRunParam(params map[string]string) error {
-	msg := []string{}
-	for i := range a.RunParams {
-		param := a.RunParams[i]
-		if param.Required {
-			if pv, ok := params[param.KeyName]; !ok || pv == "" {
-				msg = append(msg, "required param "+param.KeyName)
-			}
-		}
-	}
-
-	if len(msg) > 0 {
-		return fmt.Errorf("validate run params error, %s", strings.Join(msg, ","))
-	}
-
-	return nil
-}
-
 func (a *Action) Validate() error {
 	return validate.Struct(a)
 }
@@ -147,6 +132,44 @@ func (a *Action) Update(req *UpdateActionRequest) {
 	a.RunParams = req.RunParams
 	a.Tags = req.Tags
 	a.Description = req.Description
+	a.Lifecycle = req.Lifecycle
+	a.DeprecationMessage = req.DeprecationMessage
+}
+
+// IsDeprecated 已废弃的版本可以使用, 但是需要提醒用户升级
+func (a *Action) IsDeprecated() bool {
+	return a.Lifecycle == LIFECYCLE_DEPRECATED
+}
+
+// IsRetired 已下线的版本不能再用于创建pipeline
+func (a *Action) IsRetired() bool {
+	return a.Lifecycle == LIFECYCLE_RETIRED
+}
+
+// DeprecationWarning 使用已废弃版本时的提示
+func (a *Action) DeprecationWarning() string {
+	msg := fmt.Sprintf("action %s is deprecated", a.Key())
+	if a.DeprecationMessage != "" {
+		msg += ", " + a.DeprecationMessage
+	}
+	return msg
+}
+
+// IsNewerThan 按照语义化版本比较, 版本号不合法时按照创建时间比较
+func (a *Action) IsNewerThan(target *Action) bool {
+	av, aErr := ParseVersion(a.Version)
+	tv, tErr := ParseVersion(target.Version)
+	switch {
+	case aErr == nil && tErr == nil:
+		if r := av.Compare(tv); r != 0 {
+			return r > 0
+		}
+	case aErr == nil:
+		return true
+	case tErr == nil:
+		return false
+	}
+	return a.CreateAt > target.CreateAt
 }
 
 func (a *Action) Key() string {
@@ -170,6 +193,149 @@ func (s *ActionSet) Add(item *Action) {
 	s.Items = append(s.Items, item)
 }
 
+// Latest 同名action的最新版本, 已下线的版本不作为最新版本
+// 优先选择正式版本, 其次是正常使用的版本, 同样条件下选择版本号最高的
+func (s *ActionSet) Latest() *Action {
+	var latest *Action
+	for _, a := range s.Items {
+		if a.IsRetired() {
+			continue
+		}
+		if latest == nil {
+			latest = a
+			continue
+		}
+		if r := a.latestRank() - latest.latestRank(); r != 0 {
+			if r > 0 {
+				latest = a
+			}
+			continue
+		}
+		if a.IsNewerThan(latest) {
+			latest = a
+		}
+	}
+	return latest
+}
+
+// LatestGroup 分组计算最新版本, 全局可见的版本为一组, 其他版本按空间分组, 每组一个最新版本
+// 空间内可见版本的最新版本即为该空间最新版本和全局最新版本中更新的一个
+func (s *ActionSet) LatestGroup() *ActionSet {
+	groups := map[string]*ActionSet{}
+	keys := []string{}
+	for _, a := range s.Items {
+		k := a.latestGroup()
+		if _, ok := groups[k]; !ok {
+			groups[k] = NewActionSet()
+			keys = append(keys, k)
+		}
+		groups[k].Add(a)
+	}
+
+	set := NewActionSet()
+	for _, k := range keys {
+		if latest := groups[k].Latest(); latest != nil {
+			set.Add(latest)
+		}
+	}
+	return set
+}
+
+// Contains 是否包含指定id的action
+func (s *ActionSet) Contains(id string) bool {
+	for _, a := range s.Items {
+		if a.Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Action) latestGroup() string {
+	if a.VisiableMode == resource.VisiableMode_GLOBAL {
+		return "global"
+	}
+	return "namespace." + a.Namespace
+}
+
+func (a *Action) latestRank() int {
+	rank := 0
+	if v, err := ParseVersion(a.Version); err != nil || v.Pre == "" {
+		rank += 2
+	}
+	if !a.IsDeprecated() {
+		rank++
+	}
+	return rank
+}
+
+// Resolve 从同名action的所有版本中找到引用的版本
+// version为空或者latest时使用最新版本, 和版本完全一致时直接使用, 否则按照版本范围选择最高的版本
+func (s *ActionSet) Resolve(version string) (*Action, error) {
+	var target *Action
+	switch version {
+	case "", LATEST_VERSION:
+		// 空间内的最新版本和全局的最新版本分别标记, 取两者中更新的版本
+		flagged := NewActionSet()
+		for _, a := range s.Items {
+			if a.IsLatest {
+				flagged.Add(a)
+			}
+		}
+		if target = flagged.Latest(); target == nil {
+			target = s.Latest()
+		}
+	default:
+		for _, a := range s.Items {
+			if a.Version == version {
+				target = a
+				break
+			}
+		}
+		if target == nil {
+			var err error
+			if target, err = s.resolveConstraint(version); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if target == nil {
+		return nil, fmt.Errorf("no action version match %s", version)
+	}
+	if target.IsRetired() {
+		return nil, fmt.Errorf("action %s is retired", target.Key())
+	}
+	return target, nil
+}
+
+// 已下线的版本不参与选择, 只有已下线的版本满足时返回已下线的版本
+func (s *ActionSet) resolveConstraint(version string) (*Action, error) {
+	c, err := ParseConstraint(version)
+	if err != nil {
+		return nil, err
+	}
+
+	var target, retired *Action
+	for _, a := range s.Items {
+		v, err := ParseVersion(a.Version)
+		if err != nil || !c.Check(v) {
+			continue
+		}
+		if a.IsRetired() {
+			retired = a
+			continue
+		}
+		if target == nil || a.IsNewerThan(target) {
+			target = a
+		}
+	}
+	if target == nil {
+		return retired, nil
+	}
+	return target, nil
+}
+
 // NewQueryActionRequest 查询book列表
 func NewDescribeActionRequest(name, version string) *DescribeActionRequest {
 	return &DescribeActionRequest{
@@ -209,5 +375,19 @@ func NewUpdateActionRequest() *UpdateActionRequest {
 }
 
 func (req *UpdateActionRequest) Validate() error {
+	if err := validate.Struct(req); err != nil {
+		return err
+	}
+	return validateRunParamDesc(req.RunParams)
+}
+
+func NewResolveActionRequest(namespace, key string) *ResolveActionRequest {
+	return &ResolveActionRequest{
+		Namespace: namespace,
+		Key:       key,
+	}
+}
+
+func (req *ResolveActionRequest) Validate() error {
 	return validate.Struct(req)
 }
@@ -27,6 +27,7 @@ type ServiceClient interface {
 	DescribeAction(ctx context.Context, in *DescribeActionRequest, opts ...grpc.CallOption) (*Action, error)
 	UpdateAction(ctx context.Context, in *UpdateActionRequest, opts ...grpc.CallOption) (*Action, error)
 	DeleteAction(ctx context.Context, in *DeleteActionRequest, opts ...grpc.CallOption) (*Action, error)
+	ResolveAction(ctx context.Context, in *ResolveActionRequest, opts ...grpc.CallOption) (*Action, error)
 }
 
 type serviceClient struct {
@@ -82,6 +83,15 @@ func (c *serviceClient) DeleteAction(ctx context.Context, in *DeleteActionReques
 	return out, nil
 }
 
+func (c *serviceClient) ResolveAction(ctx context.Context, in *ResolveActionRequest, opts ...grpc.CallOption) (*Action, error) {
+	out := new(Action)
+	err := c.cc.Invoke(ctx, "/infraboard.workflow.action.Service/ResolveAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -91,6 +101,7 @@ type ServiceServer interface {
 	DescribeAction(context.Context, *DescribeActionRequest) (*Action, error)
 	UpdateAction(context.Context, *UpdateActionRequest) (*Action, error)
 	DeleteAction(context.Context, *DeleteActionRequest) (*Action, error)
+	ResolveAction(context.Context, *ResolveActionRequest) (*Action, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -113,6 +124,9 @@ func (UnimplementedServiceServer) UpdateAction(context.Context, *UpdateActionReq
 func (UnimplementedServiceServer) DeleteAction(context.Context, *DeleteActionRequest) (*Action, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAction not implemented")
 }
+func (UnimplementedServiceServer) ResolveAction(context.Context, *ResolveActionRequest) (*Action, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveAction not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +230,24 @@ func _Service_DeleteAction_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_ResolveAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ResolveAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infraboard.workflow.action.Service/ResolveAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ResolveAction(ctx, req.(*ResolveActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -243,6 +275,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAction",
 			Handler:    _Service_DeleteAction_Handler,
 		},
+		{
+			MethodName: "ResolveAction",
+			Handler:    _Service_ResolveAction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/apps/action/pb/action.proto",
@@ -0,0 +1,227 @@
+package action_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/infraboard/mcube/pb/resource"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/workflow/api/apps/action"
+)
+
+func TestConstraintCheck(t *testing.T) {
+	should := assert.New(t)
+
+	cases := []struct {
+		constraint string
+		version    string
+		match      bool
+	}{
+		{"v1", "v1.9.3", true},
+		{"v1", "v2.0.0", false},
+		{"1.x", "v1.0.1", true},
+		{"v1.2", "v1.3.0", false},
+		{"^1.2.0", "v1.9.0", true},
+		{"^1.2.0", "v1.1.0", false},
+		{"^0.2.0", "v0.3.0", false},
+		{"~1.2.0", "v1.2.9", true},
+		{"~1.2.0", "v1.3.0", false},
+		{">=1.0 <2", "v1.5.0", true},
+		{">=1.0 <2", "v2.0.0", false},
+		{">1.2", "v1.2.5", false},
+		{"<=1.2", "v1.2.5", true},
+		{"*", "v3.0.0", true},
+		{"v1", "v1.2.0-beta", false},
+		{"=1.2.0-beta", "v1.2.0-beta", true},
+	}
+	for _, c := range cases {
+		cons, err := action.ParseConstraint(c.constraint)
+		if !should.NoError(err, c.constraint) {
+			continue
+		}
+		v, err := action.ParseVersion(c.version)
+		if !should.NoError(err, c.version) {
+			continue
+		}
+		should.Equal(c.match, cons.Check(v), "%s %s", c.constraint, c.version)
+	}
+
+	_, err := action.ParseConstraint("^")
+	should.Error(err)
+	_, err = action.ParseConstraint("v1.a")
+	should.Error(err)
+}
+
+func newTestActionSet() *action.ActionSet {
+	set := action.NewActionSet()
+	set.Add(&action.Action{Name: "build", Version: "v1.0.0"})
+	set.Add(&action.Action{Name: "build", Version: "v1.2.0", Lifecycle: action.LIFECYCLE_DEPRECATED})
+	set.Add(&action.Action{Name: "build", Version: "v1.3.0", Lifecycle: action.LIFECYCLE_RETIRED})
+	set.Add(&action.Action{Name: "build", Version: "v2.0.0", IsLatest: true})
+	set.Add(&action.Action{Name: "build", Version: "v3.0.0-beta"})
+	return set
+}
+
+func TestActionSetResolve(t *testing.T) {
+	should := assert.New(t)
+	set := newTestActionSet()
+
+	cases := map[string]string{
+		"":            "v2.0.0",
+		"latest":      "v2.0.0",
+		"v1":          "v1.2.0",
+		"^1.0.0":      "v1.2.0",
+		"v1.0.0":      "v1.0.0",
+		"v3.0.0-beta": "v3.0.0-beta",
+	}
+	for version, expect := range cases {
+		a, err := set.Resolve(version)
+		if should.NoError(err, version) {
+			should.Equal(expect, a.Version, version)
+		}
+	}
+
+	// 只有已下线的版本满足时不能使用
+	_, err := set.Resolve("v1.3")
+	should.Error(err)
+	_, err = set.Resolve("v1.3.0")
+	should.Error(err)
+	_, err = set.Resolve("v4")
+	should.Error(err)
+}
+
+func TestActionSetLatest(t *testing.T) {
+	should := assert.New(t)
+	set := newTestActionSet()
+	should.Equal("v2.0.0", set.Latest().Version)
+
+	set.Items[3].Lifecycle = action.LIFECYCLE_DEPRECATED
+	should.Equal("v1.0.0", set.Latest().Version)
+
+	a, b := set.Items[0], set.Items[3]
+	should.True(b.IsNewerThan(a))
+	should.False(a.IsNewerThan(b))
+}
+
+func TestActionSetLatestGroup(t *testing.T) {
+	should := assert.New(t)
+	set := action.NewActionSet()
+	set.Add(&action.Action{Id: "g1", Name: "build", Version: "v1.0.0", Namespace: "ns01", VisiableMode: resource.VisiableMode_GLOBAL})
+	set.Add(&action.Action{Id: "g2", Name: "build", Version: "v1.1.0", Namespace: "ns02", VisiableMode: resource.VisiableMode_GLOBAL})
+	set.Add(&action.Action{Id: "a1", Name: "build", Version: "v2.0.0", Namespace: "ns01"})
+	set.Add(&action.Action{Id: "b1", Name: "build", Version: "v1.0.0", Namespace: "ns02"})
+	set.Add(&action.Action{Id: "b2", Name: "build", Version: "v0.9.0", Namespace: "ns02"})
+
+	latest := set.LatestGroup()
+	should.Len(latest.Items, 3)
+	for _, id := range []string{"g2", "a1", "b1"} {
+		should.True(latest.Contains(id), id)
+	}
+
+	// 空间内解析时, 取空间最新版本和全局最新版本中更新的一个
+	for _, a := range set.Items {
+		a.IsLatest = latest.Contains(a.Id)
+	}
+	ns02 := action.NewActionSet()
+	for _, a := range set.Items {
+		if a.Namespace == "ns02" || a.VisiableMode == resource.VisiableMode_GLOBAL {
+			ns02.Add(a)
+		}
+	}
+	a, err := ns02.Resolve("latest")
+	if should.NoError(err) {
+		should.Equal("g2", a.Id)
+	}
+}
+
+func newTestRunParamAction() *action.Action {
+	return &action.Action{
+		Name:    "deploy",
+		Version: "v1.0.0",
+		RunParams: []*action.RunParamDesc{
+			{KeyName: "REPLICAS", ValueType: action.PARAM_VALUE_TYPE_INT, DefaultValue: "1", Min: "1", Max: "10"},
+			{KeyName: "ENV", ValueType: action.PARAM_VALUE_TYPE_ENUM, Required: true, AllowedValues: []string{"dev", "prod"}},
+			{KeyName: "DEBUG", ValueType: action.PARAM_VALUE_TYPE_BOOL},
+			{KeyName: "VALUES", ValueType: action.PARAM_VALUE_TYPE_JSON},
+			{KeyName: "TOKEN", ValueType: action.PARAM_VALUE_TYPE_SECRET, Min: "8"},
+			{KeyName: "APP", Regex: `^[a-z]+$`, Max: "5"},
+		},
+	}
+}
+
+func TestValidateRunParam(t *testing.T) {
+	should := assert.New(t)
+	a := newTestRunParamAction()
+
+	should.NoError(a.ValidateRunParam(map[string]string{
+		"ENV":    "prod",
+		"DEBUG":  "true",
+		"VALUES": `{"a": 1}`,
+		"TOKEN":  "12345678",
+		"APP":    "web",
+	}))
+
+	err := a.ValidateRunParam(map[string]string{
+		"REPLICAS": "20",
+		"DEBUG":    "yes",
+		"VALUES":   "{",
+		"TOKEN":    "secret",
+		"APP":      "Web",
+	})
+	errs, ok := err.(action.RunParamErrors)
+	if should.True(ok) {
+		should.Equal([]string{"APP", "DEBUG", "ENV", "REPLICAS", "TOKEN", "VALUES"}, errs.Keys())
+		should.NotContains(err.Error(), "secret")
+	}
+}
+
+func TestValidateStaticRunParam(t *testing.T) {
+	should := assert.New(t)
+	a := newTestRunParamAction()
+	isReference := func(v string) bool { return strings.Contains(v, "${{") }
+	isSecretReference := func(v string) bool { return v == "${{ secrets.TOKEN }}" }
+
+	should.NoError(a.ValidateStaticRunParam(map[string]string{
+		"ENV":      "${{ with.ENV }}",
+		"REPLICAS": "${{ steps.prepare.outputs.REPLICAS }}",
+		"TOKEN":    "${{ secrets.TOKEN }}",
+	}, isReference, isSecretReference))
+
+	err := a.ValidateStaticRunParam(map[string]string{"ENV": "test", "TOKEN": "12345678"}, isReference, isSecretReference)
+	errs, ok := err.(action.RunParamErrors)
+	if should.True(ok) {
+		should.Equal([]string{"ENV", "TOKEN"}, errs.Keys())
+	}
+
+	// SECRET类型的参数不能在引用之外拼接明文
+	err = a.ValidateStaticRunParam(map[string]string{"ENV": "${{ with.ENV }}", "TOKEN": "hunter2${{ pipeline.with.x }}"}, isReference, isSecretReference)
+	errs, ok = err.(action.RunParamErrors)
+	if should.True(ok) {
+		should.Equal([]string{"TOKEN"}, errs.Keys())
+	}
+}
+
+func TestRunParamDescValidate(t *testing.T) {
+	should := assert.New(t)
+
+	req := action.NewCreateActionRequest()
+	req.Domain, req.Namespace, req.CreateBy = "default", "default", "admin"
+	req.Name, req.Version = "deploy", "v1.0.0"
+	req.RunnerType = action.RUNNER_TYPE_LOCAL
+	req.RunParams = newTestRunParamAction().RunParams
+	should.NoError(req.Validate())
+
+	invalid := []*action.RunParamDesc{
+		{KeyName: "A", ValueType: action.PARAM_VALUE_TYPE_ENUM},
+		{KeyName: "A", ValueType: action.PARAM_VALUE_TYPE_BOOL, Max: "1"},
+		{KeyName: "A", ValueType: action.PARAM_VALUE_TYPE_INT, Min: "a"},
+		{KeyName: "A", ValueType: action.PARAM_VALUE_TYPE_INT, DefaultValue: "a"},
+		{KeyName: "A", ValueType: action.PARAM_VALUE_TYPE_SECRET, DefaultValue: "12345678"},
+		{KeyName: "A", Regex: "("},
+	}
+	for i, p := range invalid {
+		req.RunParams = []*action.RunParamDesc{p}
+		should.Error(req.Validate(), i)
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/infraboard/workflow/api/apps/action"
 	"github.com/infraboard/workflow/node/controller/step/runner/docker"
+	http_runner "github.com/infraboard/workflow/node/controller/step/runner/http"
 	"github.com/infraboard/workflow/node/controller/step/runner/k8s"
 	"github.com/infraboard/workflow/node/controller/step/runner/local"
 )
@@ -130,6 +131,7 @@ func (h *handler) QueryRunner(w http.ResponseWriter, r *http.Request) {
 	ins.Add(action.RUNNER_TYPE_DOCKER, docker.ParamsDesc())
 	ins.Add(action.RUNNER_TYPE_K8s, k8s.ParamsDesc())
 	ins.Add(action.RUNNER_TYPE_LOCAL, local.ParamsDesc())
+	ins.Add(action.RUNNER_TYPE_HTTP, http_runner.ParamsDesc())
 	response.Success(w, ins)
 }
 
@@ -15,14 +15,20 @@ func (i *service) CreateAction(ctx context.Context, req *action.CreateActionRequ
 	*action.Action, error) {
 	a, err := action.NewAction(req)
 	if err != nil {
-		return nil, err
+		return nil, exception.NewBadRequest("validate create action error, %s", err)
 	}
 
-	// 获取之前最新的版本
 	if _, err := i.col.InsertOne(context.TODO(), a); err != nil {
 		return nil, exception.NewInternalServerError("inserted a action document error, %s", err)
 	}
 
+	// 新版本比之前的最新版本高时, 成为最新版本
+	latest, err := i.refreshLatest(ctx, a.Name)
+	if err != nil {
+		return nil, err
+	}
+	a.IsLatest = latest.Contains(a.Id)
+
 	return a, nil
 }
 
@@ -95,6 +101,13 @@ func (i *service) UpdateAction(ctx context.Context, req *action.UpdateActionRequ
 		return nil, exception.NewInternalServerError("update action(%s) error, %s", ins.Key(), err)
 	}
 
+	// 生命周期变化会影响最新版本
+	latest, err := i.refreshLatest(ctx, ins.Name)
+	if err != nil {
+		return nil, err
+	}
+	ins.IsLatest = latest.Contains(ins.Id)
+
 	return ins, nil
 }
 
@@ -118,5 +131,34 @@ func (i *service) DeleteAction(ctx context.Context, req *action.DeleteActionRequ
 		return nil, err
 	}
 
+	if ins.IsLatest {
+		if _, err := i.refreshLatest(ctx, ins.Name); err != nil {
+			return nil, err
+		}
+	}
+
 	return ins, nil
 }
+
+// ResolveAction 解析step中引用的action, 引用的版本可以是latest, 前缀或者范围
+func (i *service) ResolveAction(ctx context.Context, req *action.ResolveActionRequest) (
+	*action.Action, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest("validate resolve action request error, %s", err)
+	}
+
+	name, version := action.ParseActionKey(req.Key)
+	set, err := i.listVersions(ctx, name, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if len(set.Items) == 0 {
+		return nil, exception.NewNotFound("action %s not found", name)
+	}
+
+	a, err := set.Resolve(version)
+	if err != nil {
+		return nil, exception.NewBadRequest("resolve action %s error, %s", req.Key, err)
+	}
+	return a, nil
+}
@@ -0,0 +1,69 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/exception"
+	"github.com/infraboard/mcube/pb/resource"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/infraboard/workflow/api/apps/action"
+)
+
+// listVersions 查询action的所有版本, namespace不为空时只查询该空间和全局可见的版本
+// 模版也保存在同一个集合中, 通过version字段区分
+func (i *service) listVersions(ctx context.Context, name, namespace string) (*action.ActionSet, error) {
+	filter := bson.M{"name": name, "version": bson.M{"$exists": true}}
+	if namespace != "" {
+		filter["$or"] = bson.A{
+			bson.M{"namespace": namespace},
+			bson.M{"visiable_mode": resource.VisiableMode_GLOBAL},
+		}
+	}
+
+	resp, err := i.col.Find(ctx, filter)
+	if err != nil {
+		return nil, exception.NewInternalServerError("find action %s versions error, %s", name, err)
+	}
+
+	set := action.NewActionSet()
+	for resp.Next(ctx) {
+		a := action.NewDefaultAction()
+		if err := resp.Decode(a); err != nil {
+			return nil, exception.NewInternalServerError("decode action error, error is %s", err)
+		}
+		set.Add(a)
+	}
+	set.Total = int64(len(set.Items))
+	return set, nil
+}
+
+// refreshLatest 重新计算同名action的最新版本, 每个空间和全局可见的版本各有一个最新版本
+func (i *service) refreshLatest(ctx context.Context, name string) (*action.ActionSet, error) {
+	set, err := i.listVersions(ctx, name, "")
+	if err != nil {
+		return nil, err
+	}
+
+	latest := set.LatestGroup()
+	ids := bson.A{}
+	for _, a := range latest.Items {
+		ids = append(ids, a.Id)
+	}
+
+	unset := bson.M{"name": name, "is_latest": true, "_id": bson.M{"$nin": ids}}
+	if _, err := i.col.UpdateMany(ctx, unset, bson.M{"$set": bson.M{"is_latest": false}}); err != nil {
+		return nil, exception.NewInternalServerError("update action %s latest error, %s", name, err)
+	}
+	if len(ids) == 0 {
+		return latest, nil
+	}
+
+	if _, err := i.col.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": ids}}, bson.M{"$set": bson.M{"is_latest": true}}); err != nil {
+		return nil, exception.NewInternalServerError("update action %s latest error, %s", name, err)
+	}
+	for _, a := range latest.Items {
+		a.IsLatest = true
+	}
+	return latest, nil
+}
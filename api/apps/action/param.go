@@ -0,0 +1,242 @@
+package action
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// RunParamError 参数校验失败的原因, 前端可以根据key定位到对应的表单项
+type RunParamError struct {
+	Key    string `json:"key"`
+	Reason string `json:"reason"`
+}
+
+func (e *RunParamError) Error() string {
+	return e.Key + " " + e.Reason
+}
+
+// RunParamErrors 所有校验失败的参数, 按照key排序
+type RunParamErrors []*RunParamError
+
+func (e RunParamErrors) Error() string {
+	msg := make([]string, 0, len(e))
+	for i := range e {
+		msg = append(msg, e[i].Error())
+	}
+	return "validate run params error, " + strings.Join(msg, ",")
+}
+
+// Keys 校验失败的参数名称
+func (e RunParamErrors) Keys() []string {
+	keys := make([]string, 0, len(e))
+	for i := range e {
+		keys = append(keys, e[i].Key)
+	}
+	return keys
+}
+
+// Validate 检查参数定义, 约束需要和参数类型匹配, 默认值需要符合约束
+func (p *RunParamDesc) Validate() error {
+	if p.KeyName == "" {
+		return fmt.Errorf("param key_name required")
+	}
+	if p.Regex != "" {
+		if _, err := regexp.Compile(p.Regex); err != nil {
+			return fmt.Errorf("param %s regex %s invalid, %s", p.KeyName, p.Regex, err)
+		}
+	}
+	if p.ValueType == PARAM_VALUE_TYPE_ENUM && len(p.AllowedValues) == 0 {
+		return fmt.Errorf("param %s type ENUM must set allowed_values", p.KeyName)
+	}
+
+	for _, bound := range []string{p.Min, p.Max} {
+		if bound == "" {
+			continue
+		}
+		if !p.hasBound() {
+			return fmt.Errorf("param %s type %s not support min/max", p.KeyName, p.ValueType)
+		}
+		if _, err := strconv.ParseInt(bound, 10, 64); err != nil {
+			return fmt.Errorf("param %s min/max %s is not an integer", p.KeyName, bound)
+		}
+	}
+
+	for _, v := range p.AllowedValues {
+		if err := p.checkType(v); err != nil {
+			return fmt.Errorf("param %s allowed value %s invalid, %s", p.KeyName, v, err)
+		}
+	}
+	if p.DefaultValue != "" {
+		if p.ValueType == PARAM_VALUE_TYPE_SECRET {
+			return fmt.Errorf("param %s type SECRET can't set default value", p.KeyName)
+		}
+		if err := p.Check(p.DefaultValue); err != nil {
+			return fmt.Errorf("param %s default value invalid, %s", p.KeyName, err)
+		}
+	}
+	return nil
+}
+
+// Check 检查参数值是否符合参数的类型和约束, SECRET类型的值不会出现在错误信息中
+func (p *RunParamDesc) Check(value string) error {
+	if err := p.checkType(value); err != nil {
+		return err
+	}
+
+	if len(p.AllowedValues) > 0 && !p.isAllowed(value) {
+		return fmt.Errorf("value %s not in %s", p.display(value), strings.Join(p.AllowedValues, ","))
+	}
+
+	if p.Regex != "" {
+		ok, err := regexp.MatchString(p.Regex, value)
+		if err != nil {
+			return fmt.Errorf("regex %s invalid, %s", p.Regex, err)
+		}
+		if !ok {
+			return fmt.Errorf("value %s not match %s", p.display(value), p.Regex)
+		}
+	}
+
+	return p.checkBound(value)
+}
+
+func (p *RunParamDesc) checkType(value string) error {
+	switch p.ValueType {
+	case PARAM_VALUE_TYPE_INT:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("value %s is not an int", value)
+		}
+	case PARAM_VALUE_TYPE_BOOL:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("value %s is not a bool", value)
+		}
+	case PARAM_VALUE_TYPE_JSON:
+		if !json.Valid([]byte(value)) {
+			return fmt.Errorf("value is not a valid json")
+		}
+	case PARAM_VALUE_TYPE_FILE:
+		if strings.ContainsAny(value, "\x00\n") {
+			return fmt.Errorf("value %q is not a valid file path", value)
+		}
+	}
+	return nil
+}
+
+// checkBound INT类型比较数值, 字符串类型比较长度
+func (p *RunParamDesc) checkBound(value string) error {
+	if !p.hasBound() || (p.Min == "" && p.Max == "") {
+		return nil
+	}
+
+	var n int64
+	unit := "length"
+	if p.ValueType == PARAM_VALUE_TYPE_INT {
+		n, _ = strconv.ParseInt(value, 10, 64)
+		unit = "value"
+	} else {
+		n = int64(utf8.RuneCountInString(value))
+	}
+
+	if min, err := strconv.ParseInt(p.Min, 10, 64); err == nil && n < min {
+		return fmt.Errorf("%s must >= %d", unit, min)
+	}
+	if max, err := strconv.ParseInt(p.Max, 10, 64); err == nil && n > max {
+		return fmt.Errorf("%s must <= %d", unit, max)
+	}
+	return nil
+}
+
+func (p *RunParamDesc) hasBound() bool {
+	switch p.ValueType {
+	case PARAM_VALUE_TYPE_INT, PARAM_VALUE_TYPE_STRING, PARAM_VALUE_TYPE_SECRET, PARAM_VALUE_TYPE_FILE:
+		return true
+	}
+	return false
+}
+
+func (p *RunParamDesc) isAllowed(value string) bool {
+	for _, v := range p.AllowedValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RunParamDesc) display(value string) string {
+	if p.ValueType == PARAM_VALUE_TYPE_SECRET {
+		return "******"
+	}
+	return value
+}
+
+func validateRunParamDesc(params []*RunParamDesc) error {
+	keyMap := map[string]struct{}{}
+	for i := range params {
+		if err := params[i].Validate(); err != nil {
+			return err
+		}
+		if _, ok := keyMap[params[i].KeyName]; ok {
+			return fmt.Errorf("param %s duplicate", params[i].KeyName)
+		}
+		keyMap[params[i].KeyName] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateRunParam 按照action的定义检查运行参数, 变量引用已经替换为实际的值
+// 所有不合法的参数一起返回, 错误类型为RunParamErrors
+func (a *Action) ValidateRunParam(params map[string]string) error {
+	return a.validateRunParam(params, nil, nil)
+}
+
+// ValidateStaticRunParam 创建pipeline时检查参数, 此时变量引用还没有替换, 引用的值跳过检查
+// SECRET类型的参数整个值只能是一个secret引用, 不允许直接填写明文或者和其他内容拼接
+func (a *Action) ValidateStaticRunParam(params map[string]string, isReference, isSecretReference func(string) bool) error {
+	return a.validateRunParam(params, isReference, isSecretReference)
+}
+
+func (a *Action) validateRunParam(params map[string]string, isReference, isSecretReference func(string) bool) error {
+	errs := RunParamErrors{}
+	for i := range a.RunParams {
+		param := a.RunParams[i]
+		v, ok := params[param.KeyName]
+		if !ok || v == "" {
+			v = param.DefaultValue
+		}
+		if v == "" {
+			if param.Required {
+				errs = append(errs, &RunParamError{Key: param.KeyName, Reason: "is required"})
+			}
+			continue
+		}
+
+		if isReference != nil {
+			if param.ValueType == PARAM_VALUE_TYPE_SECRET {
+				if !isSecretReference(v) {
+					errs = append(errs, &RunParamError{Key: param.KeyName,
+						Reason: "must be exactly one secret reference, like ${{ secrets.NAME }}"})
+				}
+				continue
+			}
+			if isReference(v) {
+				continue
+			}
+		}
+
+		if err := param.Check(v); err != nil {
+			errs = append(errs, &RunParamError{Key: param.KeyName, Reason: err.Error()})
+		}
+	}
+
+	if len(errs) > 0 {
+		sort.Slice(errs, func(i, j int) bool { return errs[i].Key < errs[j].Key })
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,238 @@
+package action
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// 引用最新版本, 版本为空时等同于latest
+	LATEST_VERSION = "latest"
+)
+
+// Version 语义化版本, 支持v前缀, 比如 v1, v1.2, v1.2.3, 1.2.3-beta.1
+type Version struct {
+	Major int64
+	Minor int64
+	Patch int64
+	Pre   string
+	// 版本中实际写了几位, 用于前缀匹配, 比如v1只有1位
+	parts int
+}
+
+// ParseVersion 解析版本号
+func ParseVersion(v string) (*Version, error) {
+	raw := strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if raw == "" {
+		return nil, fmt.Errorf("invalid version %q", v)
+	}
+
+	ver := &Version{}
+	if i := strings.IndexAny(raw, "-+"); i >= 0 {
+		if raw[i] == '-' {
+			ver.Pre = strings.SplitN(raw[i+1:], "+", 2)[0]
+		}
+		raw = raw[:i]
+	}
+
+	nums := strings.Split(raw, ".")
+	if len(nums) > 3 {
+		return nil, fmt.Errorf("invalid version %q", v)
+	}
+	for i, n := range nums {
+		num, err := strconv.ParseInt(n, 10, 64)
+		if err != nil || num < 0 {
+			return nil, fmt.Errorf("invalid version %q", v)
+		}
+		switch i {
+		case 0:
+			ver.Major = num
+		case 1:
+			ver.Minor = num
+		case 2:
+			ver.Patch = num
+		}
+	}
+	ver.parts = len(nums)
+	return ver, nil
+}
+
+// Compare 比较版本, 预发布版本小于正式版本
+func (v *Version) Compare(o *Version) int {
+	for _, d := range []int64{v.Major - o.Major, v.Minor - o.Minor, v.Patch - o.Patch} {
+		if d > 0 {
+			return 1
+		}
+		if d < 0 {
+			return -1
+		}
+	}
+
+	switch {
+	case v.Pre == o.Pre:
+		return 0
+	case v.Pre == "":
+		return 1
+	case o.Pre == "":
+		return -1
+	case v.Pre > o.Pre:
+		return 1
+	default:
+		return -1
+	}
+}
+
+func (v *Version) String() string {
+	s := fmt.Sprintf("v%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Pre != "" {
+		s += "-" + v.Pre
+	}
+	return s
+}
+
+// 前缀版本的上限, 比如v1的上限为v2.0.0, v1.2的上限为v1.3.0
+func (v *Version) prefixUpper() *Version {
+	switch v.parts {
+	case 1:
+		return &Version{Major: v.Major + 1}
+	case 2:
+		return &Version{Major: v.Major, Minor: v.Minor + 1}
+	default:
+		return &Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch, Pre: v.Pre}
+	}
+}
+
+// Constraint 版本范围, 多个条件使用空格分隔, 需要同时满足
+// 支持的写法:
+//   v1, 1.x, v1.2: 前缀匹配
+//   ^1.2.0: 主版本相同, 主版本为0时次版本相同
+//   ~1.2.0: 主版本和次版本相同
+//   >1.0, >=1.0, <2, <=2.1.0, =1.2.3: 比较
+//   *: 任意版本
+type Constraint struct {
+	expr  string
+	conds []*versionCond
+}
+
+type versionCond struct {
+	op  string
+	ver *Version
+}
+
+// ParseConstraint 解析版本范围
+func ParseConstraint(expr string) (*Constraint, error) {
+	c := &Constraint{expr: expr}
+	for _, item := range strings.Fields(expr) {
+		conds, err := parseCond(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version constraint %q, %s", expr, err)
+		}
+		c.conds = append(c.conds, conds...)
+	}
+	if len(c.conds) == 0 && strings.TrimSpace(expr) != "*" {
+		return nil, fmt.Errorf("invalid version constraint %q", expr)
+	}
+	return c, nil
+}
+
+func parseCond(item string) ([]*versionCond, error) {
+	op := ""
+	for _, prefix := range []string{">=", "<=", ">", "<", "=", "^", "~"} {
+		if strings.HasPrefix(item, prefix) {
+			op, item = prefix, item[len(prefix):]
+			break
+		}
+	}
+
+	// 去掉通配符, 1.x 等同于 1
+	parts := strings.Split(strings.TrimPrefix(item, "v"), ".")
+	for i, p := range parts {
+		if p == "x" || p == "X" || p == "*" {
+			parts = parts[:i]
+			break
+		}
+	}
+	if len(parts) == 0 {
+		if op != "" {
+			return nil, fmt.Errorf("version required after %s", op)
+		}
+		return nil, nil
+	}
+
+	ver, err := ParseVersion(strings.Join(parts, "."))
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case "":
+		if ver.parts == 3 {
+			return []*versionCond{{op: "=", ver: ver}}, nil
+		}
+		return []*versionCond{{op: ">=", ver: ver}, {op: "<", ver: ver.prefixUpper()}}, nil
+	case "^":
+		upper := &Version{Major: ver.Major + 1}
+		if ver.Major == 0 && ver.parts > 1 {
+			upper = &Version{Minor: ver.Minor + 1}
+		}
+		return []*versionCond{{op: ">=", ver: ver}, {op: "<", ver: upper}}, nil
+	case "~":
+		upper := &Version{Major: ver.Major, Minor: ver.Minor + 1}
+		if ver.parts == 1 {
+			upper = &Version{Major: ver.Major + 1}
+		}
+		return []*versionCond{{op: ">=", ver: ver}, {op: "<", ver: upper}}, nil
+	case "=":
+		if ver.parts < 3 {
+			return []*versionCond{{op: ">=", ver: ver}, {op: "<", ver: ver.prefixUpper()}}, nil
+		}
+	case "<=":
+		// <=1.2 包含所有1.2.x
+		if ver.parts < 3 {
+			return []*versionCond{{op: "<", ver: ver.prefixUpper()}}, nil
+		}
+	case ">":
+		// >1.2 不包含所有1.2.x
+		if ver.parts < 3 {
+			return []*versionCond{{op: ">=", ver: ver.prefixUpper()}}, nil
+		}
+	}
+	return []*versionCond{{op: op, ver: ver}}, nil
+}
+
+// Check 版本是否满足范围, 预发布版本只能精确匹配
+func (c *Constraint) Check(v *Version) bool {
+	if len(c.conds) == 0 {
+		return v.Pre == ""
+	}
+
+	for _, cond := range c.conds {
+		if v.Pre != "" && !(cond.op == "=" && cond.ver.Pre == v.Pre) {
+			return false
+		}
+
+		r := v.Compare(cond.ver)
+		ok := false
+		switch cond.op {
+		case "=":
+			ok = r == 0
+		case ">":
+			ok = r > 0
+		case ">=":
+			ok = r >= 0
+		case "<":
+			ok = r < 0
+		case "<=":
+			ok = r <= 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Constraint) String() string {
+	return c.expr
+}
@@ -3,6 +3,8 @@ package all
 import (
 	_ "github.com/infraboard/workflow/api/apps/action/impl"
 	_ "github.com/infraboard/workflow/api/apps/approval/impl"
+	_ "github.com/infraboard/workflow/api/apps/cronjob/impl"
 	_ "github.com/infraboard/workflow/api/apps/pipeline/impl"
+	_ "github.com/infraboard/workflow/api/apps/secret/impl"
 	_ "github.com/infraboard/workflow/api/apps/template/impl"
 )
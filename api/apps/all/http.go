@@ -3,6 +3,9 @@ package all
 import (
 	// 加载服务模块
 	_ "github.com/infraboard/workflow/api/apps/action/http"
+	_ "github.com/infraboard/workflow/api/apps/cronjob/http"
 	_ "github.com/infraboard/workflow/api/apps/pipeline/http"
+	_ "github.com/infraboard/workflow/api/apps/scm/http"
+	_ "github.com/infraboard/workflow/api/apps/secret/http"
 	_ "github.com/infraboard/workflow/api/apps/template/http"
 )
@@ -0,0 +1,5 @@
+package cronjob
+
+const (
+	AppName = "cronjob"
+)
@@ -0,0 +1,1341 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.1
+// source: api/apps/cronjob/pb/cronjob.proto
+
+package cronjob
+
+import (
+	request "github.com/infraboard/mcube/http/request"
+	pipeline "github.com/infraboard/workflow/api/apps/pipeline"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CONCURRENCY_POLICY 上一次运行的pipeline还没有结束时, 如何处理新的运行
+type CONCURRENCY_POLICY int32
+
+const (
+	// 允许并发运行
+	CONCURRENCY_POLICY_ALLOW CONCURRENCY_POLICY = 0
+	// 上一次还没有结束时, 跳过本次运行
+	CONCURRENCY_POLICY_FORBID CONCURRENCY_POLICY = 1
+	// 结束上一次运行, 使用新的运行替换
+	CONCURRENCY_POLICY_REPLACE CONCURRENCY_POLICY = 2
+)
+
+// Enum value maps for CONCURRENCY_POLICY.
+var (
+	CONCURRENCY_POLICY_name = map[int32]string{
+		0: "ALLOW",
+		1: "FORBID",
+		2: "REPLACE",
+	}
+	CONCURRENCY_POLICY_value = map[string]int32{
+		"ALLOW":   0,
+		"FORBID":  1,
+		"REPLACE": 2,
+	}
+)
+
+func (x CONCURRENCY_POLICY) Enum() *CONCURRENCY_POLICY {
+	p := new(CONCURRENCY_POLICY)
+	*p = x
+	return p
+}
+
+func (x CONCURRENCY_POLICY) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CONCURRENCY_POLICY) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_apps_cronjob_pb_cronjob_proto_enumTypes[0].Descriptor()
+}
+
+func (CONCURRENCY_POLICY) Type() protoreflect.EnumType {
+	return &file_api_apps_cronjob_pb_cronjob_proto_enumTypes[0]
+}
+
+func (x CONCURRENCY_POLICY) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CONCURRENCY_POLICY.Descriptor instead.
+func (CONCURRENCY_POLICY) EnumDescriptor() ([]byte, []int) {
+	return file_api_apps_cronjob_pb_cronjob_proto_rawDescGZIP(), []int{0}
+}
+
+// MISSED_POLICY 调度器停机期间错过的运行如何处理
+type MISSED_POLICY int32
+
+const (
+	// 跳过错过的运行, 等待下一次调度
+	MISSED_POLICY_SKIP MISSED_POLICY = 0
+	// 只补跑最近一次错过的运行
+	MISSED_POLICY_RUN_ONCE MISSED_POLICY = 1
+	// 补跑所有错过的运行, 最多补跑100次
+	MISSED_POLICY_CATCH_UP MISSED_POLICY = 2
+)
+
+// Enum value maps for MISSED_POLICY.
+var (
+	MISSED_POLICY_name = map[int32]string{
+		0: "SKIP",
+		1: "RUN_ONCE",
+		2: "CATCH_UP",
+	}
+	MISSED_POLICY_value = map[string]int32{
+		"SKIP":     0,
+		"RUN_ONCE": 1,
+		"CATCH_UP": 2,
+	}
+)
+
+func (x MISSED_POLICY) Enum() *MISSED_POLICY {
+	p := new(MISSED_POLICY)
+	*p = x
+	return p
+}
+
+func (x MISSED_POLICY) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MISSED_POLICY) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_apps_cronjob_pb_cronjob_proto_enumTypes[1].Descriptor()
+}
+
+func (MISSED_POLICY) Type() protoreflect.EnumType {
+	return &file_api_apps_cronjob_pb_cronjob_proto_enumTypes[1]
+}
+
+func (x MISSED_POLICY) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MISSED_POLICY.Descriptor instead.
+func (MISSED_POLICY) EnumDescriptor() ([]byte, []int) {
+	return file_api_apps_cronjob_pb_cronjob_proto_rawDescGZIP(), []int{1}
+}
+
+// CronJob 定时运行pipeline
+type CronJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 唯一ID
+	// @gotags: bson:"_id" json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"_id"`
+	// 资源版本
+	// @gotags: bson:"resource_version" json:"resource_version,omitempty"
+	ResourceVersion int64 `protobuf:"varint,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty" bson:"resource_version"`
+	// 所属域
+	// @gotags: bson:"domain" json:"domain"
+	Domain string `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain" bson:"domain"`
+	// 所属空间
+	// @gotags: bson:"namespace" json:"namespace"
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace" bson:"namespace"`
+	// 创建时间
+	// @gotags: bson:"create_at" json:"create_at"
+	CreateAt int64 `protobuf:"varint,5,opt,name=create_at,json=createAt,proto3" json:"create_at" bson:"create_at"`
+	// 创建人
+	// @gotags: bson:"create_by" json:"create_by"
+	CreateBy string `protobuf:"bytes,6,opt,name=create_by,json=createBy,proto3" json:"create_by" bson:"create_by"`
+	// 名称
+	// @gotags: bson:"name" json:"name" validate:"required"
+	Name string `protobuf:"bytes,7,opt,name=name,proto3" json:"name" bson:"name" validate:"required"`
+	// 描述
+	// @gotags: bson:"description" json:"description"
+	Description string `protobuf:"bytes,8,opt,name=description,proto3" json:"description" bson:"description"`
+	// cron表达式, 比如: */5 * * * *, @daily
+	// @gotags: bson:"schedule" json:"schedule" validate:"required"
+	Schedule string `protobuf:"bytes,9,opt,name=schedule,proto3" json:"schedule" bson:"schedule" validate:"required"`
+	// 时区, 比如: Asia/Shanghai, 默认UTC
+	// @gotags: bson:"timezone" json:"timezone"
+	Timezone string `protobuf:"bytes,10,opt,name=timezone,proto3" json:"timezone" bson:"timezone"`
+	// 是否暂停调度
+	// @gotags: bson:"suspend" json:"suspend"
+	Suspend bool `protobuf:"varint,11,opt,name=suspend,proto3" json:"suspend" bson:"suspend"`
+	// 并发策略
+	// @gotags: bson:"concurrency_policy" json:"concurrency_policy"
+	ConcurrencyPolicy CONCURRENCY_POLICY `protobuf:"varint,12,opt,name=concurrency_policy,json=concurrencyPolicy,proto3,enum=infraboard.workflow.cronjob.CONCURRENCY_POLICY" json:"concurrency_policy" bson:"concurrency_policy"`
+	// 错过运行的处理策略
+	// @gotags: bson:"missed_policy" json:"missed_policy"
+	MissedPolicy MISSED_POLICY `protobuf:"varint,13,opt,name=missed_policy,json=missedPolicy,proto3,enum=infraboard.workflow.cronjob.MISSED_POLICY" json:"missed_policy" bson:"missed_policy"`
+	// 错过计划时间多久之内仍然可以运行, 单位秒, 超过后视为错过, 0表示使用默认值60秒
+	// @gotags: bson:"starting_deadline" json:"starting_deadline"
+	StartingDeadline int64 `protobuf:"varint,14,opt,name=starting_deadline,json=startingDeadline,proto3" json:"starting_deadline" bson:"starting_deadline"`
+	// 保留多少次成功的运行记录, 0表示使用默认值3
+	// @gotags: bson:"successful_history_limit" json:"successful_history_limit"
+	SuccessfulHistoryLimit int32 `protobuf:"varint,15,opt,name=successful_history_limit,json=successfulHistoryLimit,proto3" json:"successful_history_limit" bson:"successful_history_limit"`
+	// 保留多少次失败的运行记录, 0表示使用默认值1
+	// @gotags: bson:"failed_history_limit" json:"failed_history_limit"
+	FailedHistoryLimit int32 `protobuf:"varint,16,opt,name=failed_history_limit,json=failedHistoryLimit,proto3" json:"failed_history_limit" bson:"failed_history_limit"`
+	// 模版id, 通过模版创建时记录
+	// @gotags: bson:"template_id" json:"template_id"
+	TemplateId string `protobuf:"bytes,17,opt,name=template_id,json=templateId,proto3" json:"template_id" bson:"template_id"`
+	// 用于创建pipeline的请求参数
+	// @gotags: bson:"pipeline" json:"pipeline"
+	Pipeline *pipeline.CreatePipelineRequest `protobuf:"bytes,18,opt,name=pipeline,proto3" json:"pipeline" bson:"pipeline"`
+	// 当前状态
+	// @gotags: bson:"status" json:"status"
+	Status *CronJobStatus `protobuf:"bytes,19,opt,name=status,proto3" json:"status" bson:"status"`
+}
+
+func (x *CronJob) Reset() {
+	*x = CronJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_apps_cronjob_pb_cronjob_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CronJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CronJob) ProtoMessage() {}
+
+func (x *CronJob) ProtoReflect() protoreflect.Message {
+	mi := &file_api_apps_cronjob_pb_cronjob_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CronJob.ProtoReflect.Descriptor instead.
+func (*CronJob) Descriptor() ([]byte, []int) {
+	return file_api_apps_cronjob_pb_cronjob_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CronJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CronJob) GetResourceVersion() int64 {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return 0
+}
+
+func (x *CronJob) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *CronJob) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CronJob) GetCreateAt() int64 {
+	if x != nil {
+		return x.CreateAt
+	}
+	return 0
+}
+
+func (x *CronJob) GetCreateBy() string {
+	if x != nil {
+		return x.CreateBy
+	}
+	return ""
+}
+
+func (x *CronJob) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CronJob) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CronJob) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *CronJob) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CronJob) GetSuspend() bool {
+	if x != nil {
+		return x.Suspend
+	}
+	return false
+}
+
+func (x *CronJob) GetConcurrencyPolicy() CONCURRENCY_POLICY {
+	if x != nil {
+		return x.ConcurrencyPolicy
+	}
+	return CONCURRENCY_POLICY_ALLOW
+}
+
+func (x *CronJob) GetMissedPolicy() MISSED_POLICY {
+	if x != nil {
+		return x.MissedPolicy
+	}
+	return MISSED_POLICY_SKIP
+}
+
+func (x *CronJob) GetStartingDeadline() int64 {
+	if x != nil {
+		return x.StartingDeadline
+	}
+	return 0
+}
+
+func (x *CronJob) GetSuccessfulHistoryLimit() int32 {
+	if x != nil {
+		return x.SuccessfulHistoryLimit
+	}
+	return 0
+}
+
+func (x *CronJob) GetFailedHistoryLimit() int32 {
+	if x != nil {
+		return x.FailedHistoryLimit
+	}
+	return 0
+}
+
+func (x *CronJob) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CronJob) GetPipeline() *pipeline.CreatePipelineRequest {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+func (x *CronJob) GetStatus() *CronJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// CronJobStatus 调度状态
+type CronJobStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 最近一次计划运行的时间
+	// @gotags: bson:"last_schedule_at" json:"last_schedule_at"
+	LastScheduleAt int64 `protobuf:"varint,1,opt,name=last_schedule_at,json=lastScheduleAt,proto3" json:"last_schedule_at" bson:"last_schedule_at"`
+	// 下一次计划运行的时间
+	// @gotags: bson:"next_schedule_at" json:"next_schedule_at"
+	NextScheduleAt int64 `protobuf:"varint,2,opt,name=next_schedule_at,json=nextScheduleAt,proto3" json:"next_schedule_at" bson:"next_schedule_at"`
+	// 最近一次运行成功的结束时间
+	// @gotags: bson:"last_successful_at" json:"last_successful_at"
+	LastSuccessfulAt int64 `protobuf:"varint,3,opt,name=last_successful_at,json=lastSuccessfulAt,proto3" json:"last_successful_at" bson:"last_successful_at"`
+	// 正在运行的pipeline
+	// @gotags: bson:"active" json:"active"
+	Active []string `protobuf:"bytes,4,rep,name=active,proto3" json:"active" bson:"active"`
+	// 最近一次调度的结果, 比如跳过的原因
+	// @gotags: bson:"message" json:"message"
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message" bson:"message"`
+}
+
+func (x *CronJobStatus) Reset() {
+	*x = CronJobStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_apps_cronjob_pb_cronjob_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CronJobStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CronJobStatus) ProtoMessage() {}
+
+func (x *CronJobStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_apps_cronjob_pb_cronjob_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CronJobStatus.ProtoReflect.Descriptor instead.
+func (*CronJobStatus) Descriptor() ([]byte, []int) {
+	return file_api_apps_cronjob_pb_cronjob_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CronJobStatus) GetLastScheduleAt() int64 {
+	if x != nil {
+		return x.LastScheduleAt
+	}
+	return 0
+}
+
+func (x *CronJobStatus) GetNextScheduleAt() int64 {
+	if x != nil {
+		return x.NextScheduleAt
+	}
+	return 0
+}
+
+func (x *CronJobStatus) GetLastSuccessfulAt() int64 {
+	if x != nil {
+		return x.LastSuccessfulAt
+	}
+	return 0
+}
+
+func (x *CronJobStatus) GetActive() []string {
+	if x != nil {
+		return x.Active
+	}
+	return nil
+}
+
+func (x *CronJobStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// CronJobSet todo
+type CronJobSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @gotags: json:"total"
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	// @gotags: json:"items"
+	Items []*CronJob `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
+}
+
+func (x *CronJobSet) Reset() {
+	*x = CronJobSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_apps_cronjob_pb_cronjob_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CronJobSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CronJobSet) ProtoMessage() {}
+
+func (x *CronJobSet) ProtoReflect() protoreflect.Message {
+	mi := &file_api_apps_cronjob_pb_cronjob_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CronJobSet.ProtoReflect.Descriptor instead.
+func (*CronJobSet) Descriptor() ([]byte, []int) {
+	return file_api_apps_cronjob_pb_cronjob_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CronJobSet) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CronJobSet) GetItems() []*CronJob {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// CreateCronJobRequest template_id和pipeline必须指定一个
+type CreateCronJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 所属域
+	// @gotags: json:"domain" validate:"required"
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain" validate:"required"`
+	// 所属空间
+	// @gotags: json:"namespace" validate:"required"
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace" validate:"required"`
+	// 创建人
+	// @gotags: json:"create_by" validate:"required"
+	CreateBy string `protobuf:"bytes,3,opt,name=create_by,json=createBy,proto3" json:"create_by" validate:"required"`
+	// 名称
+	// @gotags: json:"name" validate:"required"
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name" validate:"required"`
+	// 描述
+	// @gotags: json:"description"
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description"`
+	// cron表达式
+	// @gotags: json:"schedule" validate:"required"
+	Schedule string `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule" validate:"required"`
+	// 时区
+	// @gotags: json:"timezone"
+	Timezone string `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone"`
+	// 是否暂停调度
+	// @gotags: json:"suspend"
+	Suspend bool `protobuf:"varint,8,opt,name=suspend,proto3" json:"suspend"`
+	// 并发策略
+	// @gotags: json:"concurrency_policy"
+	ConcurrencyPolicy CONCURRENCY_POLICY `protobuf:"varint,9,opt,name=concurrency_policy,json=concurrencyPolicy,proto3,enum=infraboard.workflow.cronjob.CONCURRENCY_POLICY" json:"concurrency_policy"`
+	// 错过运行的处理策略
+	// @gotags: json:"missed_policy"
+	MissedPolicy MISSED_POLICY `protobuf:"varint,10,opt,name=missed_policy,json=missedPolicy,proto3,enum=infraboard.workflow.cronjob.MISSED_POLICY" json:"missed_policy"`
+	// 错过计划时间多久之内仍然可以运行, 单位秒
+	// @gotags: json:"starting_deadline"
+	StartingDeadline int64 `protobuf:"varint,11,opt,name=starting_deadline,json=startingDeadline,proto3" json:"starting_deadline"`
+	// 保留多少次成功的运行记录
+	// @gotags: json:"successful_history_limit"
+	SuccessfulHistoryLimit int32 `protobuf:"varint,12,opt,name=successful_history_limit,json=successfulHistoryLimit,proto3" json:"successful_history_limit"`
+	// 保留多少次失败的运行记录
+	// @gotags: json:"failed_history_limit"
+	FailedHistoryLimit int32 `protobuf:"varint,13,opt,name=failed_history_limit,json=failedHistoryLimit,proto3" json:"failed_history_limit"`
+	// 模版id, 使用模版中的pipeline
+	// @gotags: json:"template_id"
+	TemplateId string `protobuf:"bytes,14,opt,name=template_id,json=templateId,proto3" json:"template_id"`
+	// 模版中有多个pipeline时, 需要指定使用哪一个
+	// @gotags: json:"pipeline_name"
+	PipelineName string `protobuf:"bytes,15,opt,name=pipeline_name,json=pipelineName,proto3" json:"pipeline_name"`
+	// 用于创建pipeline的请求参数
+	// @gotags: json:"pipeline"
+	Pipeline *pipeline.CreatePipelineRequest `protobuf:"bytes,16,opt,name=pipeline,proto3" json:"pipeline"`
+}
+
+func (x *CreateCronJobRequest) Reset() {
+	*x = CreateCronJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_apps_cronjob_pb_cronjob_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCronJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCronJobRequest) ProtoMessage() {}
+
+func (x *CreateCronJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_apps_cronjob_pb_cronjob_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCronJobRequest.ProtoReflect.Descriptor instead.
+func (*CreateCronJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_apps_cronjob_pb_cronjob_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateCronJobRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *CreateCronJobRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CreateCronJobRequest) GetCreateBy() string {
+	if x != nil {
+		return x.CreateBy
+	}
+	return ""
+}
+
+func (x *CreateCronJobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCronJobRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCronJobRequest) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *CreateCronJobRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CreateCronJobRequest) GetSuspend() bool {
+	if x != nil {
+		return x.Suspend
+	}
+	return false
+}
+
+func (x *CreateCronJobRequest) GetConcurrencyPolicy() CONCURRENCY_POLICY {
+	if x != nil {
+		return x.ConcurrencyPolicy
+	}
+	return CONCURRENCY_POLICY_ALLOW
+}
+
+func (x *CreateCronJobRequest) GetMissedPolicy() MISSED_POLICY {
+	if x != nil {
+		return x.MissedPolicy
+	}
+	return MISSED_POLICY_SKIP
+}
+
+func (x *CreateCronJobRequest) GetStartingDeadline() int64 {
+	if x != nil {
+		return x.StartingDeadline
+	}
+	return 0
+}
+
+func (x *CreateCronJobRequest) GetSuccessfulHistoryLimit() int32 {
+	if x != nil {
+		return x.SuccessfulHistoryLimit
+	}
+	return 0
+}
+
+func (x *CreateCronJobRequest) GetFailedHistoryLimit() int32 {
+	if x != nil {
+		return x.FailedHistoryLimit
+	}
+	return 0
+}
+
+func (x *CreateCronJobRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateCronJobRequest) GetPipelineName() string {
+	if x != nil {
+		return x.PipelineName
+	}
+	return ""
+}
+
+func (x *CreateCronJobRequest) GetPipeline() *pipeline.CreatePipelineRequest {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+// QueryCronJobRequest todo
+type QueryCronJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @gotags: json:"page"
+	Page *request.PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page"`
+	// @gotags: json:"namespace"
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace"`
+	// @gotags: json:"name"
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+}
+
+func (x *QueryCronJobRequest) Reset() {
+	*x = QueryCronJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_apps_cronjob_pb_cronjob_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCronJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCronJobRequest) ProtoMessage() {}
+
+func (x *QueryCronJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_apps_cronjob_pb_cronjob_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryCronJobRequest.ProtoReflect.Descriptor instead.
+func (*QueryCronJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_apps_cronjob_pb_cronjob_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryCronJobRequest) GetPage() *request.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *QueryCronJobRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *QueryCronJobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// DescribeCronJobRequest todo
+type DescribeCronJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 所属空间
+	// @gotags: json:"namespace" validate:"required"
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace" validate:"required"`
+	// 唯一ID
+	// @gotags: json:"id" validate:"required"
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id" validate:"required"`
+}
+
+func (x *DescribeCronJobRequest) Reset() {
+	*x = DescribeCronJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_apps_cronjob_pb_cronjob_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeCronJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeCronJobRequest) ProtoMessage() {}
+
+func (x *DescribeCronJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_apps_cronjob_pb_cronjob_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeCronJobRequest.ProtoReflect.Descriptor instead.
+func (*DescribeCronJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_apps_cronjob_pb_cronjob_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DescribeCronJobRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DescribeCronJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// SuspendCronJobRequest 暂停或者恢复调度
+type SuspendCronJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 所属空间
+	// @gotags: json:"namespace" validate:"required"
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace" validate:"required"`
+	// 唯一ID
+	// @gotags: json:"id" validate:"required"
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id" validate:"required"`
+	// 是否暂停
+	// @gotags: json:"suspend"
+	Suspend bool `protobuf:"varint,3,opt,name=suspend,proto3" json:"suspend"`
+}
+
+func (x *SuspendCronJobRequest) Reset() {
+	*x = SuspendCronJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_apps_cronjob_pb_cronjob_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuspendCronJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendCronJobRequest) ProtoMessage() {}
+
+func (x *SuspendCronJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_apps_cronjob_pb_cronjob_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendCronJobRequest.ProtoReflect.Descriptor instead.
+func (*SuspendCronJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_apps_cronjob_pb_cronjob_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SuspendCronJobRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SuspendCronJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SuspendCronJobRequest) GetSuspend() bool {
+	if x != nil {
+		return x.Suspend
+	}
+	return false
+}
+
+// DeleteCronJobRequest todo
+type DeleteCronJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 所属空间
+	// @gotags: json:"namespace" validate:"required"
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace" validate:"required"`
+	// 唯一ID
+	// @gotags: json:"id" validate:"required"
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id" validate:"required"`
+}
+
+func (x *DeleteCronJobRequest) Reset() {
+	*x = DeleteCronJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_apps_cronjob_pb_cronjob_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCronJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCronJobRequest) ProtoMessage() {}
+
+func (x *DeleteCronJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_apps_cronjob_pb_cronjob_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCronJobRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCronJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_apps_cronjob_pb_cronjob_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteCronJobRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteCronJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_api_apps_cronjob_pb_cronjob_proto protoreflect.FileDescriptor
+
+var file_api_apps_cronjob_pb_cronjob_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x6a,
+	0x6f, 0x62, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x6a, 0x6f, 0x62, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x6a, 0x6f, 0x62,
+	0x1a, 0x23, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x6d, 0x63, 0x75,
+	0x62, 0x65, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x06, 0x0a, 0x07, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f,
+	0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x73, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x64, 0x12, 0x5e, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x6a, 0x6f, 0x62, 0x2e, 0x43,
+	0x4f, 0x4e, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43,
+	0x59, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x4f, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x6a, 0x6f, 0x62, 0x2e, 0x4d, 0x49, 0x53, 0x53, 0x45, 0x44,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c,
+	0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x30, 0x0a, 0x14,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12,
+	0x4f, 0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x42, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x6a, 0x6f, 0x62, 0x2e, 0x43,
+	0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x74,
+	0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x0a, 0x43, 0x72,
+	0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3a,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x6a, 0x6f, 0x62, 0x2e, 0x43, 0x72, 0x6f, 0x6e,
+	0x4a, 0x6f, 0x62, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xd2, 0x05, 0x0a, 0x14, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x5e,
+	0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x69, 0x6e, 0x66,
+	0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x6a, 0x6f, 0x62, 0x2e, 0x43, 0x4f, 0x4e, 0x43, 0x55, 0x52, 0x52,
+	0x45, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x52, 0x11, 0x63, 0x6f, 0x6e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x4f,
+	0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x63, 0x72, 0x6f, 0x6e,
+	0x6a, 0x6f, 0x62, 0x2e, 0x4d, 0x49, 0x53, 0x53, 0x45, 0x44, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43,
+	0x59, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x2b, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x38, 0x0a, 0x18,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4f,
+	0x0a, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x22,
+	0x7f, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x2e, 0x6d, 0x63, 0x75, 0x62, 0x65, 0x2e, 0x70, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x46, 0x0a, 0x16, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x72, 0x6f, 0x6e,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5f, 0x0a, 0x15, 0x53, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x22, 0x44, 0x0a, 0x14, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x2a,
+	0x38, 0x0a, 0x12, 0x43, 0x4f, 0x4e, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x50,
+	0x4f, 0x4c, 0x49, 0x43, 0x59, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x02, 0x2a, 0x35, 0x0a, 0x0d, 0x4d, 0x49, 0x53,
+	0x53, 0x45, 0x44, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4b,
+	0x49, 0x50, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x55, 0x4e, 0x5f, 0x4f, 0x4e, 0x43, 0x45,
+	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x55, 0x50, 0x10, 0x02,
+	0x32, 0xa2, 0x04, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x68, 0x0a, 0x0d,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x12, 0x31, 0x2e,
+	0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x6a, 0x6f, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x6a, 0x6f, 0x62, 0x2e, 0x43,
+	0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x12, 0x69, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43,
+	0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x12, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x63, 0x72, 0x6f,
+	0x6e, 0x6a, 0x6f, 0x62, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x63,
+	0x72, 0x6f, 0x6e, 0x6a, 0x6f, 0x62, 0x2e, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x53, 0x65,
+	0x74, 0x12, 0x6c, 0x0a, 0x0f, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x72, 0x6f,
+	0x6e, 0x4a, 0x6f, 0x62, 0x12, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x6a,
+	0x6f, 0x62, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x66, 0x72,
+	0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
+	0x63, 0x72, 0x6f, 0x6e, 0x6a, 0x6f, 0x62, 0x2e, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x12,
+	0x6a, 0x0a, 0x0e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f,
+	0x62, 0x12, 0x32, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x6a, 0x6f, 0x62, 0x2e,
+	0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x63, 0x72, 0x6f, 0x6e,
+	0x6a, 0x6f, 0x62, 0x2e, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x12, 0x68, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x12, 0x31, 0x2e, 0x69,
+	0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x6a, 0x6f, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x6a, 0x6f, 0x62, 0x2e, 0x43, 0x72,
+	0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x73,
+	0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x6a, 0x6f, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_apps_cronjob_pb_cronjob_proto_rawDescOnce sync.Once
+	file_api_apps_cronjob_pb_cronjob_proto_rawDescData = file_api_apps_cronjob_pb_cronjob_proto_rawDesc
+)
+
+func file_api_apps_cronjob_pb_cronjob_proto_rawDescGZIP() []byte {
+	file_api_apps_cronjob_pb_cronjob_proto_rawDescOnce.Do(func() {
+		file_api_apps_cronjob_pb_cronjob_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_apps_cronjob_pb_cronjob_proto_rawDescData)
+	})
+	return file_api_apps_cronjob_pb_cronjob_proto_rawDescData
+}
+
+var file_api_apps_cronjob_pb_cronjob_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_apps_cronjob_pb_cronjob_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_apps_cronjob_pb_cronjob_proto_goTypes = []interface{}{
+	(CONCURRENCY_POLICY)(0),                // 0: infraboard.workflow.cronjob.CONCURRENCY_POLICY
+	(MISSED_POLICY)(0),                     // 1: infraboard.workflow.cronjob.MISSED_POLICY
+	(*CronJob)(nil),                        // 2: infraboard.workflow.cronjob.CronJob
+	(*CronJobStatus)(nil),                  // 3: infraboard.workflow.cronjob.CronJobStatus
+	(*CronJobSet)(nil),                     // 4: infraboard.workflow.cronjob.CronJobSet
+	(*CreateCronJobRequest)(nil),           // 5: infraboard.workflow.cronjob.CreateCronJobRequest
+	(*QueryCronJobRequest)(nil),            // 6: infraboard.workflow.cronjob.QueryCronJobRequest
+	(*DescribeCronJobRequest)(nil),         // 7: infraboard.workflow.cronjob.DescribeCronJobRequest
+	(*SuspendCronJobRequest)(nil),          // 8: infraboard.workflow.cronjob.SuspendCronJobRequest
+	(*DeleteCronJobRequest)(nil),           // 9: infraboard.workflow.cronjob.DeleteCronJobRequest
+	(*pipeline.CreatePipelineRequest)(nil), // 10: infraboard.workflow.pipeline.CreatePipelineRequest
+	(*request.PageRequest)(nil),            // 11: infraboard.mcube.page.PageRequest
+}
+var file_api_apps_cronjob_pb_cronjob_proto_depIdxs = []int32{
+	0,  // 0: infraboard.workflow.cronjob.CronJob.concurrency_policy:type_name -> infraboard.workflow.cronjob.CONCURRENCY_POLICY
+	1,  // 1: infraboard.workflow.cronjob.CronJob.missed_policy:type_name -> infraboard.workflow.cronjob.MISSED_POLICY
+	10, // 2: infraboard.workflow.cronjob.CronJob.pipeline:type_name -> infraboard.workflow.pipeline.CreatePipelineRequest
+	3,  // 3: infraboard.workflow.cronjob.CronJob.status:type_name -> infraboard.workflow.cronjob.CronJobStatus
+	2,  // 4: infraboard.workflow.cronjob.CronJobSet.items:type_name -> infraboard.workflow.cronjob.CronJob
+	0,  // 5: infraboard.workflow.cronjob.CreateCronJobRequest.concurrency_policy:type_name -> infraboard.workflow.cronjob.CONCURRENCY_POLICY
+	1,  // 6: infraboard.workflow.cronjob.CreateCronJobRequest.missed_policy:type_name -> infraboard.workflow.cronjob.MISSED_POLICY
+	10, // 7: infraboard.workflow.cronjob.CreateCronJobRequest.pipeline:type_name -> infraboard.workflow.pipeline.CreatePipelineRequest
+	11, // 8: infraboard.workflow.cronjob.QueryCronJobRequest.page:type_name -> infraboard.mcube.page.PageRequest
+	5,  // 9: infraboard.workflow.cronjob.Service.CreateCronJob:input_type -> infraboard.workflow.cronjob.CreateCronJobRequest
+	6,  // 10: infraboard.workflow.cronjob.Service.QueryCronJob:input_type -> infraboard.workflow.cronjob.QueryCronJobRequest
+	7,  // 11: infraboard.workflow.cronjob.Service.DescribeCronJob:input_type -> infraboard.workflow.cronjob.DescribeCronJobRequest
+	8,  // 12: infraboard.workflow.cronjob.Service.SuspendCronJob:input_type -> infraboard.workflow.cronjob.SuspendCronJobRequest
+	9,  // 13: infraboard.workflow.cronjob.Service.DeleteCronJob:input_type -> infraboard.workflow.cronjob.DeleteCronJobRequest
+	2,  // 14: infraboard.workflow.cronjob.Service.CreateCronJob:output_type -> infraboard.workflow.cronjob.CronJob
+	4,  // 15: infraboard.workflow.cronjob.Service.QueryCronJob:output_type -> infraboard.workflow.cronjob.CronJobSet
+	2,  // 16: infraboard.workflow.cronjob.Service.DescribeCronJob:output_type -> infraboard.workflow.cronjob.CronJob
+	2,  // 17: infraboard.workflow.cronjob.Service.SuspendCronJob:output_type -> infraboard.workflow.cronjob.CronJob
+	2,  // 18: infraboard.workflow.cronjob.Service.DeleteCronJob:output_type -> infraboard.workflow.cronjob.CronJob
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_api_apps_cronjob_pb_cronjob_proto_init() }
+func file_api_apps_cronjob_pb_cronjob_proto_init() {
+	if File_api_apps_cronjob_pb_cronjob_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_apps_cronjob_pb_cronjob_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CronJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_apps_cronjob_pb_cronjob_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CronJobStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_apps_cronjob_pb_cronjob_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CronJobSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_apps_cronjob_pb_cronjob_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCronJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_apps_cronjob_pb_cronjob_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCronJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_apps_cronjob_pb_cronjob_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeCronJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_apps_cronjob_pb_cronjob_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuspendCronJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_apps_cronjob_pb_cronjob_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCronJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_apps_cronjob_pb_cronjob_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_apps_cronjob_pb_cronjob_proto_goTypes,
+		DependencyIndexes: file_api_apps_cronjob_pb_cronjob_proto_depIdxs,
+		EnumInfos:         file_api_apps_cronjob_pb_cronjob_proto_enumTypes,
+		MessageInfos:      file_api_apps_cronjob_pb_cronjob_proto_msgTypes,
+	}.Build()
+	File_api_apps_cronjob_pb_cronjob_proto = out.File
+	file_api_apps_cronjob_pb_cronjob_proto_rawDesc = nil
+	file_api_apps_cronjob_pb_cronjob_proto_goTypes = nil
+	file_api_apps_cronjob_pb_cronjob_proto_depIdxs = nil
+}
@@ -0,0 +1,100 @@
+// Code generated by github.com/infraboard/mcube
+// DO NOT EDIT
+
+package cronjob
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ParseCONCURRENCY_POLICYFromString Parse CONCURRENCY_POLICY from string
+func ParseCONCURRENCY_POLICYFromString(str string) (CONCURRENCY_POLICY, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := CONCURRENCY_POLICY_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown CONCURRENCY_POLICY: %s", str)
+	}
+
+	return CONCURRENCY_POLICY(v), nil
+}
+
+// Equal type compare
+func (t CONCURRENCY_POLICY) Equal(target CONCURRENCY_POLICY) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t CONCURRENCY_POLICY) IsIn(targets ...CONCURRENCY_POLICY) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t CONCURRENCY_POLICY) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *CONCURRENCY_POLICY) UnmarshalJSON(b []byte) error {
+	ins, err := ParseCONCURRENCY_POLICYFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
+
+// ParseMISSED_POLICYFromString Parse MISSED_POLICY from string
+func ParseMISSED_POLICYFromString(str string) (MISSED_POLICY, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := MISSED_POLICY_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown MISSED_POLICY: %s", str)
+	}
+
+	return MISSED_POLICY(v), nil
+}
+
+// Equal type compare
+func (t MISSED_POLICY) Equal(target MISSED_POLICY) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t MISSED_POLICY) IsIn(targets ...MISSED_POLICY) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t MISSED_POLICY) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *MISSED_POLICY) UnmarshalJSON(b []byte) error {
+	ins, err := ParseMISSED_POLICYFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
@@ -0,0 +1,374 @@
+package cronjob
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/infraboard/keyauth/app/token"
+	"github.com/infraboard/mcube/http/request"
+	"github.com/rs/xid"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/common/cron"
+)
+
+const (
+	// 默认错过计划时间60秒内仍然可以运行
+	DEFAULT_STARTING_DEADLINE = 60 * time.Second
+	// 默认保留3次成功的运行记录
+	DEFAULT_SUCCESSFUL_HISTORY_LIMIT = 3
+	// 默认保留1次失败的运行记录
+	DEFAULT_FAILED_HISTORY_LIMIT = 1
+	// 最多补跑多少次错过的运行
+	MAX_MISSED_RUNS = 100
+)
+
+// use a single instance of Validate, it caches struct info
+var (
+	validate = validator.New()
+)
+
+// NewCronJob 创建CronJob, 使用模版创建时, 需要先把模版中的pipeline填充到请求中
+func NewCronJob(req *CreateCronJobRequest) (*CronJob, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.Pipeline == nil {
+		return nil, fmt.Errorf("pipeline required")
+	}
+
+	c := &CronJob{
+		Id:                     xid.New().String(),
+		Domain:                 req.Domain,
+		Namespace:              req.Namespace,
+		CreateAt:               time.Now().UnixMilli(),
+		CreateBy:               req.CreateBy,
+		Name:                   req.Name,
+		Description:            req.Description,
+		Schedule:               req.Schedule,
+		Timezone:               req.Timezone,
+		Suspend:                req.Suspend,
+		ConcurrencyPolicy:      req.ConcurrencyPolicy,
+		MissedPolicy:           req.MissedPolicy,
+		StartingDeadline:       req.StartingDeadline,
+		SuccessfulHistoryLimit: req.SuccessfulHistoryLimit,
+		FailedHistoryLimit:     req.FailedHistoryLimit,
+		TemplateId:             req.TemplateId,
+		Pipeline:               req.Pipeline,
+		Status:                 NewDefaultCronJobStatus(),
+	}
+
+	// pipeline的所属信息和cronjob保持一致
+	c.Pipeline.Domain = c.Domain
+	c.Pipeline.Namespace = c.Namespace
+	c.Pipeline.CreateBy = c.CreateBy
+	c.Pipeline.TemplateId = c.TemplateId
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func NewDefaultCronJob() *CronJob {
+	return &CronJob{
+		Status: NewDefaultCronJobStatus(),
+	}
+}
+
+func NewDefaultCronJobStatus() *CronJobStatus {
+	return &CronJobStatus{}
+}
+
+// LoadCronJobFromBytes 解析etcd 的cronjob数据
+func LoadCronJobFromBytes(payload []byte) (*CronJob, error) {
+	ins := NewDefaultCronJob()
+
+	// 解析Value
+	if err := json.Unmarshal(payload, ins); err != nil {
+		return nil, fmt.Errorf("unmarshal cronjob error, vaule(%s) %s", string(payload), err)
+	}
+
+	// 校验合法性
+	if err := ins.Validate(); err != nil {
+		return nil, err
+	}
+
+	return ins, nil
+}
+
+func (c *CronJob) Validate() error {
+	if err := validate.Struct(c); err != nil {
+		return err
+	}
+	if _, err := cron.Parse(c.Schedule); err != nil {
+		return err
+	}
+	if _, err := c.Location(); err != nil {
+		return err
+	}
+	if c.Pipeline == nil {
+		return fmt.Errorf("pipeline required")
+	}
+	return c.Pipeline.Validate()
+}
+
+// MakeObjectKey 构建etcd对应的key
+// 例如: inforboard/workflow/service/cronjobs/default/xxx
+func (c *CronJob) MakeObjectKey() string {
+	return CronJobObjectKey(c.Namespace, c.Id)
+}
+
+func (c *CronJob) ShortDescribe() string {
+	return fmt.Sprintf("%s[%s]", c.Name, c.Id)
+}
+
+// Location 时区, 默认UTC
+func (c *CronJob) Location() (*time.Location, error) {
+	if c.Timezone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %s, %s", c.Timezone, err)
+	}
+	return loc, nil
+}
+
+// NextScheduleTime t之后的下一次计划运行时间
+func (c *CronJob) NextScheduleTime(t time.Time) (time.Time, error) {
+	sched, err := cron.Parse(c.Schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+	loc, err := c.Location()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return sched.Next(t.In(loc)), nil
+}
+
+func (c *CronJob) StartingDeadlineDuration() time.Duration {
+	if c.StartingDeadline <= 0 {
+		return DEFAULT_STARTING_DEADLINE
+	}
+	return time.Duration(c.StartingDeadline) * time.Second
+}
+
+// HistoryLimit 保留多少次已经结束的运行记录
+func (c *CronJob) HistoryLimit(failed bool) int {
+	if failed {
+		if c.FailedHistoryLimit <= 0 {
+			return DEFAULT_FAILED_HISTORY_LIMIT
+		}
+		return int(c.FailedHistoryLimit)
+	}
+
+	if c.SuccessfulHistoryLimit <= 0 {
+		return DEFAULT_SUCCESSFUL_HISTORY_LIMIT
+	}
+	return int(c.SuccessfulHistoryLimit)
+}
+
+// DueTimes 截止到now已经到期, 还没有运行的计划时间, 从上一次计划运行时间(没有时为创建时间)开始计算
+// 最多返回最近的MAX_MISSED_RUNS个
+func (c *CronJob) DueTimes(now time.Time) ([]time.Time, error) {
+	sched, err := cron.Parse(c.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := c.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	last := c.CreateAt
+	if c.Status != nil && c.Status.LastScheduleAt > last {
+		last = c.Status.LastScheduleAt
+	}
+
+	due := []time.Time{}
+	for t := sched.Next(time.UnixMilli(last).In(loc)); !t.IsZero() && !t.After(now); t = sched.Next(t) {
+		due = append(due, t)
+		if len(due) > MAX_MISSED_RUNS {
+			due = due[1:]
+		}
+	}
+	return due, nil
+}
+
+// FilterMissed 有多次运行到期时, 说明调度器停机或者cronjob暂停期间错过了运行, 按照MissedPolicy处理:
+//   1. SKIP: 只运行最近一次, 并且没有超过StartingDeadline, 其他的跳过
+//   2. RUN_ONCE: 只运行最近一次
+//   3. CATCH_UP: 按顺序运行所有错过的
+func (c *CronJob) FilterMissed(due []time.Time, now time.Time) []time.Time {
+	if len(due) == 0 {
+		return nil
+	}
+
+	latest := due[len(due)-1]
+	switch c.MissedPolicy {
+	case MISSED_POLICY_CATCH_UP:
+		return due
+	case MISSED_POLICY_RUN_ONCE:
+		return []time.Time{latest}
+	default:
+		if now.Sub(latest) > c.StartingDeadlineDuration() {
+			return nil
+		}
+		return []time.Time{latest}
+	}
+}
+
+// PipelineId 使用cronjob id和计划时间作为pipeline的id, 保证同一个计划时间只会创建一次
+func (c *CronJob) PipelineId(scheduleAt time.Time) string {
+	return fmt.Sprintf("%s-%d", c.Id, scheduleAt.Unix())
+}
+
+// NewPipeline 计划时间到期后, 创建需要运行的pipeline
+func (c *CronJob) NewPipeline(scheduleAt time.Time) (*pipeline.Pipeline, error) {
+	// 复制一份, 避免修改cronjob中的定义
+	data, err := json.Marshal(c.Pipeline)
+	if err != nil {
+		return nil, err
+	}
+	req := pipeline.NewCreatePipelineRequest()
+	if err := json.Unmarshal(data, req); err != nil {
+		return nil, err
+	}
+	req.Domain = c.Domain
+	req.Namespace = c.Namespace
+	req.CreateBy = c.CreateBy
+	req.TemplateId = c.TemplateId
+	req.CronjobId = c.Id
+
+	p, err := pipeline.NewPipeline(req)
+	if err != nil {
+		return nil, err
+	}
+	p.Id = c.PipelineId(scheduleAt)
+	return p, nil
+}
+
+// IsOwnerOf pipeline是否由该cronjob创建
+func (c *CronJob) IsOwnerOf(p *pipeline.Pipeline) bool {
+	return p.CronjobId == c.Id && p.Namespace == c.Namespace
+}
+
+// Clone 复制一份, 用于复制后比较状态是否变化
+func (c *CronJob) Clone() (*CronJob, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	ins := NewDefaultCronJob()
+	if err := json.Unmarshal(data, ins); err != nil {
+		return nil, err
+	}
+	ins.ResourceVersion = c.ResourceVersion
+	return ins, nil
+}
+
+func (s *CronJobStatus) Clone() *CronJobStatus {
+	return &CronJobStatus{
+		LastScheduleAt:   s.LastScheduleAt,
+		NextScheduleAt:   s.NextScheduleAt,
+		LastSuccessfulAt: s.LastSuccessfulAt,
+		Active:           append([]string{}, s.Active...),
+		Message:          s.Message,
+	}
+}
+
+func (s *CronJobStatus) Equal(target *CronJobStatus) bool {
+	if target == nil {
+		return false
+	}
+	if s.LastScheduleAt != target.LastScheduleAt ||
+		s.NextScheduleAt != target.NextScheduleAt ||
+		s.LastSuccessfulAt != target.LastSuccessfulAt ||
+		s.Message != target.Message ||
+		len(s.Active) != len(target.Active) {
+		return false
+	}
+	for i := range s.Active {
+		if s.Active[i] != target.Active[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func NewCronJobSet() *CronJobSet {
+	return &CronJobSet{
+		Items: []*CronJob{},
+	}
+}
+
+func (s *CronJobSet) Add(item *CronJob) {
+	s.Items = append(s.Items, item)
+}
+
+func NewCreateCronJobRequest() *CreateCronJobRequest {
+	return &CreateCronJobRequest{}
+}
+
+func (req *CreateCronJobRequest) UpdateOwner(tk *token.Token) {
+	req.Domain = tk.Domain
+	req.Namespace = tk.Namespace
+	req.CreateBy = tk.Account
+}
+
+// Validate pipeline的所属信息在创建时和cronjob保持一致, 这里不校验
+func (req *CreateCronJobRequest) Validate() error {
+	if err := validate.StructExcept(req, "Pipeline"); err != nil {
+		return err
+	}
+	if req.TemplateId == "" && req.Pipeline == nil {
+		return fmt.Errorf("template_id or pipeline required")
+	}
+	if _, err := cron.Parse(req.Schedule); err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewQueryCronJobRequest(page *request.PageRequest) *QueryCronJobRequest {
+	return &QueryCronJobRequest{
+		Page: page,
+	}
+}
+
+func NewDescribeCronJobRequest(namespace, id string) *DescribeCronJobRequest {
+	return &DescribeCronJobRequest{
+		Namespace: namespace,
+		Id:        id,
+	}
+}
+
+func (req *DescribeCronJobRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewSuspendCronJobRequest(namespace, id string) *SuspendCronJobRequest {
+	return &SuspendCronJobRequest{
+		Namespace: namespace,
+		Id:        id,
+	}
+}
+
+func (req *SuspendCronJobRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewDeleteCronJobRequest(namespace, id string) *DeleteCronJobRequest {
+	return &DeleteCronJobRequest{
+		Namespace: namespace,
+		Id:        id,
+	}
+}
+
+func (req *DeleteCronJobRequest) Validate() error {
+	return validate.Struct(req)
+}
@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.1
+// source: api/apps/cronjob/pb/cronjob.proto
+
+package cronjob
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ServiceClient interface {
+	CreateCronJob(ctx context.Context, in *CreateCronJobRequest, opts ...grpc.CallOption) (*CronJob, error)
+	QueryCronJob(ctx context.Context, in *QueryCronJobRequest, opts ...grpc.CallOption) (*CronJobSet, error)
+	DescribeCronJob(ctx context.Context, in *DescribeCronJobRequest, opts ...grpc.CallOption) (*CronJob, error)
+	SuspendCronJob(ctx context.Context, in *SuspendCronJobRequest, opts ...grpc.CallOption) (*CronJob, error)
+	DeleteCronJob(ctx context.Context, in *DeleteCronJobRequest, opts ...grpc.CallOption) (*CronJob, error)
+}
+
+type serviceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceClient(cc grpc.ClientConnInterface) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) CreateCronJob(ctx context.Context, in *CreateCronJobRequest, opts ...grpc.CallOption) (*CronJob, error) {
+	out := new(CronJob)
+	err := c.cc.Invoke(ctx, "/infraboard.workflow.cronjob.Service/CreateCronJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) QueryCronJob(ctx context.Context, in *QueryCronJobRequest, opts ...grpc.CallOption) (*CronJobSet, error) {
+	out := new(CronJobSet)
+	err := c.cc.Invoke(ctx, "/infraboard.workflow.cronjob.Service/QueryCronJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) DescribeCronJob(ctx context.Context, in *DescribeCronJobRequest, opts ...grpc.CallOption) (*CronJob, error) {
+	out := new(CronJob)
+	err := c.cc.Invoke(ctx, "/infraboard.workflow.cronjob.Service/DescribeCronJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SuspendCronJob(ctx context.Context, in *SuspendCronJobRequest, opts ...grpc.CallOption) (*CronJob, error) {
+	out := new(CronJob)
+	err := c.cc.Invoke(ctx, "/infraboard.workflow.cronjob.Service/SuspendCronJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) DeleteCronJob(ctx context.Context, in *DeleteCronJobRequest, opts ...grpc.CallOption) (*CronJob, error) {
+	out := new(CronJob)
+	err := c.cc.Invoke(ctx, "/infraboard.workflow.cronjob.Service/DeleteCronJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+// All implementations must embed UnimplementedServiceServer
+// for forward compatibility
+type ServiceServer interface {
+	CreateCronJob(context.Context, *CreateCronJobRequest) (*CronJob, error)
+	QueryCronJob(context.Context, *QueryCronJobRequest) (*CronJobSet, error)
+	DescribeCronJob(context.Context, *DescribeCronJobRequest) (*CronJob, error)
+	SuspendCronJob(context.Context, *SuspendCronJobRequest) (*CronJob, error)
+	DeleteCronJob(context.Context, *DeleteCronJobRequest) (*CronJob, error)
+	mustEmbedUnimplementedServiceServer()
+}
+
+// UnimplementedServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (UnimplementedServiceServer) CreateCronJob(context.Context, *CreateCronJobRequest) (*CronJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCronJob not implemented")
+}
+func (UnimplementedServiceServer) QueryCronJob(context.Context, *QueryCronJobRequest) (*CronJobSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCronJob not implemented")
+}
+func (UnimplementedServiceServer) DescribeCronJob(context.Context, *DescribeCronJobRequest) (*CronJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeCronJob not implemented")
+}
+func (UnimplementedServiceServer) SuspendCronJob(context.Context, *SuspendCronJobRequest) (*CronJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendCronJob not implemented")
+}
+func (UnimplementedServiceServer) DeleteCronJob(context.Context, *DeleteCronJobRequest) (*CronJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCronJob not implemented")
+}
+func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
+
+// UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceServer will
+// result in compilation errors.
+type UnsafeServiceServer interface {
+	mustEmbedUnimplementedServiceServer()
+}
+
+func RegisterServiceServer(s grpc.ServiceRegistrar, srv ServiceServer) {
+	s.RegisterService(&Service_ServiceDesc, srv)
+}
+
+func _Service_CreateCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCronJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).CreateCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infraboard.workflow.cronjob.Service/CreateCronJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).CreateCronJob(ctx, req.(*CreateCronJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_QueryCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCronJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).QueryCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infraboard.workflow.cronjob.Service/QueryCronJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).QueryCronJob(ctx, req.(*QueryCronJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_DescribeCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeCronJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).DescribeCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infraboard.workflow.cronjob.Service/DescribeCronJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).DescribeCronJob(ctx, req.(*DescribeCronJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SuspendCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendCronJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SuspendCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infraboard.workflow.cronjob.Service/SuspendCronJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SuspendCronJob(ctx, req.(*SuspendCronJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_DeleteCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCronJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).DeleteCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infraboard.workflow.cronjob.Service/DeleteCronJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).DeleteCronJob(ctx, req.(*DeleteCronJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Service_ServiceDesc is the grpc.ServiceDesc for Service service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Service_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "infraboard.workflow.cronjob.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCronJob",
+			Handler:    _Service_CreateCronJob_Handler,
+		},
+		{
+			MethodName: "QueryCronJob",
+			Handler:    _Service_QueryCronJob_Handler,
+		},
+		{
+			MethodName: "DescribeCronJob",
+			Handler:    _Service_DescribeCronJob_Handler,
+		},
+		{
+			MethodName: "SuspendCronJob",
+			Handler:    _Service_SuspendCronJob_Handler,
+		},
+		{
+			MethodName: "DeleteCronJob",
+			Handler:    _Service_DeleteCronJob_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/apps/cronjob/pb/cronjob.proto",
+}
@@ -0,0 +1,177 @@
+package cronjob_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/workflow/api/apps/cronjob"
+	"github.com/infraboard/workflow/api/apps/pipeline"
+)
+
+func SampleCronJob(schedule string) (*cronjob.CronJob, error) {
+	step := pipeline.NewDefaultStep()
+	step.Name = "step01"
+	step.Action = "action01@v1"
+	stage := pipeline.NewDefaultStage()
+	stage.Name = "stage01"
+	stage.AddStep(step)
+
+	req := cronjob.NewCreateCronJobRequest()
+	req.Domain = "domain01"
+	req.Namespace = "namespace01"
+	req.CreateBy = "admin"
+	req.Name = "nightly"
+	req.Schedule = schedule
+	req.Pipeline = pipeline.NewCreatePipelineRequest()
+	req.Pipeline.Name = "build"
+	req.Pipeline.Stages = []*pipeline.Stage{stage}
+	return cronjob.NewCronJob(req)
+}
+
+func TestNewCronJob(t *testing.T) {
+	should := assert.New(t)
+
+	_, err := SampleCronJob("* * *")
+	should.Error(err)
+
+	req := cronjob.NewCreateCronJobRequest()
+	req.Domain = "domain01"
+	req.Namespace = "namespace01"
+	req.CreateBy = "admin"
+	req.Name = "nightly"
+	req.Schedule = "@daily"
+	should.Error(req.Validate())
+
+	cj, err := SampleCronJob("@daily")
+	if should.NoError(err) {
+		should.Equal("namespace01", cj.Pipeline.Namespace)
+
+		cj.Timezone = "Mars/Olympus"
+		should.Error(cj.Validate())
+	}
+}
+
+func TestCronJobDueTimes(t *testing.T) {
+	should := assert.New(t)
+
+	cj, err := SampleCronJob("0 * * * *")
+	if !should.NoError(err) {
+		return
+	}
+	start := time.Date(2021, 10, 15, 9, 30, 0, 0, time.UTC)
+	cj.CreateAt = start.UnixMilli()
+
+	// 还没有到期
+	due, err := cj.DueTimes(start.Add(20 * time.Minute))
+	should.NoError(err)
+	should.Len(due, 0)
+
+	// 调度器停机3个小时
+	now := time.Date(2021, 10, 15, 12, 0, 30, 0, time.UTC)
+	due, err = cj.DueTimes(now)
+	should.NoError(err)
+	if should.Len(due, 3) {
+		should.Equal(10, due[0].Hour())
+		should.Equal(12, due[2].Hour())
+	}
+
+	// 从上一次计划时间开始计算
+	cj.Status.LastScheduleAt = due[1].UnixMilli()
+	due, err = cj.DueTimes(now)
+	should.NoError(err)
+	should.Len(due, 1)
+
+	// 最多补跑MAX_MISSED_RUNS次
+	cj.Status.LastScheduleAt = 0
+	due, err = cj.DueTimes(start.Add(200 * time.Hour))
+	should.NoError(err)
+	should.Len(due, cronjob.MAX_MISSED_RUNS)
+}
+
+func TestCronJobTimezone(t *testing.T) {
+	should := assert.New(t)
+
+	cj, err := SampleCronJob("0 2 * * *")
+	if !should.NoError(err) {
+		return
+	}
+	cj.Timezone = "Asia/Shanghai"
+	cj.CreateAt = time.Date(2021, 10, 15, 0, 0, 0, 0, time.UTC).UnixMilli()
+
+	// 上海时间 02:00 为 UTC 前一天 18:00
+	next, err := cj.NextScheduleTime(time.UnixMilli(cj.CreateAt))
+	if should.NoError(err) {
+		should.Equal(time.Date(2021, 10, 15, 18, 0, 0, 0, time.UTC).Unix(), next.Unix())
+	}
+}
+
+func TestCronJobFilterMissed(t *testing.T) {
+	should := assert.New(t)
+
+	cj, err := SampleCronJob("0 * * * *")
+	if !should.NoError(err) {
+		return
+	}
+	due := []time.Time{
+		time.Date(2021, 10, 15, 10, 0, 0, 0, time.UTC),
+		time.Date(2021, 10, 15, 11, 0, 0, 0, time.UTC),
+		time.Date(2021, 10, 15, 12, 0, 0, 0, time.UTC),
+	}
+	onTime := time.Date(2021, 10, 15, 12, 0, 30, 0, time.UTC)
+	late := time.Date(2021, 10, 15, 12, 30, 0, 0, time.UTC)
+
+	cases := []struct {
+		policy cronjob.MISSED_POLICY
+		now    time.Time
+		expect int
+	}{
+		{cronjob.MISSED_POLICY_SKIP, onTime, 1},
+		{cronjob.MISSED_POLICY_SKIP, late, 0},
+		{cronjob.MISSED_POLICY_RUN_ONCE, late, 1},
+		{cronjob.MISSED_POLICY_CATCH_UP, late, 3},
+	}
+	for _, c := range cases {
+		cj.MissedPolicy = c.policy
+		runs := cj.FilterMissed(due, c.now)
+		should.Len(runs, c.expect, c.policy.String())
+		if len(runs) > 0 {
+			should.Equal(due[2], runs[len(runs)-1])
+		}
+	}
+
+	// 调整StartingDeadline后, 延迟30分钟仍然可以运行
+	cj.MissedPolicy = cronjob.MISSED_POLICY_SKIP
+	cj.StartingDeadline = 3600
+	should.Len(cj.FilterMissed(due, late), 1)
+}
+
+func TestCronJobNewPipeline(t *testing.T) {
+	should := assert.New(t)
+
+	cj, err := SampleCronJob("0 * * * *")
+	if !should.NoError(err) {
+		return
+	}
+	at := time.Date(2021, 10, 15, 10, 0, 0, 0, time.UTC)
+
+	p1, err := cj.NewPipeline(at)
+	if should.NoError(err) {
+		should.Equal(cj.PipelineId(at), p1.Id)
+		should.Equal(cj.Id, p1.CronjobId)
+		should.True(cj.IsOwnerOf(p1))
+	}
+
+	// 每次创建的pipeline互不影响
+	p2, err := cj.NewPipeline(at.Add(time.Hour))
+	if should.NoError(err) {
+		should.NotEqual(p1.Id, p2.Id)
+		p2.Stages[0].Steps[0].Name = "changed"
+		should.Equal("step01", p1.Stages[0].Steps[0].Name)
+		should.Equal("step01", cj.Pipeline.Stages[0].Steps[0].Name)
+	}
+
+	should.Equal(3, cj.HistoryLimit(false))
+	should.Equal(1, cj.HistoryLimit(true))
+}
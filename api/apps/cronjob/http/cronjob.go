@@ -0,0 +1,116 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/infraboard/keyauth/app/token"
+	"github.com/infraboard/mcube/http/context"
+	"github.com/infraboard/mcube/http/request"
+	"github.com/infraboard/mcube/http/response"
+
+	"github.com/infraboard/workflow/api/apps/cronjob"
+)
+
+func (h *handler) CreateCronJob(w http.ResponseWriter, r *http.Request) {
+	ctx := context.GetContext(r)
+	tk := ctx.AuthInfo.(*token.Token)
+
+	req := cronjob.NewCreateCronJobRequest()
+	if err := request.GetDataFromRequest(r, req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.UpdateOwner(tk)
+
+	ins, err := h.service.CreateCronJob(
+		r.Context(),
+		req,
+	)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	response.Success(w, ins)
+}
+
+func (h *handler) QueryCronJob(w http.ResponseWriter, r *http.Request) {
+	ctx := context.GetContext(r)
+	tk := ctx.AuthInfo.(*token.Token)
+
+	page := request.NewPageRequestFromHTTP(r)
+	req := cronjob.NewQueryCronJobRequest(page)
+	req.Namespace = tk.Namespace
+	req.Name = r.URL.Query().Get("name")
+
+	set, err := h.service.QueryCronJob(
+		r.Context(),
+		req,
+	)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	response.Success(w, set)
+}
+
+func (h *handler) DescribeCronJob(w http.ResponseWriter, r *http.Request) {
+	ctx := context.GetContext(r)
+	tk := ctx.AuthInfo.(*token.Token)
+
+	req := cronjob.NewDescribeCronJobRequest(tk.Namespace, ctx.PS.ByName("id"))
+	ins, err := h.service.DescribeCronJob(
+		r.Context(),
+		req,
+	)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	response.Success(w, ins)
+}
+
+func (h *handler) SuspendCronJob(w http.ResponseWriter, r *http.Request) {
+	h.suspendCronJob(w, r, true)
+}
+
+func (h *handler) ResumeCronJob(w http.ResponseWriter, r *http.Request) {
+	h.suspendCronJob(w, r, false)
+}
+
+func (h *handler) suspendCronJob(w http.ResponseWriter, r *http.Request, suspend bool) {
+	ctx := context.GetContext(r)
+	tk := ctx.AuthInfo.(*token.Token)
+
+	req := cronjob.NewSuspendCronJobRequest(tk.Namespace, ctx.PS.ByName("id"))
+	req.Suspend = suspend
+	ins, err := h.service.SuspendCronJob(
+		r.Context(),
+		req,
+	)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	response.Success(w, ins)
+}
+
+func (h *handler) DeleteCronJob(w http.ResponseWriter, r *http.Request) {
+	ctx := context.GetContext(r)
+	tk := ctx.AuthInfo.(*token.Token)
+
+	req := cronjob.NewDeleteCronJobRequest(tk.Namespace, ctx.PS.ByName("id"))
+	ins, err := h.service.DeleteCronJob(
+		r.Context(),
+		req,
+	)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	response.Success(w, ins)
+}
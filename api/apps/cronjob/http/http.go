@@ -0,0 +1,46 @@
+package http
+
+import (
+	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/http/label"
+	"github.com/infraboard/mcube/http/router"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+
+	"github.com/infraboard/workflow/api/apps/cronjob"
+)
+
+var (
+	api = &handler{log: zap.L().Named("CronJob")}
+)
+
+type handler struct {
+	service cronjob.ServiceServer
+	log     logger.Logger
+}
+
+// Registry 注册HTTP服务路由
+func (h *handler) Registry(router router.SubRouter) {
+	r := router.ResourceRouter("cronjobs")
+	r.Permission(true)
+	r.BasePath("cronjobs")
+	r.Handle("POST", "/", h.CreateCronJob).AddLabel(label.Create)
+	r.Handle("GET", "/", h.QueryCronJob).AddLabel(label.List)
+	r.Handle("GET", "/:id", h.DescribeCronJob).AddLabel(label.Get)
+	r.Handle("POST", "/:id/suspend", h.SuspendCronJob).AddLabel(label.Update)
+	r.Handle("POST", "/:id/resume", h.ResumeCronJob).AddLabel(label.Update)
+	r.Handle("DELETE", "/:id", h.DeleteCronJob).AddLabel(label.Delete)
+}
+
+func (h *handler) Config() error {
+	h.service = app.GetGrpcApp(cronjob.AppName).(cronjob.ServiceServer)
+	return nil
+}
+
+func (h *handler) Name() string {
+	return cronjob.AppName
+}
+
+func init() {
+	app.RegistryHttpApp(api)
+}
@@ -0,0 +1,171 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/infraboard/mcube/exception"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/infraboard/workflow/api/apps/cronjob"
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/api/apps/template"
+)
+
+func (i *impl) CreateCronJob(ctx context.Context, req *cronjob.CreateCronJobRequest) (
+	*cronjob.CronJob, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest("validate create cronjob error, %s", err)
+	}
+
+	// 使用模版中的pipeline, 创建后模版的修改不影响cronjob
+	if req.TemplateId != "" {
+		if req.Pipeline != nil {
+			return nil, exception.NewBadRequest("template_id and pipeline can't be used together")
+		}
+		p, err := i.getTemplatePipeline(ctx, req.TemplateId, req.PipelineName)
+		if err != nil {
+			return nil, err
+		}
+		req.Pipeline = p
+	}
+
+	ins, err := cronjob.NewCronJob(req)
+	if err != nil {
+		return nil, exception.NewBadRequest("validate create cronjob error, %s", err)
+	}
+
+	next, err := ins.NextScheduleTime(time.Now())
+	if err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+	if !next.IsZero() {
+		ins.Status.NextScheduleAt = next.UnixMilli()
+	}
+
+	if err := i.putCronJob(ctx, ins); err != nil {
+		return nil, err
+	}
+	i.log.Debugf("create cronjob success, key: %s", ins.MakeObjectKey())
+	return ins, nil
+}
+
+func (i *impl) getTemplatePipeline(ctx context.Context, id, name string) (
+	*pipeline.CreatePipelineRequest, error) {
+	t, err := i.template.DescribeTemplate(ctx, template.NewDescribeTemplateRequestWithID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := t.GetPipeline(name)
+	if err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+	p.TemplateRevision = t.Revision
+	return p, nil
+}
+
+func (i *impl) QueryCronJob(ctx context.Context, req *cronjob.QueryCronJobRequest) (
+	*cronjob.CronJobSet, error) {
+	listKey := cronjob.EtcdCronJobPrefix()
+	if req.Namespace != "" {
+		listKey = cronjob.CronJobObjectKey(req.Namespace, "")
+	}
+	i.log.Infof("list etcd cronjob resource key: %s", listKey)
+	resp, err := i.client.Get(ctx, listKey, clientv3.WithPrefix())
+	if err != nil {
+		return nil, exception.NewInternalServerError("list cronjob error, %s", err)
+	}
+
+	set := cronjob.NewCronJobSet()
+	for index := range resp.Kvs {
+		// 解析对象
+		ins, err := cronjob.LoadCronJobFromBytes(resp.Kvs[index].Value)
+		if err != nil {
+			i.log.Error(err)
+			continue
+		}
+		if req.Name != "" && !strings.Contains(ins.Name, req.Name) {
+			continue
+		}
+		ins.ResourceVersion = resp.Kvs[index].ModRevision
+		set.Add(ins)
+	}
+	set.Total = int64(len(set.Items))
+	return set, nil
+}
+
+func (i *impl) DescribeCronJob(ctx context.Context, req *cronjob.DescribeCronJobRequest) (
+	*cronjob.CronJob, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest("validate describe cronjob error, %s", err)
+	}
+
+	descKey := cronjob.CronJobObjectKey(req.Namespace, req.Id)
+	i.log.Infof("describe etcd cronjob resource key: %s", descKey)
+	resp, err := i.client.Get(ctx, descKey)
+	if err != nil {
+		return nil, exception.NewInternalServerError("describe cronjob error, %s", err)
+	}
+
+	if resp.Count == 0 {
+		return nil, exception.NewNotFound("cronjob %s not found", req.Id)
+	}
+
+	ins, err := cronjob.LoadCronJobFromBytes(resp.Kvs[0].Value)
+	if err != nil {
+		return nil, exception.NewInternalServerError(err.Error())
+	}
+	ins.ResourceVersion = resp.Kvs[0].ModRevision
+	return ins, nil
+}
+
+// SuspendCronJob 恢复调度时, 暂停期间错过的运行按照MissedPolicy处理
+func (i *impl) SuspendCronJob(ctx context.Context, req *cronjob.SuspendCronJobRequest) (
+	*cronjob.CronJob, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest("validate suspend cronjob error, %s", err)
+	}
+
+	ins, err := i.DescribeCronJob(ctx, cronjob.NewDescribeCronJobRequest(req.Namespace, req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	ins.Suspend = req.Suspend
+	if err := i.putCronJob(ctx, ins); err != nil {
+		return nil, err
+	}
+	return ins, nil
+}
+
+// DeleteCronJob 已经创建的pipeline不会被删除
+func (i *impl) DeleteCronJob(ctx context.Context, req *cronjob.DeleteCronJobRequest) (
+	*cronjob.CronJob, error) {
+	ins, err := i.DescribeCronJob(ctx, cronjob.NewDescribeCronJobRequest(req.Namespace, req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	descKey := ins.MakeObjectKey()
+	i.log.Infof("delete etcd cronjob resource key: %s", descKey)
+	if _, err := i.client.Delete(ctx, descKey); err != nil {
+		return nil, exception.NewInternalServerError("delete cronjob error, %s", err)
+	}
+	return ins, nil
+}
+
+func (i *impl) putCronJob(ctx context.Context, ins *cronjob.CronJob) error {
+	value, err := json.Marshal(ins)
+	if err != nil {
+		return exception.NewInternalServerError(err.Error())
+	}
+
+	objKey := ins.MakeObjectKey()
+	if _, err := i.client.Put(ctx, objKey, string(value)); err != nil {
+		return exception.NewInternalServerError("put cronjob with key: %s, error, %s", objKey, err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package impl
+
+import (
+	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc"
+
+	"github.com/infraboard/workflow/api/apps/cronjob"
+	"github.com/infraboard/workflow/api/apps/template"
+	"github.com/infraboard/workflow/conf"
+)
+
+var (
+	// Service 服务实例
+	svr = &impl{}
+)
+
+type impl struct {
+	client   *clientv3.Client
+	log      logger.Logger
+	template template.ServiceServer
+
+	cronjob.UnimplementedServiceServer
+}
+
+func (s *impl) Config() error {
+	s.log = zap.L().Named("CronJob")
+	s.client = conf.C().Etcd.GetClient()
+	s.template = app.GetGrpcApp(template.AppName).(template.ServiceServer)
+	return nil
+}
+
+func (s *impl) Name() string {
+	return cronjob.AppName
+}
+
+func (s *impl) Registry(server *grpc.Server) {
+	cronjob.RegisterServiceServer(server, svr)
+}
+
+func init() {
+	app.RegistryGrpcApp(svr)
+}
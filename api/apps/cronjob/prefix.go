@@ -0,0 +1,16 @@
+package cronjob
+
+import (
+	"fmt"
+
+	"github.com/infraboard/workflow/conf"
+	"github.com/infraboard/workflow/version"
+)
+
+func CronJobObjectKey(namespace, id string) string {
+	return fmt.Sprintf("%s/%s/%s", EtcdCronJobPrefix(), namespace, id)
+}
+
+func EtcdCronJobPrefix() string {
+	return fmt.Sprintf("%s/%s/cronjobs", conf.C().Etcd.Prefix, version.ServiceName)
+}
@@ -0,0 +1,86 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/infraboard/keyauth/common/header"
+	"github.com/infraboard/mcube/exception"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/infraboard/workflow/conf"
+)
+
+const (
+	// 节点HTTP接口的前缀, 只允许内部服务通过keyauth服务凭证调用
+	NODE_API_PREFIX = "/workflow/node/v1"
+	// 节点返回的日志在整个step日志中开始的位置
+	STEP_LOG_OFFSET_HEADER = "X-Step-Log-Offset"
+)
+
+// StepLogPath 节点上读取step日志的接口
+func StepLogPath(key string) string {
+	return fmt.Sprintf("%s/steps/%s/log", NODE_API_PREFIX, url.PathEscape(key))
+}
+
+// StepTerminalPath 节点上连接step执行环境的websocket接口
+func StepTerminalPath(key string) string {
+	return fmt.Sprintf("%s/steps/%s/terminal", NODE_API_PREFIX, url.PathEscape(key))
+}
+
+// DescribeNode 查询在线的节点, 节点下线后会从etcd中删除
+func DescribeNode(ctx context.Context, client *clientv3.Client, t Type, name string) (*Node, error) {
+	key := fmt.Sprintf("%s/%s", EtcdNodePrefixWithType(t), name)
+	resp, err := client.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Count == 0 {
+		return nil, exception.NewNotFound("node %s not online", name)
+	}
+
+	n, err := LoadNodeFromBytes(resp.Kvs[0].Value)
+	if err != nil {
+		return nil, err
+	}
+	if n == nil || n.Address == "" {
+		return nil, exception.NewNotFound("node %s has no address", name)
+	}
+	return n, nil
+}
+
+// URL 节点接口的完整地址
+func (n *Node) URL(path string) string {
+	return fmt.Sprintf("http://%s%s", n.Address, path)
+}
+
+// WebsocketURL 节点websocket接口的完整地址
+func (n *Node) WebsocketURL(path string) string {
+	return fmt.Sprintf("ws://%s%s", n.Address, path)
+}
+
+// NewClientCredentialHeader 调用节点接口时使用的服务凭证
+func NewClientCredentialHeader() http.Header {
+	h := http.Header{}
+	h.Set(header.ClientHeaderKey, conf.C().Keyauth.ClientID)
+	h.Set(header.ClientSecretKey, conf.C().Keyauth.ClientSecret)
+	return h
+}
+
+const (
+	// 客户端的输入
+	TERMINAL_OP_STDIN = "stdin"
+	// 客户端终端的大小变化
+	TERMINAL_OP_RESIZE = "resize"
+)
+
+// TerminalMessage 终端连接中客户端发送的消息, 使用json格式的文本消息
+// 终端的输出使用二进制消息原样发送给客户端
+type TerminalMessage struct {
+	Op   string `json:"op"`
+	Data string `json:"data,omitempty"`
+	Cols uint16 `json:"cols,omitempty"`
+	Rows uint16 `json:"rows,omitempty"`
+}
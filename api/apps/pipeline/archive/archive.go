@@ -0,0 +1,208 @@
+package archive
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/exception"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/bsonx"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+)
+
+const (
+	PIPELINE_COLLECTION = "pipeline_archives"
+	STEP_COLLECTION     = "step_archives"
+)
+
+// NewStore 已经结束的pipeline和step的归档存储
+func NewStore(db *mongo.Database) (*Store, error) {
+	pc := db.Collection(PIPELINE_COLLECTION)
+	_, err := pc.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bsonx.Doc{
+				{Key: "namespace", Value: bsonx.Int32(1)},
+				{Key: "create_at", Value: bsonx.Int32(-1)},
+			},
+		},
+		{
+			Keys: bsonx.Doc{
+				{Key: "template_id", Value: bsonx.Int32(1)},
+				{Key: "create_at", Value: bsonx.Int32(-1)},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sc := db.Collection(STEP_COLLECTION)
+	_, err = sc.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bsonx.Doc{{Key: "key", Value: bsonx.Int32(1)}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bsonx.Doc{{Key: "pipeline_id", Value: bsonx.Int32(1)}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{pipeline: pc, step: sc}, nil
+}
+
+// Store 归档存储
+type Store struct {
+	pipeline *mongo.Collection
+	step     *mongo.Collection
+}
+
+// Save 保存pipeline和它的step, 重复保存会覆盖之前的数据
+// 先保存step, 保证可以查询到pipeline时, step都已经归档
+func (s *Store) Save(ctx context.Context, p *pipeline.Pipeline, steps []*pipeline.Step) error {
+	opt := options.Replace().SetUpsert(true)
+	for i := range steps {
+		if _, err := s.step.ReplaceOne(ctx, bson.M{"key": steps[i].Key}, steps[i], opt); err != nil {
+			return exception.NewInternalServerError("archive step %s error, %s", steps[i].Key, err)
+		}
+	}
+
+	if _, err := s.pipeline.ReplaceOne(ctx, bson.M{"_id": p.Id}, p, opt); err != nil {
+		return exception.NewInternalServerError("archive pipeline %s error, %s", p.ShortDescribe(), err)
+	}
+	return nil
+}
+
+// DescribePipeline 查询归档的pipeline, namespace为空时不限制空间
+func (s *Store) DescribePipeline(ctx context.Context, namespace, id string) (*pipeline.Pipeline, error) {
+	filter := bson.M{"_id": id}
+	if namespace != "" {
+		filter["namespace"] = namespace
+	}
+
+	ins := pipeline.NewDefaultPipeline()
+	if err := s.pipeline.FindOne(ctx, filter).Decode(ins); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, exception.NewNotFound("pipeline %s not found", id)
+		}
+		return nil, exception.NewInternalServerError("find archived pipeline %s error, %s", id, err)
+	}
+	return ins, nil
+}
+
+// DescribeStep 查询归档的step
+func (s *Store) DescribeStep(ctx context.Context, key string) (*pipeline.Step, error) {
+	ins := pipeline.NewDefaultStep()
+	if err := s.step.FindOne(ctx, bson.M{"key": key}).Decode(ins); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, exception.NewNotFound("step %s not found", key)
+		}
+		return nil, exception.NewInternalServerError("find archived step %s error, %s", key, err)
+	}
+	return ins, nil
+}
+
+// DeletePipeline 删除归档的pipeline和它的step
+func (s *Store) DeletePipeline(ctx context.Context, p *pipeline.Pipeline) error {
+	if _, err := s.step.DeleteMany(ctx, bson.M{"pipeline_id": p.Id}); err != nil {
+		return exception.NewInternalServerError("delete archived pipeline %s steps error, %s", p.ShortDescribe(), err)
+	}
+	if _, err := s.pipeline.DeleteOne(ctx, bson.M{"_id": p.Id}); err != nil {
+		return exception.NewInternalServerError("delete archived pipeline %s error, %s", p.ShortDescribe(), err)
+	}
+	return nil
+}
+
+// Unarchive 从归档中移除pipeline, 用于pipeline重新回到etcd中运行, 已经归档的step保留
+func (s *Store) Unarchive(ctx context.Context, p *pipeline.Pipeline) error {
+	if _, err := s.pipeline.DeleteOne(ctx, bson.M{"_id": p.Id}); err != nil {
+		return exception.NewInternalServerError("unarchive pipeline %s error, %s", p.ShortDescribe(), err)
+	}
+	return nil
+}
+
+// QueryPipeline 查询归档的pipeline, 按照id倒序, 即最新创建的在前面
+// cursor为上一页最后一个pipeline的id, 只返回在它之后的pipeline, limit为0时只统计总数
+// 返回的total不受cursor影响, hasMore表示当前页之后是否还有满足条件的pipeline
+func (s *Store) QueryPipeline(ctx context.Context, req *pipeline.QueryPipelineRequest, cursor string, skip, limit int64) (
+	set *pipeline.PipelineSet, hasMore bool, err error) {
+	filter := PipelineFilter(req)
+	total, err := s.pipeline.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, false, exception.NewInternalServerError("count archived pipeline error, %s", err)
+	}
+
+	set = pipeline.NewPipelineSet()
+	set.Total = total
+	if cursor != "" {
+		filter["_id"] = bson.M{"$lt": cursor}
+	}
+
+	if limit == 0 {
+		remain, err := s.pipeline.CountDocuments(ctx, filter, options.Count().SetSkip(skip).SetLimit(1))
+		if err != nil {
+			return nil, false, exception.NewInternalServerError("count archived pipeline error, %s", err)
+		}
+		return set, remain > 0, nil
+	}
+
+	// 多读取一个, 判断是否还有下一页
+	opt := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetSkip(skip).
+		SetLimit(limit + 1)
+	resp, err := s.pipeline.Find(ctx, filter, opt)
+	if err != nil {
+		return nil, false, exception.NewInternalServerError("find archived pipeline error, %s", err)
+	}
+	defer resp.Close(ctx)
+
+	for resp.Next(ctx) {
+		if int64(len(set.Items)) == limit {
+			hasMore = true
+			break
+		}
+		ins := pipeline.NewDefaultPipeline()
+		if err := resp.Decode(ins); err != nil {
+			return nil, false, exception.NewInternalServerError("decode archived pipeline error, %s", err)
+		}
+		set.Add(ins)
+	}
+	return set, hasMore, nil
+}
+
+// PipelineFilter 查询条件对应的mongodb过滤条件
+func PipelineFilter(req *pipeline.QueryPipelineRequest) bson.M {
+	filter := bson.M{}
+	if req.Namespace != "" {
+		filter["namespace"] = req.Namespace
+	}
+	if req.Name != "" {
+		filter["name"] = req.Name
+	}
+	if req.TemplateId != "" {
+		filter["template_id"] = req.TemplateId
+	}
+	for k, v := range req.Tags {
+		filter["tags."+k] = v
+	}
+	if len(req.Status) > 0 {
+		filter["status.status"] = bson.M{"$in": req.Status}
+	}
+
+	createAt := bson.M{}
+	if req.CreateStartAt > 0 {
+		createAt["$gte"] = req.CreateStartAt
+	}
+	if req.CreateEndAt > 0 {
+		createAt["$lt"] = req.CreateEndAt
+	}
+	if len(createAt) > 0 {
+		filter["create_at"] = createAt
+	}
+	return filter
+}
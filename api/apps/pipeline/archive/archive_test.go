@@ -0,0 +1,30 @@
+package archive_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/api/apps/pipeline/archive"
+)
+
+func TestPipelineFilter(t *testing.T) {
+	should := assert.New(t)
+
+	req := pipeline.NewQueryPipelineRequest()
+	should.Equal(bson.M{}, archive.PipelineFilter(req))
+
+	req.Namespace = "ns01"
+	req.TemplateId = "t01"
+	req.Tags = map[string]string{"env": "prod"}
+	req.Status = []pipeline.PIPELINE_STATUS{pipeline.PIPELINE_STATUS_FAILED}
+	req.CreateStartAt = 1000
+	filter := archive.PipelineFilter(req)
+	should.Equal("ns01", filter["namespace"])
+	should.Equal("t01", filter["template_id"])
+	should.Equal("prod", filter["tags.env"])
+	should.Equal(bson.M{"$in": req.Status}, filter["status.status"])
+	should.Equal(bson.M{"$gte": int64(1000)}, filter["create_at"])
+}
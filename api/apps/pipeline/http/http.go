@@ -29,9 +29,17 @@ func (h *handler) Registry(router router.SubRouter) {
 	r.Handle("GET", "/", h.QueryPipeline).AddLabel(label.List)
 	r.Handle("GET", "/:id", h.DescribePipeline).AddLabel(label.Get)
 	r.Handle("DELETE", "/:id", h.DeletePipeline).AddLabel(label.Delete)
+	r.Handle("POST", "/:id/rerun", h.RerunPipeline).AddLabel(label.Create)
+	r.Handle("POST", "/:id/retry", h.RetryPipeline).AddLabel(label.Update)
+	r.Handle("POST", "/:id/cancel", h.CancelPipeline).AddLabel(label.Update)
 	r.Handle("GET", "/:id/watch_check", h.WatchPipelineCheck).AddLabel(label.Get)
 	r.BasePath("websocket")
 	r.Handle("GET", "pipelines/:id/watch", h.WatchPipeline).AddLabel(label.Get)
+	r.Handle("GET", "steps/:id/log", h.WatchStepLog).AddLabel(label.Get)
+	r.Handle("GET", "steps/:id/terminal", h.StepTerminal).AddLabel(label.Update)
+
+	r.BasePath("templates")
+	r.Handle("GET", "/:id/pipelines", h.QueryPipelineHistory).AddLabel(label.List)
 
 	r.BasePath("steps")
 	r.Handle("GET", "/", h.QueryStep).AddLabel(label.List)
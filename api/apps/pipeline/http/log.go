@@ -0,0 +1,99 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/infraboard/keyauth/app/token"
+	"github.com/infraboard/mcube/exception"
+	hc "github.com/infraboard/mcube/http/context"
+	"github.com/infraboard/mcube/http/response"
+	"google.golang.org/grpc"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+)
+
+// WatchStepLog 通过websocket读取step的日志, 每条消息为一行日志
+func (h *handler) WatchStepLog(w http.ResponseWriter, r *http.Request) {
+	ctx := hc.GetContext(r)
+	tk := ctx.AuthInfo.(*token.Token)
+
+	req, err := newWatchStepLogRequestFromHTTP(r)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.Key = ctx.PS.ByName("id")
+	req.Namespace = tk.Namespace
+
+	if err := req.Validate(); err != nil {
+		response.Failed(w, exception.NewBadRequest(err.Error()))
+		return
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		h.log.Errorf("error upgrading websocket: %s", err)
+		return
+	}
+	defer conn.Close()
+
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	go func() {
+		err := h.service.WatchStepLog(req, newStepLogStream(r.Context(), pw))
+		if err != nil && r.Context().Err() == nil {
+			// 比如step不存在, 通过关闭消息告诉客户端原因
+			h.log.Debugf("watch step %s log error, %s", req.Key, err)
+			msg := websocket.FormatCloseMessage(websocket.CloseInternalServerErr, closeReason(err))
+			conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(time.Second))
+		}
+		pw.CloseWithError(err)
+	}()
+
+	NewProxy().Proxy(r.Context(), conn, &stepLogDumpper{pr})
+}
+
+// websocket关闭消息的原因最长123字节
+func closeReason(err error) string {
+	reason := err.Error()
+	if len(reason) > 123 {
+		reason = reason[:123]
+	}
+	return reason
+}
+
+func newStepLogStream(ctx context.Context, w io.Writer) *stepLogStream {
+	return &stepLogStream{
+		ctx: ctx,
+		w:   w,
+	}
+}
+
+// stepLogStream 把grpc stream的日志写入writer
+type stepLogStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	w   io.Writer
+}
+
+func (s *stepLogStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *stepLogStream) Send(l *pipeline.StepLog) error {
+	_, err := s.w.Write(l.Data)
+	return err
+}
+
+// stepLogDumpper 日志只读, 忽略客户端发送的消息
+type stepLogDumpper struct {
+	io.Reader
+}
+
+func (d *stepLogDumpper) Write(buf []byte) (n int, err error) {
+	return len(buf), nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/infraboard/keyauth/app/token"
+	"github.com/infraboard/mcube/exception"
 	"github.com/infraboard/mcube/http/context"
 	"github.com/infraboard/mcube/http/request"
 	"github.com/infraboard/mcube/http/response"
@@ -38,9 +39,15 @@ func (h *handler) CreatePipeline(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *handler) QueryPipeline(w http.ResponseWriter, r *http.Request) {
-	page := request.NewPageRequestFromHTTP(r)
-	req := pipeline.NewQueryPipelineRequest()
-	req.Page = page
+	ctx := context.GetContext(r)
+	tk := ctx.AuthInfo.(*token.Token)
+
+	req, err := newQueryPipelineRequestFromHTTP(r)
+	if err != nil {
+		response.Failed(w, exception.NewBadRequest("parse query pipeline request error, %s", err))
+		return
+	}
+	req.Namespace = tk.Namespace
 
 	set, err := h.service.QueryPipeline(
 		r.Context(),
@@ -53,6 +60,26 @@ func (h *handler) QueryPipeline(w http.ResponseWriter, r *http.Request) {
 	response.Success(w, set)
 }
 
+// QueryPipelineHistory 查询模版已经结束的pipeline, 包括已经归档的
+func (h *handler) QueryPipelineHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := context.GetContext(r)
+	tk := ctx.AuthInfo.(*token.Token)
+
+	req := pipeline.NewQueryPipelineHistoryRequest(tk.Namespace, ctx.PS.ByName("id"))
+	req.Page = &request.NewPageRequestFromHTTP(r).PageRequest
+	req.Cursor = r.URL.Query().Get("cursor")
+
+	set, err := h.service.QueryPipelineHistory(
+		r.Context(),
+		req,
+	)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, set)
+}
+
 func (h *handler) DescribePipeline(w http.ResponseWriter, r *http.Request) {
 	ctx := context.GetContext(r)
 	tk := ctx.AuthInfo.(*token.Token)
@@ -90,6 +117,58 @@ func (h *handler) DeletePipeline(w http.ResponseWriter, r *http.Request) {
 	response.Success(w, dommains)
 }
 
+func (h *handler) RerunPipeline(w http.ResponseWriter, r *http.Request) {
+	ctx := context.GetContext(r)
+	tk := ctx.AuthInfo.(*token.Token)
+
+	req := pipeline.NewRerunPipelineRequest(tk.Namespace, ctx.PS.ByName("id"))
+	req.CreateBy = tk.Account
+
+	ins, err := h.service.RerunPipeline(
+		r.Context(),
+		req,
+	)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) RetryPipeline(w http.ResponseWriter, r *http.Request) {
+	ctx := context.GetContext(r)
+	tk := ctx.AuthInfo.(*token.Token)
+
+	req := pipeline.NewRetryPipelineRequest(tk.Namespace, ctx.PS.ByName("id"))
+	ins, err := h.service.RetryPipeline(
+		r.Context(),
+		req,
+	)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) CancelPipeline(w http.ResponseWriter, r *http.Request) {
+	ctx := context.GetContext(r)
+	tk := ctx.AuthInfo.(*token.Token)
+
+	req := pipeline.NewCancelPipelineRequest(tk.Namespace, ctx.PS.ByName("id"))
+	req.Message = r.URL.Query().Get("message")
+
+	ins, err := h.service.CancelPipeline(
+		r.Context(),
+		req,
+	)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
 func (h *handler) WatchPipelineCheck(w http.ResponseWriter, r *http.Request) {
 	ctx := context.GetContext(r)
 	tk := ctx.AuthInfo.(*token.Token)
@@ -31,10 +31,11 @@ type Proxy struct {
 }
 
 func (p *Proxy) Proxy(ctx context.Context, conn *websocket.Conn, stream io.ReadWriter) {
+	// 读写任意一端结束时都会调用cancel, 需要在启动前设置
+	ctx, p.cancel = context.WithCancel(ctx)
 	go p.handleRead(ctx, conn, stream)
 	go p.handleWrite(ctx, conn, stream)
 
-	ctx, p.cancel = context.WithCancel(ctx)
 	p.handlePing(ctx, conn)
 	p.log.Debug("proxy down")
 }
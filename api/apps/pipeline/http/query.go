@@ -0,0 +1,110 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/infraboard/mcube/http/request"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+)
+
+// 从URL参数中解析pipeline的查询条件
+// 标签使用tag参数, 格式为key=value, 可以指定多个
+func newQueryPipelineRequestFromHTTP(r *http.Request) (*pipeline.QueryPipelineRequest, error) {
+	qs := r.URL.Query()
+
+	req := pipeline.NewQueryPipelineRequest()
+	req.Page = &request.NewPageRequestFromHTTP(r).PageRequest
+	req.Name = qs.Get("name")
+	req.TemplateId = qs.Get("template_id")
+	req.Cursor = qs.Get("cursor")
+	if err := req.ParseStatus(qs.Get("status")); err != nil {
+		return nil, err
+	}
+
+	tags, err := parseTags(qs["tag"])
+	if err != nil {
+		return nil, err
+	}
+	req.Tags = tags
+
+	if req.CreateStartAt, err = parseInt64(qs.Get("create_start_at")); err != nil {
+		return nil, err
+	}
+	if req.CreateEndAt, err = parseInt64(qs.Get("create_end_at")); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// 从URL参数中解析step的查询条件
+func newQueryStepRequestFromHTTP(r *http.Request) (*pipeline.QueryStepRequest, error) {
+	qs := r.URL.Query()
+
+	req := pipeline.NewQueryStepRequest()
+	req.Page = &request.NewPageRequestFromHTTP(r).PageRequest
+	req.Key = qs.Get("key")
+	req.PipelineId = qs.Get("pipeline_id")
+	req.Name = qs.Get("name")
+	req.Cursor = qs.Get("cursor")
+	if err := req.ParseStatus(qs.Get("status")); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if req.CreateStartAt, err = parseInt64(qs.Get("create_start_at")); err != nil {
+		return nil, err
+	}
+	if req.CreateEndAt, err = parseInt64(qs.Get("create_end_at")); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func newWatchStepLogRequestFromHTTP(r *http.Request) (*pipeline.WatchStepLogRequest, error) {
+	qs := r.URL.Query()
+
+	req := pipeline.NewWatchStepLogRequest("")
+	req.Follow = qs.Get("follow") == "true"
+
+	var err error
+	if req.Offset, err = parseInt64(qs.Get("offset")); err != nil {
+		return nil, err
+	}
+	tail, err := parseInt64(qs.Get("tail"))
+	if err != nil {
+		return nil, err
+	}
+	req.Tail = int32(tail)
+	return req, nil
+}
+
+func parseTags(items []string) (map[string]string, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	tags := make(map[string]string, len(items))
+	for _, item := range items {
+		kv := strings.SplitN(item, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("tag format error, must be key=value, but %s", item)
+		}
+		tags[kv[0]] = kv[1]
+	}
+	return tags, nil
+}
+
+func parseInt64(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse %s to int64 error, %s", s, err)
+	}
+	return v, nil
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/infraboard/keyauth/app/token"
+	"github.com/infraboard/mcube/exception"
 	"github.com/infraboard/mcube/grpc/gcontext"
 	"github.com/infraboard/mcube/http/context"
 	"github.com/infraboard/mcube/http/request"
@@ -53,9 +54,19 @@ func (h *handler) QueryStep(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	page := request.NewPageRequestFromHTTP(r)
-	req := pipeline.NewQueryStepRequest()
-	req.Page = page
+	hc := context.GetContext(r)
+	tk, ok := hc.AuthInfo.(*token.Token)
+	if !ok {
+		response.Failed(w, fmt.Errorf("auth info is not an *token.Token"))
+		return
+	}
+
+	req, err := newQueryStepRequestFromHTTP(r)
+	if err != nil {
+		response.Failed(w, exception.NewBadRequest("parse query step request error, %s", err))
+		return
+	}
+	req.Namespace = tk.Namespace
 
 	dommains, err := h.service.QueryStep(
 		ctx.Context(),
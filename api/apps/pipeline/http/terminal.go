@@ -0,0 +1,110 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/infraboard/keyauth/app/token"
+	"github.com/infraboard/mcube/exception"
+	"github.com/infraboard/mcube/grpc/gcontext"
+	hc "github.com/infraboard/mcube/http/context"
+	"github.com/infraboard/mcube/http/response"
+
+	"github.com/infraboard/workflow/api/apps/node"
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/conf"
+)
+
+// StepTerminal 通过websocket连接到运行中的step的执行环境, 由API Server转发到运行step的节点
+// 客户端发送json格式的node.TerminalMessage, 终端的输出为二进制消息
+// 参数:
+//   command: 执行的命令, 可以重复传入作为命令的参数, 默认为/bin/sh
+//   tty: 是否分配终端, 默认为true
+func (h *handler) StepTerminal(w http.ResponseWriter, r *http.Request) {
+	ctx, err := gcontext.NewGrpcOutCtxFromHTTPRequest(r)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	rctx := hc.GetContext(r)
+	tk, ok := rctx.AuthInfo.(*token.Token)
+	if !ok {
+		response.Failed(w, fmt.Errorf("auth info is not an *token.Token"))
+		return
+	}
+
+	req := pipeline.NewDescribeStepRequestWithKey(rctx.PS.ByName("id"))
+	req.Namespace = tk.Namespace
+	s, err := h.service.DescribeStep(ctx.Context(), req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	if !s.IsRunning() || !s.IsScheduled() {
+		response.Failed(w, exception.NewBadRequest("step %s is not running", s.Key))
+		return
+	}
+
+	n, err := node.DescribeNode(r.Context(), conf.C().Etcd.GetClient(), node.NodeType, s.ScheduledNodeName())
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	// 先连接节点, 节点不可用时可以直接返回错误
+	target := n.WebsocketURL(node.StepTerminalPath(s.Key))
+	if r.URL.RawQuery != "" {
+		target += "?" + r.URL.RawQuery
+	}
+	nodeConn, resp, err := websocket.DefaultDialer.DialContext(r.Context(), target, node.NewClientCredentialHeader())
+	if err != nil {
+		if resp != nil {
+			err = fmt.Errorf("%s, status %d", err, resp.StatusCode)
+		}
+		response.Failed(w, exception.NewInternalServerError("connect to node %s error, %s", n.InstanceName, err))
+		return
+	}
+	defer nodeConn.Close()
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		h.log.Errorf("error upgrading websocket: %s", err)
+		return
+	}
+	defer conn.Close()
+
+	relayCtx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	go relayWebsocket(cancel, nodeConn, conn)
+	go relayWebsocket(cancel, conn, nodeConn)
+	<-relayCtx.Done()
+}
+
+// relayWebsocket 把src的消息原样转发给dst, 包括关闭消息
+func relayWebsocket(cancel context.CancelFunc, dst, src *websocket.Conn) {
+	defer cancel()
+
+	for {
+		mt, data, err := src.ReadMessage()
+		if err != nil {
+			code, reason := websocket.CloseNormalClosure, ""
+			if e, ok := err.(*websocket.CloseError); ok {
+				code, reason = e.Code, e.Text
+			}
+			// 1005和1006不能出现在关闭消息中
+			if code == websocket.CloseNoStatusReceived || code == websocket.CloseAbnormalClosure {
+				code = websocket.CloseNormalClosure
+			}
+			msg := websocket.FormatCloseMessage(code, reason)
+			dst.WriteControl(websocket.CloseMessage, msg, time.Now().Add(time.Second))
+			return
+		}
+		if err := dst.WriteMessage(mt, data); err != nil {
+			return
+		}
+	}
+}
@@ -12,6 +12,8 @@ import (
 
 	"github.com/infraboard/workflow/api/apps/action"
 	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/api/apps/pipeline/archive"
+	"github.com/infraboard/workflow/common/events"
 	"github.com/infraboard/workflow/conf"
 )
 
@@ -26,6 +28,10 @@ type impl struct {
 	client *clientv3.Client
 	log    logger.Logger
 	action action.ServiceServer
+	// 已经归档的pipeline
+	archive *archive.Store
+	// 创建pipeline时发布创建事件, 之后的事件由调度器发布
+	events events.Publisher
 
 	watchCancel   map[int64]context.CancelFunc
 	currentNumber int64
@@ -47,6 +53,13 @@ func (s *impl) Config() error {
 	s.log = zap.L().Named("Pipeline")
 	s.client = conf.C().Etcd.GetClient()
 	s.action = app.GetGrpcApp(action.AppName).(action.ServiceServer)
+
+	store, err := archive.NewStore(conf.C().Mongo.GetDB())
+	if err != nil {
+		return err
+	}
+	s.archive = store
+	s.events = events.NewBusPublisher(conf.C().App.Name)
 	return nil
 }
 
@@ -0,0 +1,143 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/infraboard/mcube/exception"
+
+	"github.com/infraboard/workflow/api/apps/node"
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/node/controller/step/store"
+)
+
+const (
+	// 每次发送的日志大小
+	LOG_CHUNK_SIZE = 32 * 1024
+)
+
+var (
+	// follow模式下日志会持续输出, 只限制连接和等待响应的时间
+	nodeClient = &http.Client{
+		Transport: &http.Transport{
+			DialContext:           (&net.Dialer{Timeout: 3 * time.Second}).DialContext,
+			ResponseHeaderTimeout: 10 * time.Second,
+		},
+	}
+)
+
+// WatchStepLog 优先从运行step的节点读取日志, 节点上有实时日志和本地文件保存的日志
+// 节点不可用时直接从日志存储中读取
+func (i *impl) WatchStepLog(req *pipeline.WatchStepLogRequest, stream pipeline.Service_WatchStepLogServer) error {
+	if err := req.Validate(); err != nil {
+		return exception.NewBadRequest("validate watch step log request error, %s", err)
+	}
+	ctx := stream.Context()
+
+	s, err := i.describeStepWithArchive(ctx, req.Key)
+	if err != nil {
+		return err
+	}
+	if req.Namespace != "" && s.Namespace != req.Namespace {
+		return exception.NewNotFound("step %s not found", req.Key)
+	}
+
+	rc, offset, err := i.openStepLog(ctx, s, req)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	buf := make([]byte, LOG_CHUNK_SIZE)
+	for {
+		n, err := rc.Read(buf)
+		if n > 0 {
+			data := make([]byte, n)
+			copy(data, buf[:n])
+			if err := stream.Send(&pipeline.StepLog{Offset: offset, Data: data}); err != nil {
+				return err
+			}
+			offset += int64(n)
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return exception.NewInternalServerError("read step %s log error, %s", s.Key, err)
+		}
+	}
+}
+
+// 已经归档的step只能从归档中查询
+func (i *impl) describeStepWithArchive(ctx context.Context, key string) (*pipeline.Step, error) {
+	s, err := i.DescribeStep(ctx, pipeline.NewDescribeStepRequestWithKey(key))
+	if exception.IsNotFoundError(err) && i.archive != nil {
+		return i.archive.DescribeStep(ctx, key)
+	}
+	return s, err
+}
+
+func (i *impl) openStepLog(ctx context.Context, s *pipeline.Step, req *pipeline.WatchStepLogRequest) (
+	io.ReadCloser, int64, error) {
+	if s.IsScheduled() {
+		rc, offset, err := i.openNodeStepLog(ctx, s, req)
+		if err == nil {
+			return rc, offset, nil
+		}
+		i.log.Debugf("read step %s log from node %s error, %s", s.Key, s.ScheduledNodeName(), err)
+	}
+
+	if s.LogDriver() == "" || s.LogPath() == "" {
+		return nil, 0, exception.NewNotFound("step %s has no log", s.Key)
+	}
+	reader, err := store.NewReader(s.LogDriver())
+	if err != nil {
+		return nil, 0, exception.NewBadRequest("read step %s log error, %s", s.Key, err)
+	}
+	rc, err := reader.Open(ctx, s.LogPath())
+	if err != nil {
+		return nil, 0, exception.NewNotFound("open step %s log error, %s", s.Key, err)
+	}
+	return store.Seek(rc, req.Offset, int(req.Tail))
+}
+
+func (i *impl) openNodeStepLog(ctx context.Context, s *pipeline.Step, req *pipeline.WatchStepLogRequest) (
+	io.ReadCloser, int64, error) {
+	n, err := node.DescribeNode(ctx, i.client, node.NodeType, s.ScheduledNodeName())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	qs := url.Values{}
+	qs.Set("offset", strconv.FormatInt(req.Offset, 10))
+	qs.Set("tail", strconv.Itoa(int(req.Tail)))
+	qs.Set("follow", strconv.FormatBool(req.Follow))
+	qs.Set("log_driver", s.LogDriver())
+	qs.Set("log_path", s.LogPath())
+	r, err := http.NewRequestWithContext(ctx, http.MethodGet, n.URL(node.StepLogPath(s.Key))+"?"+qs.Encode(), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	r.Header = node.NewClientCredentialHeader()
+
+	resp, err := nodeClient.Do(r)
+	if err != nil {
+		return nil, 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, 0, fmt.Errorf("node response status %d, %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	offset, _ := strconv.ParseInt(resp.Header.Get(node.STEP_LOG_OFFSET_HEADER), 10, 64)
+	return resp.Body, offset, nil
+}
@@ -7,11 +7,13 @@ import (
 
 	"github.com/infraboard/mcube/exception"
 	"github.com/infraboard/mcube/grpc/gcontext"
+	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/infraboard/workflow/api/apps/action"
 	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/api/apps/pipeline/variable"
 )
 
 func (i *impl) CreatePipeline(ctx context.Context, req *pipeline.CreatePipelineRequest) (
@@ -37,13 +39,29 @@ func (i *impl) CreatePipeline(ctx context.Context, req *pipeline.CreatePipelineR
 		return nil, fmt.Errorf("put pipeline with key: %s, error, %s", objKey, err.Error())
 	}
 	i.log.Debugf("create pipeline success, key: %s", objKey)
+	i.events.PublishPipeline(nil, p)
 	return p, nil
 }
 
 func (i *impl) validatePipelineStage(ctx context.Context, p *pipeline.Pipeline) error {
+	// step只能引用一定在它之前执行结束的step的输出: 依赖的stage(包含间接依赖)中的step,
+	// 以及同一个stage中在它之前的step, 并发执行的stage和同一组并行step之间不能互相引用
+	if err := variable.ValidatePipelineWith(p.With); err != nil {
+		return fmt.Errorf("pipeline with %s", err)
+	}
+
+	vv := variable.NewPipelineValidator(p.With)
 	for index := range p.Stages {
 		stage := p.Stages[index]
-		if err := i.validateStage(ctx, stage); err != nil {
+		upstream := []string{}
+		for _, need := range p.UpstreamStages(stage) {
+			for _, s := range need.Steps {
+				upstream = append(upstream, s.Name)
+			}
+		}
+		vv.ResetSteps(upstream...)
+
+		if err := i.validateStage(ctx, p, stage, vv); err != nil {
 			return err
 		}
 	}
@@ -51,52 +69,165 @@ func (i *impl) validatePipelineStage(ctx context.Context, p *pipeline.Pipeline)
 	return nil
 }
 
-func (i *impl) validateStage(ctx context.Context, s *pipeline.Stage) error {
+func (i *impl) validateStage(ctx context.Context, p *pipeline.Pipeline, s *pipeline.Stage, vv *variable.Validator) error {
 	if s.StepCount() == 0 {
 		return fmt.Errorf("stage %s host no steps", s.ShortDesc())
 	}
 
+	// 连续的并行step在同一组中执行, 这一组结束后才能被引用
+	parallel := []string{}
 	for index := range s.Steps {
 		step := s.Steps[index]
-		if err := i.validateStep(ctx, step); err != nil {
+		if !step.IsParallel {
+			for _, name := range parallel {
+				vv.AddStep(name)
+			}
+			parallel = parallel[:0]
+		}
+
+		a, err := i.validateStep(ctx, p.Namespace, p.With, step, vv)
+		if err != nil {
 			return err
 		}
+		if a.IsDeprecated() {
+			p.Warnings = append(p.Warnings, fmt.Sprintf("step %s: %s", step.Name, a.DeprecationWarning()))
+		}
+
+		if step.IsParallel {
+			parallel = append(parallel, step.Name)
+		} else {
+			vv.AddStep(step.Name)
+		}
 	}
 
 	return nil
 }
 
-func (i *impl) validateStep(ctx context.Context, s *pipeline.Step) error {
-	_, err := i.action.DescribeAction(ctx, action.NewDescribeActionRequest(s.ActionName(), s.ActionVersion()))
+// validateStep 解析step引用的action版本, 解析后的版本固定到step中, 之后action发布新版本不影响运行
+// pipelineWith 为pipeline的参数, 运行时会和step的参数合并后传给action
+func (i *impl) validateStep(ctx context.Context, namespace string, pipelineWith map[string]string,
+	s *pipeline.Step, vv *variable.Validator) (*action.Action, error) {
+	a, err := i.action.ResolveAction(ctx, action.NewResolveActionRequest(namespace, s.Action))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	s.Action = a.Key()
+
+	// 校验参数中的变量引用
+	if err := vv.Validate(s.With); err != nil {
+		return nil, fmt.Errorf("step %s with %s", s.Name, err)
+	}
+
+	// 按照action的定义检查参数, 变量引用运行时才能确定值, 运行时再检查
+	if err := a.ValidateStaticRunParam(mergeWith(pipelineWith, s.With), variable.IsReference, variable.IsSecretReference); err != nil {
+		return nil, exception.NewBadRequest("step %s %s", s.Name, err).WithData(err)
+	}
+
+	// 校验执行条件
+	if err := vv.ValidateCondition(s.If); err != nil {
+		return nil, fmt.Errorf("step %s if %s", s.Name, err)
+	}
+
+	if s.Retry != nil {
+		if err := s.Retry.Validate(); err != nil {
+			return nil, fmt.Errorf("step %s retry %s", s.Name, err)
+		}
+	}
+	return a, nil
+}
+
+func mergeWith(items ...map[string]string) map[string]string {
+	with := map[string]string{}
+	for _, item := range items {
+		for k, v := range item {
+			with[k] = v
+		}
+	}
+	return with
 }
 
+// QueryPipeline 先查询etcd中的pipeline, 不足一页时继续查询已经归档的pipeline
+// 查询归档数据时, 游标使用ARCHIVE_CURSOR_PREFIX前缀区分
 func (i *impl) QueryPipeline(ctx context.Context, req *pipeline.QueryPipelineRequest) (
 	*pipeline.PipelineSet, error) {
-	listKey := pipeline.EtcdPipelinePrefix()
-	i.log.Infof("list etcd pipeline resource key: %s", listKey)
-	resp, err := i.client.Get(ctx, listKey, clientv3.WithPrefix())
-	if err != nil {
-		return nil, err
+	archiveCursor, isArchiveCursor := parseArchiveCursor(req.Cursor)
+	q := newRangeQuery(pipeline.EtcdPipelinePrefix()+"/", req.EtcdPrefix(), req.Cursor, req.Page, req.HasFilter())
+	// 指定空间时key的后缀为pipeline id, 创建时间的过滤条件可以转换为key的范围, 减少扫描的数量
+	if req.Namespace != "" {
+		q.setKeyRange(req.IdRange())
+	}
+	pageSize := q.limit
+	// etcd中的数据已经查询完了, 只需要统计总数
+	if isArchiveCursor {
+		q.cursor, q.offset, q.limit = "", 0, 0
 	}
+	i.log.Infof("list etcd pipeline resource key: %s", q.prefix)
 
 	ps := pipeline.NewPipelineSet()
-	for index := range resp.Kvs {
+	result, err := i.rangeQuery(ctx, q, func(kv *mvccpb.KeyValue, inPage bool) bool {
 		// 解析对象
-		ins, err := pipeline.LoadPipelineFromBytes(resp.Kvs[index].Value)
+		ins, err := pipeline.LoadPipelineFromBytes(kv.Value)
 		if err != nil {
 			i.log.Error(err)
-			continue
+			return false
 		}
-		ins.ResourceVersion = resp.Header.Revision
-		ps.Add(ins)
+		if !req.Match(ins) {
+			return false
+		}
+		if inPage {
+			ins.ResourceVersion = kv.ModRevision
+			ps.Add(ins)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	ps.Total = result.total
+	ps.NextCursor = result.nextCursor
+
+	if i.archive == nil {
+		return ps, nil
+	}
+
+	// 归档数据在etcd数据之后, 需要跳过的数量扣除etcd中的数据
+	limit, skip := int64(0), int64(0)
+	if isArchiveCursor {
+		limit = pageSize
+	} else if ps.NextCursor == "" {
+		limit = q.limit - int64(len(ps.Items))
+		if q.offset > result.total {
+			skip = q.offset - result.total
+		}
+	}
+	as, hasMore, err := i.archive.QueryPipeline(ctx, req, archiveCursor, skip, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	ps.Total += as.Total
+	for index := range as.Items {
+		ps.Add(as.Items[index])
+	}
+	if hasMore && ps.NextCursor == "" {
+		next := archiveCursor
+		if len(as.Items) > 0 {
+			next = as.Items[len(as.Items)-1].Id
+		}
+		ps.NextCursor = ARCHIVE_CURSOR_PREFIX + next
 	}
 	return ps, nil
 }
 
+func (i *impl) QueryPipelineHistory(ctx context.Context, req *pipeline.QueryPipelineHistoryRequest) (
+	*pipeline.PipelineSet, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest("validate query pipeline history error, %s", err)
+	}
+
+	return i.QueryPipeline(ctx, req.QueryPipelineRequest())
+}
+
 func (i *impl) DescribePipeline(ctx context.Context, req *pipeline.DescribePipelineRequest) (
 	*pipeline.Pipeline, error) {
 	descKey := pipeline.PipeLineObjectKey(req.Namespace, req.Id)
@@ -106,8 +237,18 @@ func (i *impl) DescribePipeline(ctx context.Context, req *pipeline.DescribePipel
 		return nil, err
 	}
 
+	// etcd中没有时, 查询已经归档的pipeline
 	if resp.Count == 0 {
-		return nil, exception.NewNotFound("pipeline %s not found", req.Id)
+		if i.archive == nil {
+			return nil, exception.NewNotFound("pipeline %s not found", req.Id)
+		}
+		ins, err := i.archive.DescribePipeline(ctx, req.Namespace, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		// 归档的pipeline不在etcd中, 版本为0, 重新写入etcd时要求key不存在
+		ins.ResourceVersion = 0
+		return ins, nil
 	}
 
 	if resp.Count > 1 {
@@ -122,7 +263,7 @@ func (i *impl) DescribePipeline(ctx context.Context, req *pipeline.DescribePipel
 			i.log.Error(err)
 			continue
 		}
-		ins.ResourceVersion = resp.Header.Revision
+		ins.ResourceVersion = resp.Kvs[index].ModRevision
 	}
 	return ins, nil
 }
@@ -149,9 +290,147 @@ func (i *impl) DeletePipeline(ctx context.Context, req *pipeline.DeletePipelineR
 		return nil, err
 	}
 
+	// 同时删除已经归档的数据
+	if i.archive != nil {
+		if err := i.archive.DeletePipeline(ctx, ins); err != nil {
+			return nil, err
+		}
+	}
+
 	return ins, nil
 }
 
+// RerunPipeline 基于已经结束的pipeline创建一个新的pipeline重新运行
+func (i *impl) RerunPipeline(ctx context.Context, req *pipeline.RerunPipelineRequest) (
+	*pipeline.Pipeline, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest("validate rerun pipeline error, %s", err)
+	}
+
+	descReq := pipeline.NewDescribePipelineRequestWithID(req.Id)
+	descReq.Namespace = req.Namespace
+	ins, err := i.DescribePipeline(ctx, descReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ins.IsComplete() {
+		return nil, exception.NewBadRequest("pipeline %s is not complete, can't rerun", ins.ShortDescribe())
+	}
+
+	p, err := ins.Rerun(req.CreateBy)
+	if err != nil {
+		return nil, exception.NewInternalServerError("rerun pipeline error, %s", err)
+	}
+
+	value, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+
+	objKey := p.EtcdObjectKey()
+	if _, err := i.client.Put(ctx, objKey, string(value)); err != nil {
+		return nil, fmt.Errorf("put pipeline with key: %s, error, %s", objKey, err.Error())
+	}
+	i.log.Debugf("rerun pipeline %s success, key: %s", ins.ShortDescribe(), objKey)
+	i.events.PublishPipeline(nil, p)
+	return p, nil
+}
+
+// RetryPipeline 从失败的step开始重新执行, 删除需要重新执行的step, 并重置pipeline状态
+func (i *impl) RetryPipeline(ctx context.Context, req *pipeline.RetryPipelineRequest) (
+	*pipeline.Pipeline, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest("validate retry pipeline error, %s", err)
+	}
+
+	descReq := pipeline.NewDescribePipelineRequestWithID(req.Id)
+	descReq.Namespace = req.Namespace
+	ins, err := i.DescribePipeline(ctx, descReq)
+	if err != nil {
+		return nil, err
+	}
+
+	stale, err := ins.Retry()
+	if err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	value, err := json.Marshal(ins)
+	if err != nil {
+		return nil, err
+	}
+
+	// 旧的step和pipeline状态一起更新, 避免调度器把旧的step状态同步回pipeline
+	ops := []clientv3.Op{}
+	for _, s := range stale {
+		ops = append(ops, clientv3.OpDelete(s.MakeObjectKey()))
+	}
+	objKey := ins.EtcdObjectKey()
+	ops = append(ops, clientv3.OpPut(objKey, string(value)))
+	if err := i.commitIfNotModified(ctx, ins, ops...); err != nil {
+		return nil, err
+	}
+
+	// 已经归档的pipeline重新回到etcd中运行, 归档的step保留, 结束后再次归档
+	if i.archive != nil {
+		if err := i.archive.Unarchive(ctx, ins); err != nil {
+			return nil, err
+		}
+	}
+
+	i.log.Debugf("retry pipeline %s success, reset %d scheduled steps", ins.ShortDescribe(), len(stale))
+	return ins, nil
+}
+
+// CancelPipeline 标记pipeline为取消中, 由调度器取消正在运行的step, 全部取消完成后为取消完成状态
+func (i *impl) CancelPipeline(ctx context.Context, req *pipeline.CancelPipelineRequest) (
+	*pipeline.Pipeline, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest("validate cancel pipeline error, %s", err)
+	}
+
+	descReq := pipeline.NewDescribePipelineRequestWithID(req.Id)
+	descReq.Namespace = req.Namespace
+	ins, err := i.DescribePipeline(ctx, descReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ins.Cancel(req.CancelMessage()); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	value, err := json.Marshal(ins)
+	if err != nil {
+		return nil, err
+	}
+
+	objKey := ins.EtcdObjectKey()
+	if err := i.commitIfNotModified(ctx, ins, clientv3.OpPut(objKey, string(value))); err != nil {
+		return nil, err
+	}
+	i.log.Debugf("cancel pipeline %s success, key: %s", ins.ShortDescribe(), objKey)
+	return ins, nil
+}
+
+// commitIfNotModified 读取之后pipeline没有被修改时才提交, 避免覆盖调度器同时写入的状态
+func (i *impl) commitIfNotModified(ctx context.Context, p *pipeline.Pipeline, ops ...clientv3.Op) error {
+	objKey := p.EtcdObjectKey()
+	resp, err := i.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(objKey), "=", p.ResourceVersion)).
+		Then(ops...).
+		Commit()
+	if err != nil {
+		return exception.NewInternalServerError("update pipeline %s error, %s", p.ShortDescribe(), err)
+	}
+	if !resp.Succeeded {
+		return exception.NewConflict("pipeline %s has been modified, resource version %d changed, please retry",
+			p.ShortDescribe(), p.ResourceVersion)
+	}
+	return nil
+}
+
 func (i *impl) WatchPipeline(stream pipeline.Service_WatchPipelineServer) error {
 	for {
 		union, err := stream.Recv()
@@ -0,0 +1,219 @@
+package impl
+
+import (
+	"context"
+	"strings"
+
+	"github.com/infraboard/mcube/http/request"
+	"github.com/infraboard/mcube/pb/page"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	// 有过滤条件时, 每次从etcd读取的对象数量
+	RANGE_BATCH_SIZE = 500
+	// 查询归档数据时使用的游标前缀
+	ARCHIVE_CURSOR_PREFIX = "archive:"
+)
+
+// 解析归档数据的游标, 返回上一页最后一个pipeline的id
+func parseArchiveCursor(cursor string) (string, bool) {
+	if !strings.HasPrefix(cursor, ARCHIVE_CURSOR_PREFIX) {
+		return "", false
+	}
+	return strings.TrimPrefix(cursor, ARCHIVE_CURSOR_PREFIX), true
+}
+
+// rangeQuery 基于etcd key范围的分页查询, 按照key倒序返回, 实际的顺序由key的格式决定:
+//   1. pipeline的key为 <namespace>/<id>, id(xid)按创建时间递增, 指定namespace时按创建时间倒序,
+//      不指定namespace时先按namespace倒序, 同一个namespace中再按创建时间倒序
+//   2. pipeline创建的step的key为 <namespace>.<pipeline id>.<stage id>.<step id>, 编号按字符串比较,
+//      比如同一个stage中step 9排在step 10之前; 单独创建的step的key为xid, 按创建时间倒序,
+//      不指定pipeline查询时两种key混在一起按字符串倒序, 不是按创建时间排序
+type rangeQuery struct {
+	// 游标相对的key前缀, 返回的游标不包含该前缀
+	base string
+	// 需要查询的key前缀
+	prefix string
+	// 前缀中需要读取的key范围[start, stop), 为空时读取整个前缀, 用于把过滤条件转换为key的范围
+	start string
+	stop  string
+	// 上一页最后一个对象的游标, 只返回在它之后的对象
+	cursor string
+	offset int64
+	limit  int64
+	// 是否需要解析对象后过滤, 没有过滤条件时直接通过etcd统计总数
+	filter bool
+}
+
+func newRangeQuery(base, prefix, cursor string, pr *page.PageRequest, filter bool) *rangeQuery {
+	q := &rangeQuery{
+		base:   base,
+		prefix: prefix,
+		cursor: cursor,
+		limit:  request.DefaultPageSize,
+		filter: filter,
+	}
+	if pr != nil {
+		if pr.PageSize > 0 {
+			q.limit = int64(pr.PageSize)
+		}
+		// 使用游标时忽略页码, 传入了offset时优先使用offset
+		if cursor == "" && pr.Offset > 0 {
+			q.offset = pr.Offset
+		} else if cursor == "" && pr.PageNumber > 0 {
+			q.offset = q.limit * int64(pr.PageNumber-1)
+		}
+	}
+	return q
+}
+
+// 只返回在游标之后的对象, 倒序时即key比游标小的对象
+func (q *rangeQuery) end() string {
+	if q.cursor != "" {
+		return q.base + q.cursor
+	}
+	return clientv3.GetPrefixRangeEnd(q.prefix)
+}
+
+// setKeyRange 只读取前缀中[from, to)范围的key, 为空表示不限制
+func (q *rangeQuery) setKeyRange(from, to string) {
+	if from != "" {
+		q.start = q.prefix + from
+	}
+	if to != "" {
+		q.stop = q.prefix + to
+	}
+}
+
+// 扫描的key范围
+func (q *rangeQuery) scanRange() (start, end string) {
+	start, end = q.prefix, clientv3.GetPrefixRangeEnd(q.prefix)
+	if q.start != "" {
+		start = q.start
+	}
+	if q.stop != "" {
+		end = q.stop
+	}
+	return
+}
+
+func (q *rangeQuery) nextCursor(key []byte) string {
+	return strings.TrimPrefix(string(key), q.base)
+}
+
+// rangeVisitor 解析并判断对象是否满足过滤条件, inPage为true时把对象加入当前页
+type rangeVisitor func(kv *mvccpb.KeyValue, inPage bool) (matched bool)
+
+// rangeResult 查询结果
+type rangeResult struct {
+	total      int64
+	nextCursor string
+}
+
+func (i *impl) rangeQuery(ctx context.Context, q *rangeQuery, visit rangeVisitor) (*rangeResult, error) {
+	if q.filter {
+		return i.rangeScan(ctx, q, visit)
+	}
+	return i.rangePage(ctx, q, visit)
+}
+
+// 没有过滤条件时, 总数直接由etcd统计, 只读取当前页的对象
+func (i *impl) rangePage(ctx context.Context, q *rangeQuery, visit rangeVisitor) (*rangeResult, error) {
+	countResp, err := i.client.Get(ctx, q.prefix, clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return nil, err
+	}
+
+	// 只统计总数
+	result := &rangeResult{total: countResp.Count}
+	if q.limit == 0 {
+		return result, nil
+	}
+
+	// 多读取一个, 判断是否还有下一页
+	resp, err := i.client.Get(ctx, q.prefix,
+		clientv3.WithRange(q.end()),
+		clientv3.WithSort(clientv3.SortByKey, clientv3.SortDescend),
+		clientv3.WithLimit(q.offset+q.limit+1),
+		clientv3.WithRev(countResp.Header.Revision),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var collected int64
+	for index, kv := range resp.Kvs {
+		if int64(index) < q.offset {
+			continue
+		}
+		if collected == q.limit {
+			result.nextCursor = q.nextCursor(resp.Kvs[index-1].Key)
+			break
+		}
+		visit(kv, true)
+		collected++
+	}
+	return result, nil
+}
+
+// 有过滤条件时, 需要分批读取范围内所有对象, 解析后才能统计准确的总数
+func (i *impl) rangeScan(ctx context.Context, q *rangeQuery, visit rangeVisitor) (*rangeResult, error) {
+	start, end := q.scanRange()
+	var (
+		cursorKey = ""
+		rev       int64
+		skipped   int64
+		collected int64
+		lastKey   []byte
+		result    = &rangeResult{}
+	)
+	if q.cursor != "" {
+		cursorKey = q.base + q.cursor
+	}
+
+	for {
+		opts := []clientv3.OpOption{
+			clientv3.WithRange(end),
+			clientv3.WithSort(clientv3.SortByKey, clientv3.SortDescend),
+			clientv3.WithLimit(RANGE_BATCH_SIZE),
+		}
+		// 所有批次读取同一个版本的数据, 避免翻页过程中数据变化
+		if rev > 0 {
+			opts = append(opts, clientv3.WithRev(rev))
+		}
+		resp, err := i.client.Get(ctx, start, opts...)
+		if err != nil {
+			return nil, err
+		}
+		rev = resp.Header.Revision
+
+		for _, kv := range resp.Kvs {
+			afterCursor := cursorKey == "" || string(kv.Key) < cursorKey
+			inPage := afterCursor && skipped >= q.offset && collected < q.limit
+			if !visit(kv, inPage) {
+				continue
+			}
+
+			result.total++
+			switch {
+			case inPage:
+				collected++
+				lastKey = kv.Key
+			case afterCursor && skipped < q.offset:
+				skipped++
+			case afterCursor && result.nextCursor == "":
+				// 当前页已满, 还有满足条件的对象
+				result.nextCursor = q.nextCursor(lastKey)
+			}
+		}
+
+		if len(resp.Kvs) < RANGE_BATCH_SIZE {
+			break
+		}
+		end = string(resp.Kvs[len(resp.Kvs)-1].Key)
+	}
+
+	return result, nil
+}
@@ -7,9 +7,11 @@ import (
 
 	"github.com/infraboard/mcube/exception"
 	"github.com/rs/xid"
+	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/api/apps/pipeline/variable"
 )
 
 func (i *impl) CreateStep(ctx context.Context, req *pipeline.CreateStepRequest) (
@@ -21,9 +23,16 @@ func (i *impl) CreateStep(ctx context.Context, req *pipeline.CreateStepRequest)
 	step := pipeline.NewStep(pipeline.STEP_CREATE_BY_USER, req)
 	step.Key = xid.New().String()
 
-	if err := i.validateStep(ctx, step); err != nil {
+	a, err := i.validateStep(ctx, req.Namespace, nil, step, variable.NewValidator())
+	if err != nil {
+		if e, ok := err.(exception.APIException); ok {
+			return nil, e
+		}
 		return nil, exception.NewBadRequest("validate step error, %s", err)
 	}
+	if a.IsDeprecated() {
+		i.log.Warnf("create step %s, %s", step.Key, a.DeprecationWarning())
+	}
 
 	value, err := json.Marshal(step)
 	if err != nil {
@@ -42,26 +51,32 @@ func (i *impl) CreateStep(ctx context.Context, req *pipeline.CreateStepRequest)
 
 func (i *impl) QueryStep(ctx context.Context, req *pipeline.QueryStepRequest) (
 	*pipeline.StepSet, error) {
-	listKey := pipeline.EtcdStepPrefix()
-	i.log.Infof("list etcd step resource key: %s", listKey)
-	resp, err := i.client.Get(ctx,
-		listKey,
-		clientv3.WithPrefix(),
-		clientv3.WithSort(clientv3.SortByModRevision, clientv3.SortDescend))
-	if err != nil {
-		return nil, err
-	}
+	q := newRangeQuery(pipeline.EtcdStepPrefix()+"/", req.EtcdPrefix(), req.Cursor, req.Page, req.HasFilter())
+	i.log.Infof("list etcd step resource key: %s", q.prefix)
 
 	ps := pipeline.NewStepSet()
-	for index := range resp.Kvs {
+	result, err := i.rangeQuery(ctx, q, func(kv *mvccpb.KeyValue, inPage bool) bool {
 		// 解析对象
-		ins, err := pipeline.LoadStepFromBytes(resp.Kvs[index].Value)
+		ins, err := pipeline.LoadStepFromBytes(kv.Value)
 		if err != nil {
 			i.log.Error(err)
-			continue
+			return false
+		}
+		if !req.Match(ins) {
+			return false
+		}
+		if inPage {
+			ins.ResourceVersion = kv.ModRevision
+			ps.Add(ins)
 		}
-		ps.Add(ins)
+		return true
+	})
+	if err != nil {
+		return nil, err
 	}
+
+	ps.Total = result.total
+	ps.NextCursor = result.nextCursor
 	return ps, nil
 }
 
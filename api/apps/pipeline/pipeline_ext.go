@@ -1,9 +1,11 @@
 package pipeline
 
 import (
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -11,6 +13,8 @@ import (
 	"github.com/infraboard/mcube/http/request"
 	"github.com/infraboard/mcube/logger/zap"
 	"github.com/rs/xid"
+
+	"github.com/infraboard/workflow/api/apps/pipeline/variable"
 )
 
 const (
@@ -45,15 +49,104 @@ func (req *CreatePipelineRequest) UpdateOwner(tk *token.Token) {
 // NewQueryPipelineRequest 查询book列表
 func NewQueryPipelineRequest() *QueryPipelineRequest {
 	return &QueryPipelineRequest{
-		Page: request.NewDefaultPageRequest(),
+		Page: &request.NewDefaultPageRequest().PageRequest,
+	}
+}
+
+// ParseStatus 解析逗号分隔的状态过滤条件, 例如: FAILED,TIMEOUT
+func (req *QueryPipelineRequest) ParseStatus(s string) error {
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		status, err := ParsePIPELINE_STATUSFromString(item)
+		if err != nil {
+			return err
+		}
+		req.Status = append(req.Status, status)
+	}
+	return nil
+}
+
+// MatchStatus pipeline的状态是否满足过滤条件, 没有指定状态时都满足
+func (req *QueryPipelineRequest) MatchStatus(p *Pipeline) bool {
+	if len(req.Status) == 0 {
+		return true
+	}
+	if p.Status == nil {
+		return false
+	}
+	return p.Status.Status.IsIn(req.Status...)
+}
+
+// EtcdPrefix 查询的key前缀, 指定空间时只查询该空间下的pipeline
+func (req *QueryPipelineRequest) EtcdPrefix() string {
+	if req.Namespace == "" {
+		return EtcdPipelinePrefix() + "/"
+	}
+	return PipeLineObjectKey(req.Namespace, "")
+}
+
+// IdRange 创建时间的过滤条件对应的pipeline id范围[from, to), 空字符串表示不限制
+// pipeline id(xid)的前4个字节是创建的秒数, 按字符串比较时和创建时间的顺序一致,
+// id和create_at不是同时生成的, 范围前后各多留1秒, 最终仍然需要Match判断
+func (req *QueryPipelineRequest) IdRange() (from, to string) {
+	if req.CreateStartAt > 0 {
+		from = minIdAt(req.CreateStartAt/1000 - 1)
+	}
+	if req.CreateEndAt > 0 {
+		to = minIdAt(req.CreateEndAt/1000 + 2)
+	}
+	return
+}
+
+// 该秒创建的最小的id
+func minIdAt(sec int64) string {
+	if sec < 0 {
+		sec = 0
+	}
+	var id xid.ID
+	binary.BigEndian.PutUint32(id[:4], uint32(sec))
+	return id.String()
+}
+
+// HasFilter 是否有需要解析对象后才能判断的过滤条件
+func (req *QueryPipelineRequest) HasFilter() bool {
+	return req.Name != "" || req.TemplateId != "" || len(req.Status) > 0 ||
+		len(req.Tags) > 0 || req.CreateStartAt > 0 || req.CreateEndAt > 0
+}
+
+// Match pipeline是否满足所有过滤条件
+func (req *QueryPipelineRequest) Match(p *Pipeline) bool {
+	if req.Namespace != "" && p.Namespace != req.Namespace {
+		return false
+	}
+	if req.Name != "" && p.Name != req.Name {
+		return false
+	}
+	if req.TemplateId != "" && p.TemplateId != req.TemplateId {
+		return false
+	}
+	for k, v := range req.Tags {
+		if tv, ok := p.Tags[k]; !ok || tv != v {
+			return false
+		}
 	}
+	if !isInTimeRange(p.CreateAt, req.CreateStartAt, req.CreateEndAt) {
+		return false
+	}
+	return req.MatchStatus(p)
 }
 
 func (req *CreatePipelineRequest) Validate() error {
 	if len(req.Stages) == 0 {
 		return fmt.Errorf("no stages")
 	}
-	return validate.Struct(req)
+	if err := validate.Struct(req); err != nil {
+		return err
+	}
+	return validateStageNeeds(req.Stages)
 }
 
 func (req *CreatePipelineRequest) EnsureStep() {
@@ -103,21 +196,24 @@ func NewPipeline(req *CreatePipelineRequest) (*Pipeline, error) {
 	req.EnsureStep()
 
 	p := &Pipeline{
-		Id:          xid.New().String(),
-		CreateAt:    time.Now().UnixMilli(),
-		TemplateId:  req.TemplateId,
-		CreateBy:    req.CreateBy,
-		Domain:      req.Domain,
-		Namespace:   req.Namespace,
-		Name:        req.Name,
-		With:        req.With,
-		Mount:       req.Mount,
-		Tags:        req.Tags,
-		Description: req.Description,
-		On:          req.On,
-		Stages:      req.Stages,
-		HookEvent:   req.HookEvent,
-		Status:      &PipelineStatus{},
+		Id:               xid.New().String(),
+		CreateAt:         time.Now().UnixMilli(),
+		TemplateId:       req.TemplateId,
+		TemplateRevision: req.TemplateRevision,
+		CreateBy:         req.CreateBy,
+		Domain:           req.Domain,
+		Namespace:        req.Namespace,
+		Name:             req.Name,
+		With:             req.With,
+		Mount:            req.Mount,
+		Tags:             req.Tags,
+		Description:      req.Description,
+		On:               req.On,
+		Stages:           req.Stages,
+		HookEvent:        req.HookEvent,
+		Timeout:          req.Timeout,
+		CronjobId:        req.CronjobId,
+		Status:           &PipelineStatus{},
 	}
 	return p, nil
 }
@@ -135,7 +231,10 @@ func (p *Pipeline) UpdateOwner(tk *token.Token) {
 }
 
 func (p *Pipeline) Validate() error {
-	return validate.Struct(p)
+	if err := validate.Struct(p); err != nil {
+		return err
+	}
+	return validateStageNeeds(p.Stages)
 }
 
 func (p *Pipeline) GetStep(stageNumber int32, stepKey string) (*Step, error) {
@@ -147,6 +246,58 @@ func (p *Pipeline) GetStep(stageNumber int32, stepKey string) (*Step, error) {
 	return stage.GetStepByKey(stepKey)
 }
 
+// RuntimeContext pipeline运行中产生的参数, 即当前step之前一定执行结束并且成功的step的输出
+// 按照stage和step的顺序合并, 后执行的覆盖先执行的
+func (p *Pipeline) RuntimeContext(current *Step) map[string]string {
+	m := map[string]string{}
+	for _, step := range p.upstreamSteps(current) {
+		for k, v := range step.Outputs() {
+			m[k] = v
+		}
+	}
+	return m
+}
+
+// StepOutputs 当前step之前一定执行结束并且成功的step的输出, 按step名称索引
+func (p *Pipeline) StepOutputs(current *Step) map[string]map[string]string {
+	m := map[string]map[string]string{}
+	for _, step := range p.upstreamSteps(current) {
+		m[step.Name] = step.Outputs()
+	}
+	return m
+}
+
+// upstreamSteps 和创建时校验引用的范围一致: 依赖的stage(包含间接依赖)中的step,
+// 以及同一个stage中在它之前的step, 并发执行的stage和同一组并行step中的step不包含在内
+func (p *Pipeline) upstreamSteps(current *Step) (steps []*Step) {
+	stage, err := p.GetStageByNumber(current.GetPipelineStageNumber())
+	if err != nil {
+		return nil
+	}
+
+	upstream := map[*Stage]bool{}
+	for _, s := range p.UpstreamStages(stage) {
+		upstream[s] = true
+	}
+
+	for i := range p.Stages {
+		items := p.Stages[i].Steps
+		switch {
+		case p.Stages[i] == stage:
+			items = stage.stepsBefore(current)
+		case !upstream[p.Stages[i]]:
+			continue
+		}
+
+		for _, step := range items {
+			if step.IsSucceeded() {
+				steps = append(steps, step)
+			}
+		}
+	}
+	return
+}
+
 func (p *Pipeline) UpdateStep(s *Step) error {
 	ns, id := s.GetNamespace(), s.GetPipelineId()
 	if ns != p.Namespace || id != p.Id {
@@ -182,8 +333,36 @@ func (p *Pipeline) LastStage() *Stage {
 	return p.Stages[total-1]
 }
 
+// CompleteStatus pipeline结束的状态, 包括成功, 失败, 取消和超时
+func CompleteStatus() []PIPELINE_STATUS {
+	return []PIPELINE_STATUS{
+		PIPELINE_STATUS_COMPLETE,
+		PIPELINE_STATUS_SUCCEEDED,
+		PIPELINE_STATUS_FAILED,
+		PIPELINE_STATUS_CANCELED,
+		PIPELINE_STATUS_TIMEOUT,
+	}
+}
+
+// IsComplete pipeline已经结束, 包括成功, 失败, 取消和超时
 func (p *Pipeline) IsComplete() bool {
-	return p.Status.Status.Equal(PIPELINE_STATUS_COMPLETE)
+	return p.Status.Status.IsIn(CompleteStatus()...)
+}
+
+// IsArchivable pipeline结束超过retention后可以归档
+func (p *Pipeline) IsArchivable(now time.Time, retention time.Duration) bool {
+	if !p.IsComplete() || p.Status.EndAt == 0 {
+		return false
+	}
+	return now.Sub(time.UnixMilli(p.Status.EndAt)) >= retention
+}
+
+// IsCanceling 正在取消, 等待正在运行的step取消完成
+func (p *Pipeline) IsCanceling() bool {
+	if p.Status == nil {
+		return false
+	}
+	return p.Status.Status.Equal(PIPELINE_STATUS_CANCELING)
 }
 
 func (s *Pipeline) IsScheduled() bool {
@@ -201,6 +380,7 @@ func (p *Pipeline) IsRunning() bool {
 	return p.Status.Status.Equal(PIPELINE_STATUS_EXECUTING)
 }
 
+// NextFlowNumber 下一个flow的编号, 每调度一组step分配一个新的编号
 func (p *Pipeline) NextFlowNumber() int64 {
 	if p.Status == nil {
 		return 1
@@ -209,6 +389,8 @@ func (p *Pipeline) NextFlowNumber() int64 {
 	return p.Status.GetCurrentFlow() + 1
 }
 
+// CurrentFlowNumber 最近一次分配的flow编号
+// 因为stage可以并发执行, 同一时刻可能有多个flow在运行, 不一定是这个编号
 func (p *Pipeline) CurrentFlowNumber() int64 {
 	if p.Status == nil {
 		return 0
@@ -218,6 +400,9 @@ func (p *Pipeline) CurrentFlowNumber() int64 {
 }
 
 func (p *Pipeline) incFlow() {
+	if p.Status == nil {
+		p.Status = NewDefaultPipelineStatus()
+	}
 	p.Status.CurrentFlow++
 }
 
@@ -229,101 +414,514 @@ func (p *Pipeline) Run() {
 	p.Status.StartAt = time.Now().UnixMilli()
 }
 
+// Complete 根据step的执行结果结束pipeline
+//   1. 正在取消的pipeline结束后为取消完成
+//   2. 有stage中断的为失败, 并记录失败的step
+//   3. 其他的为成功
 func (p *Pipeline) Complete() {
+	if p.IsCanceling() {
+		p.complete(PIPELINE_STATUS_CANCELED, p.Status.Message)
+		return
+	}
+
+	if failed := p.FailedSteps(); len(failed) > 0 {
+		p.complete(PIPELINE_STATUS_FAILED, failedStepsSummary(failed))
+		return
+	}
+
+	p.complete(PIPELINE_STATUS_SUCCEEDED, "")
+}
+
+func (p *Pipeline) complete(status PIPELINE_STATUS, msg string) {
 	if p.Status == nil {
 		p.Status = NewDefaultPipelineStatus()
 	}
-	p.Status.Status = PIPELINE_STATUS_COMPLETE
+	p.Status.Status = status
+	p.Status.Message = msg
 	p.Status.EndAt = time.Now().UnixMilli()
 }
 
-func (p *Pipeline) GetCurrentFlow() *Flow {
-	flow := p.CurrentFlowNumber()
+// FailedSteps 导致stage中断的step
+func (p *Pipeline) FailedSteps() (steps []*Step) {
+	for i := range p.Stages {
+		for _, step := range p.Stages[i].Steps {
+			if step.IsBreakNow() {
+				steps = append(steps, step)
+			}
+		}
+	}
+	return
+}
 
-	// pipeline还没有运行
-	if flow == 0 {
-		return nil
+func failedStepsSummary(steps []*Step) string {
+	items := make([]string, 0, len(steps))
+	for _, s := range steps {
+		items = append(items, fmt.Sprintf("%s(%s): %s", s.Name, s.Status.Status, s.Status.Message))
+	}
+	return fmt.Sprintf("%d step failed, %s", len(steps), strings.Join(items, "; "))
+}
+
+// Cancel 标记pipeline为取消中, 由调度器取消所有未完成的step
+func (p *Pipeline) Cancel(msg string) error {
+	if p.IsComplete() {
+		return fmt.Errorf("pipeline %s is complete, can't cancel", p.ShortDescribe())
+	}
+	if p.Status == nil {
+		p.Status = NewDefaultPipelineStatus()
+	}
+
+	p.Status.Status = PIPELINE_STATUS_CANCELING
+	p.Status.Message = msg
+	return nil
+}
+
+// CancelSteps 取消中的pipeline, 处理所有未完成的step
+//   1. 正在运行的step标记为取消中, 由节点取消
+//   2. 已经调度但是还没有运行的step直接标记为取消完成
+//   3. 还没有调度的step跳过
+// 所有step都结束后, pipeline取消完成
+// 返回状态有变化并且已经调度的step, 需要同步状态, changed表示pipeline是否有变化
+func (p *Pipeline) CancelSteps() (scheduled []*Step, changed bool) {
+	if !p.IsCanceling() {
+		return nil, false
 	}
 
+	msg := p.Status.Message
 	for i := range p.Stages {
-		stage := p.Stages[i]
-		if f := stage.GetFlow(flow); f != nil {
-			return f
+		for _, step := range p.Stages[i].Steps {
+			if step.Status == nil {
+				step.Status = NewDefaultStepStatus()
+			}
+			// 取消后不再重试
+			if step.Retry != nil {
+				step.Retry = nil
+				changed = true
+			}
+			if step.IsComplete() || step.Status.Status.Equal(STEP_STATUS_CANCELING) {
+				continue
+			}
+
+			changed = true
+			switch {
+			case step.FlowNumber() == 0:
+				step.Skip(msg)
+			case step.IsRunning():
+				step.Cancel(msg)
+				scheduled = append(scheduled, step)
+			default:
+				step.Canceled(msg)
+				scheduled = append(scheduled, step)
+			}
 		}
 	}
 
-	return nil
+	if p.IsAllStageComplete() {
+		p.Complete()
+		changed = true
+	}
+	return scheduled, changed
+}
+
+// TimeoutDuration pipeline的超时时间, 0表示不限制
+func (p *Pipeline) TimeoutDuration() time.Duration {
+	return time.Duration(p.Timeout) * time.Second
+}
+
+// Deadline pipeline的截止时间, 从开始执行计算, 没有设置超时或者还没有开始执行时返回false
+func (p *Pipeline) Deadline() (time.Time, bool) {
+	if p.Timeout <= 0 || p.Status == nil || p.Status.StartAt == 0 {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(p.Status.StartAt).Add(p.TimeoutDuration()), true
+}
+
+// IsTimeout 在t时刻pipeline是否已经超时
+func (p *Pipeline) IsTimeout(t time.Time) bool {
+	deadline, ok := p.Deadline()
+	return ok && t.After(deadline)
 }
 
-func (p *Pipeline) GetNextFlow() *Flow {
+// MarkTimeout pipeline超时后结束所有未完成的step, 取消中的pipeline超时后为取消完成
+// 返回已经调度的step, 需要同步状态
+func (p *Pipeline) MarkTimeout() (scheduled []*Step) {
+	status := PIPELINE_STATUS_TIMEOUT
+	if p.IsCanceling() {
+		status = PIPELINE_STATUS_CANCELED
+	}
+	return p.Terminate(status, fmt.Sprintf("pipeline run timeout, timeout is %s", p.TimeoutDuration()))
+}
+
+// Terminate 结束所有未完成的step, 已经调度的step标记为失败, 未调度的跳过
+// pipeline以指定的状态结束, 返回已经调度的step, 需要同步状态
+func (p *Pipeline) Terminate(status PIPELINE_STATUS, msg string) (scheduled []*Step) {
 	for i := range p.Stages {
 		stage := p.Stages[i]
+		for j := range stage.Steps {
+			step := stage.Steps[j]
+			// pipeline结束后不再重试
+			step.Retry = nil
+			if step.IsComplete() {
+				continue
+			}
+
+			if step.FlowNumber() > 0 {
+				step.Failed(msg)
+				scheduled = append(scheduled, step)
+			} else {
+				step.Skip(msg)
+			}
+		}
+	}
+
+	p.complete(status, msg)
+	return
+}
+
+// IsFailed pipeline已经结束, 并且没有执行成功
+func (p *Pipeline) IsFailed() bool {
+	if p.Status == nil {
+		return false
+	}
+
+	switch p.Status.Status {
+	case PIPELINE_STATUS_FAILED, PIPELINE_STATUS_CANCELED, PIPELINE_STATUS_TIMEOUT:
+		return true
+	case PIPELINE_STATUS_COMPLETE:
+		// 之前版本的结束状态, 根据stage是否中断判断
+		for i := range p.Stages {
+			if p.Stages[i].IsBreakNow() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Rerun 基于当前pipeline的定义创建一个新的pipeline, 所有step重新执行
+func (p *Pipeline) Rerun(createBy string) (*Pipeline, error) {
+	// 复制一份, 避免修改原来的pipeline
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	ins := NewDefaultPipeline()
+	if err := json.Unmarshal(data, ins); err != nil {
+		return nil, err
+	}
 
-		// stage 通过了继续搜索下一个stage
-		if stage.IsPassed() {
+	ins.Id = xid.New().String()
+	ins.CreateAt = time.Now().UnixMilli()
+	ins.ResourceVersion = 0
+	ins.RerunFrom = p.Id
+	// 重新运行的pipeline不再属于cronjob, 不会被cronjob清理
+	ins.CronjobId = ""
+	if createBy != "" {
+		ins.CreateBy = createBy
+	}
+	ins.Status = NewDefaultPipelineStatus()
+	for i := range ins.Stages {
+		for _, step := range ins.Stages[i].Steps {
+			step.Key = ""
+			step.PipelineId = ""
+			step.Status = NewDefaultStepStatus()
+		}
+	}
+	return ins, nil
+}
+
+// Retry 从失败的step开始重新执行, 执行成功的step保留状态和输出
+// 重置的step包括:
+//   1. 失败, 取消, 审核拒绝的step, 以及同一个stage中之后的step
+//   2. 依赖重置stage的stage(包含间接依赖)中的所有step
+//   3. 被跳过的step, 重新计算if条件
+// 返回已经调度过的step, 需要删除etcd中旧的step
+func (p *Pipeline) Retry() (stale []*Step, err error) {
+	if !p.IsComplete() {
+		return nil, fmt.Errorf("pipeline %s is not complete", p.ShortDescribe())
+	}
+
+	reset := map[*Stage]bool{}
+	for _, stage := range p.Stages {
+		index := stage.failedIndex()
+		if index < 0 {
 			continue
 		}
+		reset[stage] = true
+		stale = append(stale, stage.resetSteps(index)...)
+	}
+	if len(reset) == 0 {
+		return nil, fmt.Errorf("pipeline %s has no failed step", p.ShortDescribe())
+	}
 
-		// 如果stage中断后 就没有下一步了
-		if stage.IsBreakNow() {
-			return nil
+	for _, stage := range p.Stages {
+		if !reset[stage] && p.isStageNeedsAny(stage, reset, map[*Stage]bool{}) {
+			stale = append(stale, stage.resetSteps(0)...)
 		}
+	}
 
-		// 先找出了 该Stage 中下一步 需要执行的Step
-		// 然后为这些Step进行 编号(Flow number)
-		steps := stage.NextStep()
-		for i := range steps {
-			step := steps[i]
+	for _, stage := range p.Stages {
+		for _, step := range stage.Steps {
+			if step.Status != nil && step.Status.Status.Equal(STEP_STATUS_SKIP) {
+				stale = append(stale, step.reset()...)
+			}
+		}
+	}
+
+	// flow编号继续递增, 保证同一个stage中step的flow编号是递增的
+	p.Status = &PipelineStatus{
+		CurrentFlow: p.CurrentFlowNumber(),
+	}
+	return stale, nil
+}
+
+// stage是否依赖(包含间接依赖)targets中的stage
+func (p *Pipeline) isStageNeedsAny(s *Stage, targets, visited map[*Stage]bool) bool {
+	if visited[s] {
+		return false
+	}
+	visited[s] = true
+
+	for _, need := range p.StageNeeds(s) {
+		if targets[need] || p.isStageNeedsAny(need, targets, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRunningFlows 获取所有正在运行的flow, 每个stage同一时刻最多只有一个flow在运行
+func (p *Pipeline) GetRunningFlows() (flows []*Flow) {
+	for i := range p.Stages {
+		f := p.Stages[i].CurrentFlow()
+		if f != nil && !f.IsComplete() {
+			flows = append(flows, f)
+		}
+	}
+	return
+}
+
+// 为stage中下一组需要执行的step 进行编号(Flow number)
+// 不满足if条件的step会被直接跳过, 继续查找下一组
+func (p *Pipeline) nextFlow(stage *Stage) (f *Flow, changed bool) {
+	for {
+		candidates := stage.NextStep()
+		if len(candidates) == 0 {
+			return nil, changed
+		}
+
+		// 同一组step使用相同的状态计算条件
+		failed := stage.IsBreakNow() || p.IsStageBlocked(stage)
+
+		steps := []*Step{}
+		for i := range candidates {
+			step := candidates[i]
 			step.PipelineId = p.Id
 			step.Namespace = p.Namespace
 			step.CreateAt = time.Now().UnixMilli()
-			steps[i].BuildKey(p.Namespace, p.Id, stage.Id)
-			step.setFlowNumber(p.NextFlowNumber())
+			step.BuildKey(p.Namespace, p.Id, stage.Id)
+
+			ok, err := p.evalStepCondition(step, failed)
+			if err != nil {
+				step.Failed("eval step if condition error, %s", err)
+				changed = true
+				continue
+			}
+			if !ok {
+				step.Skip("step if condition %s not match", step.ConditionExpr())
+				changed = true
+				continue
+			}
+			steps = append(steps, step)
 		}
 
-		if len(steps) > 0 {
-			p.incFlow()
+		if len(steps) == 0 {
+			continue
 		}
-		return NewFlow(p.CurrentFlowNumber(), steps)
+
+		number := p.NextFlowNumber()
+		for i := range steps {
+			steps[i].setFlowNumber(number)
+		}
+		p.incFlow()
+		return NewFlow(number, steps), changed
 	}
-	return nil
 }
 
-// 只有上一个flow执行完成后, 才会有下个fow
-// 注意: 多个并行的任务是不能跨stage同时执行的
-//      也就是说stage一定是串行的
+// 计算step的if条件, failed表示step所在的stage或者依赖的stage已经中断
+func (p *Pipeline) evalStepCondition(s *Step, failed bool) (bool, error) {
+	cond, err := variable.ParseCondition(s.If)
+	if err != nil {
+		return false, err
+	}
+
+	resolver := variable.NewResolver().SetPipelineWith(p.With)
+	for name, outputs := range p.StepOutputs(s) {
+		resolver.SetStepOutputs(name, outputs)
+	}
+
+	return cond.Eval(&variable.ConditionContext{
+		Failed:   failed,
+		Resolver: resolver,
+	})
+}
+
+// NextStep 找出下一批需要调度的step
+// stage 按照needs构成的DAG调度:
+//   1. stage依赖的stage都执行结束后, 才开始执行
+//   2. 没有依赖关系的stage并发执行, 每个stage同一时刻只有一个flow在运行
+//   3. stage中断后, 只会阻塞依赖它的stage, 其他stage继续执行
+// step通过if条件控制是否执行, 默认为success(), 也就是stage中断后, 之后的step以及依赖它的stage中的step都会被跳过,
+// 只有if条件为failure()或者always()的step会继续执行, 比如清理和通知
+// 当所有的stage都执行结束时, pipeline执行完成
 func (p *Pipeline) NextStep() (steps []*Step, isComplete bool) {
-	// 判断当前Flow是否运行完成
-	if f := p.GetCurrentFlow(); f != nil {
-		// 如果有flow中断, pipeline 提前结束
-		if f.IsBreak() {
-			isComplete = true
-			return
+	// 取消中的pipeline不再调度, 等待正在运行的step取消完成
+	if p.IsCanceling() {
+		return nil, p.IsAllStageComplete()
+	}
+
+	for {
+		changed := false
+		for i := range p.Stages {
+			stage := p.Stages[i]
+
+			// stage 已经执行结束
+			if stage.IsComplete() {
+				continue
+			}
+
+			// 依赖的stage还没有执行结束, 需要等待
+			if !p.IsStageReady(stage) {
+				continue
+			}
+
+			// 如果flow没有完成 说明stage还是在运行中, 不需要调度下一组step
+			if f := stage.CurrentFlow(); f != nil && !f.IsComplete() {
+				continue
+			}
+
+			f, ok := p.nextFlow(stage)
+			if f != nil {
+				steps = append(steps, f.items...)
+			}
+			changed = changed || ok
 		}
 
-		// 如果flow没有pass 说明还是在运行中, 不需要调度下以组step
-		if !f.IsPassed() {
-			return
+		// 有step被跳过时, 依赖它的stage可能已经可以执行了
+		if !changed {
+			break
 		}
 	}
 
-	f := p.GetNextFlow()
+	isComplete = len(steps) == 0 && p.IsAllStageComplete()
+	return
+}
+
+// IsAllStageComplete 所有stage是否都已经执行结束
+func (p *Pipeline) IsAllStageComplete() bool {
+	for i := range p.Stages {
+		if !p.Stages[i].IsComplete() {
+			return false
+		}
+	}
+	return true
+}
 
-	// 判断是不是最后一个Flow了
-	if f == nil {
-		isComplete = true
+// IsDAG 有stage声明了needs时, stage按照needs构成的DAG调度
+// 否则为了兼容之前的行为, stage按照顺序串行执行
+func (p *Pipeline) IsDAG() bool {
+	return isStageDAG(p.Stages)
+}
+
+func (p *Pipeline) GetStageByName(name string) *Stage {
+	for i := range p.Stages {
+		if p.Stages[i].Name == name {
+			return p.Stages[i]
+		}
+	}
+	return nil
+}
+
+// StageNeeds stage依赖的stage, 串行模式下依赖上一个stage
+func (p *Pipeline) StageNeeds(s *Stage) (needs []*Stage) {
+	if !p.IsDAG() {
+		for i := range p.Stages {
+			if p.Stages[i] == s && i > 0 {
+				needs = append(needs, p.Stages[i-1])
+			}
+		}
 		return
 	}
 
-	// 如果不是则获取flow中的step
-	steps = f.items
+	for _, name := range s.Needs {
+		if need := p.GetStageByName(name); need != nil {
+			needs = append(needs, need)
+		}
+	}
+	return
+}
+
+// UpstreamStages stage直接和间接依赖的stage, 这些stage一定在它之前执行结束
+func (p *Pipeline) UpstreamStages(s *Stage) (upstream []*Stage) {
+	visited := map[*Stage]bool{s: true}
+	queue := p.StageNeeds(s)
+	for len(queue) > 0 {
+		need := queue[0]
+		queue = queue[1:]
+		if visited[need] {
+			continue
+		}
+		visited[need] = true
+		upstream = append(upstream, need)
+		queue = append(queue, p.StageNeeds(need)...)
+	}
 	return
 }
 
+// IsStageReady 依赖的stage是否都已经执行结束
+func (p *Pipeline) IsStageReady(s *Stage) bool {
+	for _, need := range p.StageNeeds(s) {
+		if !need.IsComplete() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsStageBlocked 依赖的stage(包含间接依赖)是否有中断的
+func (p *Pipeline) IsStageBlocked(s *Stage) bool {
+	return p.isStageBlocked(s, map[*Stage]bool{})
+}
+
+func (p *Pipeline) isStageBlocked(s *Stage, visited map[*Stage]bool) bool {
+	// 防止needs有环时死循环
+	if visited[s] {
+		return false
+	}
+	visited[s] = true
+
+	for _, need := range p.StageNeeds(s) {
+		if need.IsBreakNow() || p.isStageBlocked(need, visited) {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *Pipeline) ShortDescribe() string {
 	return fmt.Sprintf("%s[%s]", p.Name, p.Id)
 }
 
+// TemplateRef 创建pipeline使用的模版版本, 格式为 template_id@revision
+func (p *Pipeline) TemplateRef() string {
+	if p.TemplateId == "" {
+		return ""
+	}
+	if p.TemplateRevision == 0 {
+		return p.TemplateId
+	}
+	return fmt.Sprintf("%s@%d", p.TemplateId, p.TemplateRevision)
+}
+
 func (p *Pipeline) ScheduledNodeName() string {
 	return p.Status.SchedulerNode
 }
@@ -372,6 +970,72 @@ func NewDeletePipelineRequestWithID(id string) *DeletePipelineRequest {
 	}
 }
 
+func NewRerunPipelineRequest(namespace, id string) *RerunPipelineRequest {
+	return &RerunPipelineRequest{
+		Namespace: namespace,
+		Id:        id,
+	}
+}
+
+func (req *RerunPipelineRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewQueryPipelineHistoryRequest(namespace, templateId string) *QueryPipelineHistoryRequest {
+	return &QueryPipelineHistoryRequest{
+		Page:       &request.NewDefaultPageRequest().PageRequest,
+		Namespace:  namespace,
+		TemplateId: templateId,
+	}
+}
+
+func (req *QueryPipelineHistoryRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+// QueryPipelineRequest 转换为查询模版下所有已经结束的pipeline
+func (req *QueryPipelineHistoryRequest) QueryPipelineRequest() *QueryPipelineRequest {
+	query := NewQueryPipelineRequest()
+	if req.Page != nil {
+		query.Page = req.Page
+	}
+	query.Namespace = req.Namespace
+	query.TemplateId = req.TemplateId
+	query.Cursor = req.Cursor
+	query.Status = CompleteStatus()
+	return query
+}
+
+func NewRetryPipelineRequest(namespace, id string) *RetryPipelineRequest {
+	return &RetryPipelineRequest{
+		Namespace: namespace,
+		Id:        id,
+	}
+}
+
+func (req *RetryPipelineRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewCancelPipelineRequest(namespace, id string) *CancelPipelineRequest {
+	return &CancelPipelineRequest{
+		Namespace: namespace,
+		Id:        id,
+	}
+}
+
+func (req *CancelPipelineRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+// CancelMessage 取消原因, 没有填写时使用默认的
+func (req *CancelPipelineRequest) CancelMessage() string {
+	if req.Message != "" {
+		return req.Message
+	}
+	return "pipeline canceled by user"
+}
+
 func NewQueryPipelineOptions() *QueryPipelineOptions {
 	return &QueryPipelineOptions{}
 }
@@ -435,15 +1099,19 @@ func (req *CreateWatchPipelineRequest) Validate() error {
 	return nil
 }
 
+// IsMatch 分支和事件都匹配时触发, branches和events中的每一项都是正则表达式, 需要完整匹配
 func (t *Trigger) IsMatch(branche, event string) bool {
-	return t.matchBranche(branche) && t.matchEvent(event)
+	if t == nil {
+		return false
+	}
+	return matchAny(t.Branches, branche) && matchAny(t.Events, event)
 }
 
-func (t *Trigger) matchBranche(b string) bool {
-	for i := range t.Branches {
-		matched, err := regexp.MatchString(b, t.Branches[i])
+func matchAny(patterns []string, value string) bool {
+	for i := range patterns {
+		matched, err := regexp.MatchString("^(?:"+patterns[i]+")$", value)
 		if err != nil {
-			zap.L().Errorf("match branche string error, %s", err)
+			zap.L().Errorf("match pattern %s error, %s", patterns[i], err)
 		}
 		if matched {
 			return true
@@ -452,16 +1120,88 @@ func (t *Trigger) matchBranche(b string) bool {
 	return false
 }
 
-func (t *Trigger) matchEvent(e string) bool {
-	for i := range t.Events {
-		matched, err := regexp.MatchString(e, t.Events[i])
-		if err != nil {
-			zap.L().Errorf("match event string error, %s", err)
-		}
-		if matched {
+// 有stage声明了needs, 就按照DAG调度
+func isStageDAG(stages []*Stage) bool {
+	for i := range stages {
+		if len(stages[i].Needs) > 0 {
 			return true
 		}
 	}
-
 	return false
 }
+
+// 校验stage的依赖关系: 依赖的stage必须存在, 且不能有环
+func validateStageNeeds(stages []*Stage) error {
+	if !isStageDAG(stages) {
+		return nil
+	}
+
+	index := map[string]*Stage{}
+	for i := range stages {
+		stage := stages[i]
+		if _, ok := index[stage.Name]; ok {
+			return fmt.Errorf("stage name %s duplicate", stage.Name)
+		}
+		index[stage.Name] = stage
+	}
+
+	for i := range stages {
+		stage := stages[i]
+		for _, name := range stage.Needs {
+			if name == stage.Name {
+				return fmt.Errorf("stage %s can't need itself", stage.Name)
+			}
+			if _, ok := index[name]; !ok {
+				return fmt.Errorf("stage %s need unknown stage %s", stage.Name, name)
+			}
+		}
+	}
+
+	// 深度优先搜索, 检查是否有环
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := map[string]int{}
+	path := []string{}
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("stage needs has cycle: %s -> %s", strings.Join(path, " -> "), name)
+		case visited:
+			return nil
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+		for _, need := range index[name].Needs {
+			if err := visit(need); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+
+	for i := range stages {
+		if err := visit(stages[i].Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// 时间是否在[start, end)范围内, 为0表示不限制
+func isInTimeRange(t, start, end int64) bool {
+	if start > 0 && t < start {
+		return false
+	}
+	if end > 0 && t >= end {
+		return false
+	}
+	return true
+}
@@ -28,6 +28,10 @@ type ServiceClient interface {
 	DescribePipeline(ctx context.Context, in *DescribePipelineRequest, opts ...grpc.CallOption) (*Pipeline, error)
 	WatchPipeline(ctx context.Context, opts ...grpc.CallOption) (Service_WatchPipelineClient, error)
 	DeletePipeline(ctx context.Context, in *DeletePipelineRequest, opts ...grpc.CallOption) (*Pipeline, error)
+	RerunPipeline(ctx context.Context, in *RerunPipelineRequest, opts ...grpc.CallOption) (*Pipeline, error)
+	RetryPipeline(ctx context.Context, in *RetryPipelineRequest, opts ...grpc.CallOption) (*Pipeline, error)
+	CancelPipeline(ctx context.Context, in *CancelPipelineRequest, opts ...grpc.CallOption) (*Pipeline, error)
+	QueryPipelineHistory(ctx context.Context, in *QueryPipelineHistoryRequest, opts ...grpc.CallOption) (*PipelineSet, error)
 	// step管理
 	CreateStep(ctx context.Context, in *CreateStepRequest, opts ...grpc.CallOption) (*Step, error)
 	QueryStep(ctx context.Context, in *QueryStepRequest, opts ...grpc.CallOption) (*StepSet, error)
@@ -35,6 +39,7 @@ type ServiceClient interface {
 	DeleteStep(ctx context.Context, in *DeleteStepRequest, opts ...grpc.CallOption) (*Step, error)
 	CancelStep(ctx context.Context, in *CancelStepRequest, opts ...grpc.CallOption) (*Step, error)
 	AuditStep(ctx context.Context, in *AuditStepRequest, opts ...grpc.CallOption) (*Step, error)
+	WatchStepLog(ctx context.Context, in *WatchStepLogRequest, opts ...grpc.CallOption) (Service_WatchStepLogClient, error)
 }
 
 type serviceClient struct {
@@ -112,6 +117,42 @@ func (c *serviceClient) DeletePipeline(ctx context.Context, in *DeletePipelineRe
 	return out, nil
 }
 
+func (c *serviceClient) RerunPipeline(ctx context.Context, in *RerunPipelineRequest, opts ...grpc.CallOption) (*Pipeline, error) {
+	out := new(Pipeline)
+	err := c.cc.Invoke(ctx, "/infraboard.workflow.pipeline.Service/RerunPipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RetryPipeline(ctx context.Context, in *RetryPipelineRequest, opts ...grpc.CallOption) (*Pipeline, error) {
+	out := new(Pipeline)
+	err := c.cc.Invoke(ctx, "/infraboard.workflow.pipeline.Service/RetryPipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) CancelPipeline(ctx context.Context, in *CancelPipelineRequest, opts ...grpc.CallOption) (*Pipeline, error) {
+	out := new(Pipeline)
+	err := c.cc.Invoke(ctx, "/infraboard.workflow.pipeline.Service/CancelPipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) QueryPipelineHistory(ctx context.Context, in *QueryPipelineHistoryRequest, opts ...grpc.CallOption) (*PipelineSet, error) {
+	out := new(PipelineSet)
+	err := c.cc.Invoke(ctx, "/infraboard.workflow.pipeline.Service/QueryPipelineHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) CreateStep(ctx context.Context, in *CreateStepRequest, opts ...grpc.CallOption) (*Step, error) {
 	out := new(Step)
 	err := c.cc.Invoke(ctx, "/infraboard.workflow.pipeline.Service/CreateStep", in, out, opts...)
@@ -166,6 +207,38 @@ func (c *serviceClient) AuditStep(ctx context.Context, in *AuditStepRequest, opt
 	return out, nil
 }
 
+func (c *serviceClient) WatchStepLog(ctx context.Context, in *WatchStepLogRequest, opts ...grpc.CallOption) (Service_WatchStepLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Service_ServiceDesc.Streams[1], "/infraboard.workflow.pipeline.Service/WatchStepLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceWatchStepLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_WatchStepLogClient interface {
+	Recv() (*StepLog, error)
+	grpc.ClientStream
+}
+
+type serviceWatchStepLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceWatchStepLogClient) Recv() (*StepLog, error) {
+	m := new(StepLog)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -176,6 +249,10 @@ type ServiceServer interface {
 	DescribePipeline(context.Context, *DescribePipelineRequest) (*Pipeline, error)
 	WatchPipeline(Service_WatchPipelineServer) error
 	DeletePipeline(context.Context, *DeletePipelineRequest) (*Pipeline, error)
+	RerunPipeline(context.Context, *RerunPipelineRequest) (*Pipeline, error)
+	RetryPipeline(context.Context, *RetryPipelineRequest) (*Pipeline, error)
+	CancelPipeline(context.Context, *CancelPipelineRequest) (*Pipeline, error)
+	QueryPipelineHistory(context.Context, *QueryPipelineHistoryRequest) (*PipelineSet, error)
 	// step管理
 	CreateStep(context.Context, *CreateStepRequest) (*Step, error)
 	QueryStep(context.Context, *QueryStepRequest) (*StepSet, error)
@@ -183,6 +260,7 @@ type ServiceServer interface {
 	DeleteStep(context.Context, *DeleteStepRequest) (*Step, error)
 	CancelStep(context.Context, *CancelStepRequest) (*Step, error)
 	AuditStep(context.Context, *AuditStepRequest) (*Step, error)
+	WatchStepLog(*WatchStepLogRequest, Service_WatchStepLogServer) error
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -205,6 +283,18 @@ func (UnimplementedServiceServer) WatchPipeline(Service_WatchPipelineServer) err
 func (UnimplementedServiceServer) DeletePipeline(context.Context, *DeletePipelineRequest) (*Pipeline, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePipeline not implemented")
 }
+func (UnimplementedServiceServer) RerunPipeline(context.Context, *RerunPipelineRequest) (*Pipeline, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RerunPipeline not implemented")
+}
+func (UnimplementedServiceServer) RetryPipeline(context.Context, *RetryPipelineRequest) (*Pipeline, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryPipeline not implemented")
+}
+func (UnimplementedServiceServer) CancelPipeline(context.Context, *CancelPipelineRequest) (*Pipeline, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPipeline not implemented")
+}
+func (UnimplementedServiceServer) QueryPipelineHistory(context.Context, *QueryPipelineHistoryRequest) (*PipelineSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPipelineHistory not implemented")
+}
 func (UnimplementedServiceServer) CreateStep(context.Context, *CreateStepRequest) (*Step, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateStep not implemented")
 }
@@ -223,6 +313,9 @@ func (UnimplementedServiceServer) CancelStep(context.Context, *CancelStepRequest
 func (UnimplementedServiceServer) AuditStep(context.Context, *AuditStepRequest) (*Step, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuditStep not implemented")
 }
+func (UnimplementedServiceServer) WatchStepLog(*WatchStepLogRequest, Service_WatchStepLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStepLog not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -334,6 +427,78 @@ func _Service_DeletePipeline_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_RerunPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RerunPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RerunPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infraboard.workflow.pipeline.Service/RerunPipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RerunPipeline(ctx, req.(*RerunPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RetryPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RetryPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infraboard.workflow.pipeline.Service/RetryPipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RetryPipeline(ctx, req.(*RetryPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_CancelPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).CancelPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infraboard.workflow.pipeline.Service/CancelPipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).CancelPipeline(ctx, req.(*CancelPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_QueryPipelineHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPipelineHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).QueryPipelineHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infraboard.workflow.pipeline.Service/QueryPipelineHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).QueryPipelineHistory(ctx, req.(*QueryPipelineHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_CreateStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateStepRequest)
 	if err := dec(in); err != nil {
@@ -442,6 +607,27 @@ func _Service_AuditStep_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_WatchStepLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStepLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).WatchStepLog(m, &serviceWatchStepLogServer{stream})
+}
+
+type Service_WatchStepLogServer interface {
+	Send(*StepLog) error
+	grpc.ServerStream
+}
+
+type serviceWatchStepLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceWatchStepLogServer) Send(m *StepLog) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -465,6 +651,22 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePipeline",
 			Handler:    _Service_DeletePipeline_Handler,
 		},
+		{
+			MethodName: "RerunPipeline",
+			Handler:    _Service_RerunPipeline_Handler,
+		},
+		{
+			MethodName: "RetryPipeline",
+			Handler:    _Service_RetryPipeline_Handler,
+		},
+		{
+			MethodName: "CancelPipeline",
+			Handler:    _Service_CancelPipeline_Handler,
+		},
+		{
+			MethodName: "QueryPipelineHistory",
+			Handler:    _Service_QueryPipelineHistory_Handler,
+		},
 		{
 			MethodName: "CreateStep",
 			Handler:    _Service_CreateStep_Handler,
@@ -497,6 +699,11 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "WatchStepLog",
+			Handler:       _Service_WatchStepLog_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api/apps/pipeline/pb/pipeline.proto",
 }
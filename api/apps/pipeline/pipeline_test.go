@@ -3,6 +3,9 @@ package pipeline_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
+
+	"github.com/rs/xid"
 
 	"github.com/infraboard/workflow/api/apps/pipeline"
 	"github.com/stretchr/testify/assert"
@@ -192,13 +195,13 @@ func TestPipelineCurrentFlowOK(t *testing.T) {
 	t.Log(sample)
 	t.Log(sample.NextStep())
 	sample.Stages[0].Steps[0].Success("status ok")
-	t.Log("current flow: ", sample.GetCurrentFlow())
+	t.Log("running flows: ", len(sample.GetRunningFlows()))
 
 	steps, ok := sample.NextStep()
 	t.Log("is complete: ", ok, "steps: ", steps)
 
 	sample.Stages[0].Steps[1].Run()
-	t.Log("current flow: ", sample.GetCurrentFlow())
+	t.Log("running flows: ", len(sample.GetRunningFlows()))
 	steps, ok = sample.NextStep()
 	t.Log("is complete: ", ok, "steps: ", steps)
 
@@ -253,3 +256,713 @@ func SampleStage(name string) *pipeline.Stage {
 	stage.AddStep(s2)
 	return stage
 }
+
+func TestPipelineRuntimeContext(t *testing.T) {
+	should := assert.New(t)
+	sample := pipeline.NewDefaultPipeline()
+	err := json.Unmarshal([]byte(testP), sample)
+	should.NoError(err)
+
+	s1 := sample.Stages[0].Steps[0]
+	s1.MarkSendAuditNotify()
+	s1.UpdateCtx(map[string]string{"IMAGE_TAG": "v1"})
+	s1.Success("")
+
+	s2 := sample.Stages[1].Steps[0]
+	s2.BuildKey(sample.Namespace, sample.Id, 2)
+	s2.UpdateCtx(map[string]string{"IMAGE_TAG": "v2"})
+
+	ctx := sample.RuntimeContext(s2)
+	should.Equal(map[string]string{"IMAGE_TAG": "v1"}, ctx)
+}
+
+func TestPipelineRuntimeContextUpstream(t *testing.T) {
+	should := assert.New(t)
+
+	p := DAGPipeline([]dagStage{
+		{name: "build"},
+		{name: "lint"},
+		{name: "test", needs: []string{"build"}},
+	})
+	test := p.GetStageByName("test")
+	for i, name := range []string{"report", "upload"} {
+		step := pipeline.NewDefaultStep()
+		step.Id = int32(i) + 2
+		step.Name = name
+		step.Action = "action01"
+		step.IsParallel = true
+		test.AddStep(step)
+	}
+
+	outputs := map[string]map[string]string{
+		"build":  {"IMAGE": "v1"},
+		"lint":   {"IMAGE": "lint", "LINT": "ok"},
+		"test":   {"COVER": "90"},
+		"report": {"REPORT": "r1"},
+	}
+	steps := map[string]*pipeline.Step{}
+	for _, stage := range p.Stages {
+		for _, step := range stage.Steps {
+			step.BuildKey("ns01", "p01", stage.Id)
+			step.UpdateCtx(outputs[step.Name])
+			step.Success("")
+			steps[step.Name] = step
+		}
+	}
+
+	// 并发执行的lint和同一组并行的report不可见, 即使已经执行成功
+	upload := steps["upload"]
+	should.Equal(map[string]string{"IMAGE": "v1", "COVER": "90"}, p.RuntimeContext(upload))
+	should.Equal(map[string]map[string]string{
+		"build": {"IMAGE": "v1"},
+		"test":  {"COVER": "90"},
+	}, p.StepOutputs(upload))
+
+	should.Empty(p.StepOutputs(steps["lint"]))
+	should.Equal(map[string]string{"IMAGE": "v1"}, p.RuntimeContext(steps["test"]))
+}
+
+type dagStage struct {
+	name  string
+	needs []string
+}
+
+type dagRound struct {
+	// 本轮调度前, 执行成功和失败的stage
+	succeeded []string
+	failed    []string
+	// 本轮调度出的step所属的stage
+	expect     []string
+	isComplete bool
+}
+
+func DAGPipeline(stages []dagStage) *pipeline.Pipeline {
+	p := pipeline.NewDefaultPipeline()
+	for i := range stages {
+		stage := pipeline.NewDefaultStage()
+		stage.Id = int32(i) + 1
+		stage.Name = stages[i].name
+		stage.Needs = stages[i].needs
+
+		step := pipeline.NewDefaultStep()
+		step.Id = 1
+		step.Name = stages[i].name
+		step.Action = "action01"
+		stage.AddStep(step)
+		p.AddStage(stage)
+	}
+	return p
+}
+
+func TestPipelineDAGNextStep(t *testing.T) {
+	cases := []struct {
+		name   string
+		stages []dagStage
+		rounds []dagRound
+	}{
+		{
+			name:   "serial without needs",
+			stages: []dagStage{{name: "s1"}, {name: "s2"}},
+			rounds: []dagRound{
+				{expect: []string{"s1"}},
+				{expect: []string{}},
+				{succeeded: []string{"s1"}, expect: []string{"s2"}},
+				{succeeded: []string{"s2"}, expect: []string{}, isComplete: true},
+			},
+		},
+		{
+			name:   "serial break",
+			stages: []dagStage{{name: "s1"}, {name: "s2"}},
+			rounds: []dagRound{
+				{expect: []string{"s1"}},
+				{failed: []string{"s1"}, expect: []string{}, isComplete: true},
+			},
+		},
+		{
+			name: "diamond",
+			stages: []dagStage{
+				{name: "a"},
+				{name: "b", needs: []string{"a"}},
+				{name: "c", needs: []string{"a"}},
+				{name: "d", needs: []string{"b", "c"}},
+			},
+			rounds: []dagRound{
+				{expect: []string{"a"}},
+				{succeeded: []string{"a"}, expect: []string{"b", "c"}},
+				{succeeded: []string{"b"}, expect: []string{}},
+				{succeeded: []string{"c"}, expect: []string{"d"}},
+				{succeeded: []string{"d"}, expect: []string{}, isComplete: true},
+			},
+		},
+		{
+			name: "independent stages run concurrently",
+			stages: []dagStage{
+				{name: "a"},
+				{name: "b"},
+				{name: "c", needs: []string{"a"}},
+			},
+			rounds: []dagRound{
+				{expect: []string{"a", "b"}},
+				{succeeded: []string{"a"}, expect: []string{"c"}},
+				{succeeded: []string{"b", "c"}, expect: []string{}, isComplete: true},
+			},
+		},
+		{
+			name: "failed stage only blocks dependents",
+			stages: []dagStage{
+				{name: "a"},
+				{name: "b", needs: []string{"a"}},
+				{name: "c", needs: []string{"b"}},
+				{name: "d"},
+				{name: "e", needs: []string{"d"}},
+			},
+			rounds: []dagRound{
+				{expect: []string{"a", "d"}},
+				{failed: []string{"a"}, expect: []string{}},
+				{succeeded: []string{"d"}, expect: []string{"e"}},
+				{succeeded: []string{"e"}, expect: []string{}, isComplete: true},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			should := assert.New(t)
+			p := DAGPipeline(c.stages)
+			should.NoError(p.Validate())
+
+			scheduled := 0
+			for i, r := range c.rounds {
+				for _, name := range r.succeeded {
+					p.GetStageByName(name).Steps[0].Success("")
+				}
+				for _, name := range r.failed {
+					p.GetStageByName(name).Steps[0].Failed("failed")
+				}
+
+				steps, isComplete := p.NextStep()
+				got := []string{}
+				for _, s := range steps {
+					got = append(got, s.Name)
+				}
+				should.Equal(r.expect, got, "round %d", i)
+				should.Equal(r.isComplete, isComplete, "round %d", i)
+
+				// 每个stage的调度都分配独立的flow编号
+				scheduled += len(steps)
+				should.Equal(int64(scheduled), p.CurrentFlowNumber(), "round %d", i)
+			}
+		})
+	}
+}
+
+func TestPipelineRunningFlows(t *testing.T) {
+	should := assert.New(t)
+	p := DAGPipeline([]dagStage{
+		{name: "a"},
+		{name: "b"},
+		{name: "c", needs: []string{"a", "b"}},
+	})
+
+	steps, _ := p.NextStep()
+	should.Len(steps, 2)
+	should.Len(p.GetRunningFlows(), 2)
+	should.NotEqual(steps[0].FlowNumber(), steps[1].FlowNumber())
+
+	steps[0].Success("")
+	should.Len(p.GetRunningFlows(), 1)
+	should.NotNil(p.Stages[0].GetFlow(steps[0].FlowNumber()))
+
+	steps[1].Success("")
+	should.Len(p.GetRunningFlows(), 0)
+
+	steps, _ = p.NextStep()
+	should.Len(steps, 1)
+	should.Equal(int64(3), steps[0].FlowNumber())
+	should.Len(p.GetRunningFlows(), 1)
+}
+
+func TestPipelineValidateNeeds(t *testing.T) {
+	cases := []struct {
+		name   string
+		stages []dagStage
+		err    string
+	}{
+		{
+			name:   "serial",
+			stages: []dagStage{{name: "a"}, {name: "b"}},
+		},
+		{
+			name:   "dag",
+			stages: []dagStage{{name: "a"}, {name: "b", needs: []string{"a"}}, {name: "c", needs: []string{"a", "b"}}},
+		},
+		{
+			name:   "unknown stage",
+			stages: []dagStage{{name: "a"}, {name: "b", needs: []string{"x"}}},
+			err:    "stage b need unknown stage x",
+		},
+		{
+			name:   "need itself",
+			stages: []dagStage{{name: "a", needs: []string{"a"}}},
+			err:    "stage a can't need itself",
+		},
+		{
+			name:   "duplicate name",
+			stages: []dagStage{{name: "a"}, {name: "a", needs: []string{"a"}}},
+			err:    "stage name a duplicate",
+		},
+		{
+			name: "cycle",
+			stages: []dagStage{
+				{name: "a", needs: []string{"c"}},
+				{name: "b", needs: []string{"a"}},
+				{name: "c", needs: []string{"b"}},
+			},
+			err: "stage needs has cycle: a -> c -> b -> a",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			should := assert.New(t)
+			err := DAGPipeline(c.stages).Validate()
+			if c.err == "" {
+				should.NoError(err)
+				return
+			}
+			if should.Error(err) {
+				should.Equal(c.err, err.Error())
+			}
+		})
+	}
+}
+
+func ConditionPipeline(env string) *pipeline.Pipeline {
+	p := pipeline.NewDefaultPipeline()
+	p.With = map[string]string{"ENV": env}
+
+	build := pipeline.NewDefaultStage()
+	build.Id = 1
+	build.Name = "build"
+	for _, s := range []struct{ name, cond string }{
+		{"compile", ""},
+		{"cleanup", "always()"},
+		{"notify", "failure()"},
+	} {
+		step := pipeline.NewDefaultStep()
+		step.Name = s.name
+		step.If = s.cond
+		build.AddStep(step)
+	}
+	p.AddStage(build)
+
+	deploy := pipeline.NewDefaultStage()
+	deploy.Id = 2
+	deploy.Name = "deploy"
+	deploy.Needs = []string{"build"}
+	step := pipeline.NewDefaultStep()
+	step.Name = "deploy"
+	step.If = `pipeline.with.ENV == "prod"`
+	deploy.AddStep(step)
+	p.AddStage(deploy)
+	return p
+}
+
+func TestUpstreamStages(t *testing.T) {
+	should := assert.New(t)
+
+	p := pipeline.NewDefaultPipeline()
+	for _, s := range []struct {
+		name  string
+		needs []string
+	}{
+		{"build", nil},
+		{"lint", nil},
+		{"test", []string{"build"}},
+		{"deploy", []string{"test"}},
+	} {
+		stage := pipeline.NewDefaultStage()
+		stage.Name = s.name
+		stage.Needs = s.needs
+		p.AddStage(stage)
+	}
+
+	names := func(stages []*pipeline.Stage) []string {
+		ret := []string{}
+		for i := range stages {
+			ret = append(ret, stages[i].Name)
+		}
+		return ret
+	}
+	// 间接依赖的stage也在上游, 并发执行的lint不在
+	should.Equal([]string{"test", "build"}, names(p.UpstreamStages(p.GetStageByName("deploy"))))
+	should.Empty(p.UpstreamStages(p.GetStageByName("lint")))
+}
+
+func stepNames(steps []*pipeline.Step) []string {
+	names := []string{}
+	for i := range steps {
+		names = append(names, steps[i].Name)
+	}
+	return names
+}
+
+func TestPipelineStepCondition(t *testing.T) {
+	should := assert.New(t)
+
+	// 执行失败后, 只有failure()和always()的step会执行
+	p := ConditionPipeline("prod")
+	steps, _ := p.NextStep()
+	should.Equal([]string{"compile"}, stepNames(steps))
+	steps[0].Failed("compile error")
+
+	steps, _ = p.NextStep()
+	should.Equal([]string{"cleanup"}, stepNames(steps))
+	steps[0].Success("")
+
+	steps, _ = p.NextStep()
+	should.Equal([]string{"notify"}, stepNames(steps))
+	steps[0].Success("")
+
+	steps, isComplete := p.NextStep()
+	should.Empty(steps)
+	should.True(isComplete)
+	should.False(p.Stages[0].IsPassed())
+	should.Equal(pipeline.STEP_STATUS_SKIP, p.Stages[1].Steps[0].Status.Status)
+
+	// 执行成功后, failure()的step和条件不满足的step被跳过
+	p = ConditionPipeline("dev")
+	steps, _ = p.NextStep()
+	steps[0].Success("")
+	steps, _ = p.NextStep()
+	should.Equal([]string{"cleanup"}, stepNames(steps))
+	steps[0].Success("")
+
+	steps, isComplete = p.NextStep()
+	should.Empty(steps)
+	should.True(isComplete)
+	should.Equal(pipeline.STEP_STATUS_SKIP, p.Stages[0].Steps[2].Status.Status)
+	should.Equal(pipeline.STEP_STATUS_SKIP, p.Stages[1].Steps[0].Status.Status)
+	should.True(p.Stages[0].IsPassed())
+	should.True(p.Stages[1].IsPassed())
+}
+
+func TestPipelineStepConditionError(t *testing.T) {
+	should := assert.New(t)
+
+	p := ConditionPipeline("prod")
+	p.Stages[0].Steps[0].If = "unknown()"
+
+	steps, _ := p.NextStep()
+	should.Equal([]string{"cleanup"}, stepNames(steps))
+	should.Equal(pipeline.STEP_STATUS_FAILED, p.Stages[0].Steps[0].Status.Status)
+	should.True(p.Stages[0].IsBreakNow())
+}
+
+func TestPipelineMarkTimeout(t *testing.T) {
+	should := assert.New(t)
+
+	p := DAGPipeline([]dagStage{{name: "a"}, {name: "b"}, {name: "c", needs: []string{"a"}}})
+	p.Timeout = 600
+	should.False(p.IsTimeout(time.Now()))
+	p.Run()
+	should.False(p.IsTimeout(time.Now()))
+	should.True(p.IsTimeout(time.Now().Add(time.Hour)))
+
+	steps, _ := p.NextStep()
+	should.Equal([]string{"a", "b"}, stepNames(steps))
+	steps[0].Success("")
+	steps[1].Run()
+	steps[1].Retry = &pipeline.RetryPolicy{MaxAttempts: 3}
+
+	scheduled := p.MarkTimeout()
+	should.Equal([]string{"b"}, stepNames(scheduled))
+	should.Equal(pipeline.STEP_STATUS_SUCCEEDED, p.Stages[0].Steps[0].Status.Status)
+	should.Equal(pipeline.STEP_STATUS_FAILED, p.Stages[1].Steps[0].Status.Status)
+	should.Equal(pipeline.STEP_STATUS_SKIP, p.Stages[2].Steps[0].Status.Status)
+	should.False(p.Stages[1].Steps[0].IsRetryable())
+	should.True(p.IsComplete())
+	should.True(p.IsFailed())
+	should.Equal(pipeline.PIPELINE_STATUS_TIMEOUT, p.Status.Status)
+	should.Contains(p.Status.Message, "pipeline run timeout")
+}
+
+func TestPipelineCompleteStatus(t *testing.T) {
+	should := assert.New(t)
+
+	p := DAGPipeline([]dagStage{{name: "a"}})
+	p.Run()
+	steps, _ := p.NextStep()
+	should.Equal([]string{"a"}, stepNames(steps))
+	steps[0].Success("")
+	_, isComplete := p.NextStep()
+	should.True(isComplete)
+	p.Complete()
+	should.Equal(pipeline.PIPELINE_STATUS_SUCCEEDED, p.Status.Status)
+	should.False(p.IsFailed())
+	should.Empty(p.Status.Message)
+
+	p = DAGPipeline([]dagStage{{name: "a"}, {name: "b"}})
+	p.Run()
+	steps, _ = p.NextStep()
+	should.Equal([]string{"a"}, stepNames(steps))
+	steps[0].Failed("exit code 1")
+	_, isComplete = p.NextStep()
+	should.True(isComplete)
+	p.Complete()
+	should.Equal(pipeline.PIPELINE_STATUS_FAILED, p.Status.Status)
+	should.True(p.IsFailed())
+	should.Contains(p.Status.Message, "a(FAILED): exit code 1")
+
+	req := pipeline.NewQueryPipelineRequest()
+	should.True(req.MatchStatus(p))
+	should.NoError(req.ParseStatus("succeeded, timeout"))
+	should.False(req.MatchStatus(p))
+	should.NoError(req.ParseStatus("FAILED"))
+	should.True(req.MatchStatus(p))
+	should.Error(req.ParseStatus("unknown"))
+}
+
+func TestTriggerIsMatch(t *testing.T) {
+	should := assert.New(t)
+
+	trigger := &pipeline.Trigger{
+		Events:   []string{"push", "merge_request"},
+		Branches: []string{"master", "release-.*"},
+	}
+	should.True(trigger.IsMatch("master", "push"))
+	should.True(trigger.IsMatch("release-1.0", "merge_request"))
+	should.False(trigger.IsMatch("master-fix", "push"))
+	should.False(trigger.IsMatch("dev", "push"))
+	should.False(trigger.IsMatch("master", "tag"))
+
+	var empty *pipeline.Trigger
+	should.False(empty.IsMatch("master", "push"))
+}
+
+func TestPipelineRetry(t *testing.T) {
+	should := assert.New(t)
+
+	p := DAGPipeline([]dagStage{
+		{name: "a"},
+		{name: "b", needs: []string{"a"}},
+		{name: "c", needs: []string{"b"}},
+		{name: "d"},
+	})
+	p.Id = "p01"
+	p.Namespace = "ns01"
+
+	// 没有结束的pipeline不能重试
+	_, err := p.Retry()
+	should.Error(err)
+
+	steps, _ := p.NextStep()
+	should.Equal([]string{"a", "d"}, stepNames(steps))
+	steps[0].Failed("failed")
+	steps[1].UpdateCtx(map[string]string{"IMAGE": "v1"})
+	steps[1].Success("")
+	steps, isComplete := p.NextStep()
+	should.Len(steps, 0)
+	should.True(isComplete)
+	p.Complete()
+	should.True(p.IsFailed())
+
+	stale, err := p.Retry()
+	should.NoError(err)
+	should.Equal([]string{"a"}, stepNames(stale))
+	should.Equal("ns01.p01.1.1", stale[0].Key)
+	should.False(p.IsComplete())
+	should.Equal(int64(2), p.CurrentFlowNumber())
+
+	// 执行成功的step保留状态和输出
+	d := p.GetStageByName("d").Steps[0]
+	should.True(d.IsSucceeded())
+	should.Equal("v1", d.Outputs()["IMAGE"])
+	should.Equal(pipeline.STEP_STATUS_PENDDING, p.GetStageByName("b").Steps[0].Status.Status)
+
+	steps, _ = p.NextStep()
+	should.Equal([]string{"a"}, stepNames(steps))
+	should.Equal(int64(3), steps[0].FlowNumber())
+	steps[0].Success("")
+	steps, _ = p.NextStep()
+	should.Equal([]string{"b"}, stepNames(steps))
+
+	// 没有失败的step时不需要重试
+	steps[0].Success("")
+	steps, _ = p.NextStep()
+	steps[0].Success("")
+	_, isComplete = p.NextStep()
+	should.True(isComplete)
+	p.Complete()
+	_, err = p.Retry()
+	should.Error(err)
+}
+
+func TestPipelineRerun(t *testing.T) {
+	should := assert.New(t)
+
+	p := DAGPipeline([]dagStage{{name: "a"}, {name: "b"}})
+	p.Id = "p01"
+	p.Namespace = "ns01"
+	p.CreateBy = "admin"
+	p.CronjobId = "cron01"
+	steps, _ := p.NextStep()
+	steps[0].Success("")
+	steps, _ = p.NextStep()
+	steps[0].Failed("failed")
+	p.Complete()
+
+	ins, err := p.Rerun("user01")
+	should.NoError(err)
+	should.NotEqual("p01", ins.Id)
+	should.Equal("p01", ins.RerunFrom)
+	should.Equal("user01", ins.CreateBy)
+	should.Equal("", ins.CronjobId)
+	should.False(ins.IsComplete())
+	should.Equal(int64(0), ins.CurrentFlowNumber())
+	for _, stage := range ins.Stages {
+		should.Equal("", stage.Steps[0].Key)
+		should.Equal(int64(0), stage.Steps[0].FlowNumber())
+		should.False(stage.Steps[0].IsComplete())
+	}
+
+	// 原来的pipeline不受影响
+	should.True(p.IsComplete())
+	should.True(p.Stages[0].Steps[0].IsSucceeded())
+}
+
+func TestPipelineCancel(t *testing.T) {
+	should := assert.New(t)
+
+	p := DAGPipeline([]dagStage{
+		{name: "a"},
+		{name: "b"},
+		{name: "c"},
+		{name: "d", needs: []string{"a"}},
+	})
+	p.Run()
+	steps, _ := p.NextStep()
+	should.Equal([]string{"a", "b", "c"}, stepNames(steps))
+	steps[0].Run()
+	steps[1].Success("")
+	steps[2].Retry = &pipeline.RetryPolicy{MaxAttempts: 3}
+
+	should.NoError(p.Cancel("canceled by admin"))
+	should.True(p.IsCanceling())
+	should.False(p.IsComplete())
+
+	// 取消中不再调度新的step
+	next, isComplete := p.NextStep()
+	should.Len(next, 0)
+	should.False(isComplete)
+
+	scheduled, changed := p.CancelSteps()
+	should.True(changed)
+	should.Equal([]string{"a", "c"}, stepNames(scheduled))
+	should.Equal(pipeline.STEP_STATUS_CANCELING, steps[0].Status.Status)
+	should.Equal(pipeline.STEP_STATUS_SUCCEEDED, steps[1].Status.Status)
+	should.Equal(pipeline.STEP_STATUS_CANCELED, steps[2].Status.Status)
+	should.Nil(steps[2].Retry)
+	should.Equal(pipeline.STEP_STATUS_SKIP, p.GetStageByName("d").Steps[0].Status.Status)
+	should.True(p.IsCanceling())
+
+	// 等待节点上报取消结果
+	scheduled, changed = p.CancelSteps()
+	should.Len(scheduled, 0)
+	should.False(changed)
+
+	steps[0].Canceled("canceled")
+	_, isComplete = p.NextStep()
+	should.True(isComplete)
+	_, changed = p.CancelSteps()
+	should.True(changed)
+	should.True(p.IsComplete())
+	should.Equal(pipeline.PIPELINE_STATUS_CANCELED, p.Status.Status)
+	should.Equal("canceled by admin", p.Status.Message)
+
+	should.Error(p.Cancel("again"))
+}
+
+func TestQueryPipelineRequestMatch(t *testing.T) {
+	should := assert.New(t)
+
+	p := &pipeline.Pipeline{
+		Namespace:  "ns01",
+		Name:       "deploy",
+		TemplateId: "t01",
+		CreateAt:   1000,
+		Tags:       map[string]string{"env": "prod", "app": "api"},
+		Status:     pipeline.NewDefaultPipelineStatus(),
+	}
+
+	req := pipeline.NewQueryPipelineRequest()
+	should.False(req.HasFilter())
+	should.True(req.Match(p))
+
+	// 空间通过key前缀过滤
+	req.Namespace = "ns01"
+	should.False(req.HasFilter())
+	should.True(req.Match(p))
+
+	req.TemplateId = "t01"
+	req.Tags = map[string]string{"env": "prod"}
+	req.CreateStartAt = 1000
+	req.CreateEndAt = 2000
+	should.True(req.HasFilter())
+	should.True(req.Match(p))
+
+	req.Tags["app"] = "web"
+	should.False(req.Match(p))
+	delete(req.Tags, "app")
+
+	req.CreateEndAt = 1000
+	should.False(req.Match(p))
+	req.CreateEndAt = 0
+
+	req.Name = "build"
+	should.False(req.Match(p))
+}
+
+func TestQueryPipelineRequestIdRange(t *testing.T) {
+	should := assert.New(t)
+
+	req := pipeline.NewQueryPipelineRequest()
+	from, to := req.IdRange()
+	should.Equal("", from)
+	should.Equal("", to)
+
+	// id按照创建时间递增, 按字符串比较的顺序和创建时间一致
+	now := time.Now()
+	before := xid.NewWithTime(now.Add(-time.Hour)).String()
+	current := xid.NewWithTime(now).String()
+	after := xid.NewWithTime(now.Add(time.Hour)).String()
+	should.True(before < current && current < after)
+
+	req.CreateStartAt = now.Add(-time.Minute).UnixMilli()
+	req.CreateEndAt = now.Add(time.Minute).UnixMilli()
+	from, to = req.IdRange()
+	should.True(from > before && from <= current)
+	should.True(to > current && to < after)
+}
+
+func TestPipelineIsArchivable(t *testing.T) {
+	should := assert.New(t)
+
+	p := DAGPipeline([]dagStage{{name: "a"}})
+	p.Run()
+	should.False(p.IsArchivable(time.Now(), 0))
+
+	steps, _ := p.NextStep()
+	steps[0].Success("")
+	p.Complete()
+	now := time.UnixMilli(p.Status.EndAt)
+	should.True(p.IsArchivable(now, 0))
+	should.False(p.IsArchivable(now.Add(time.Hour), 2*time.Hour))
+	should.True(p.IsArchivable(now.Add(3*time.Hour), 2*time.Hour))
+
+	req := pipeline.NewQueryPipelineHistoryRequest("ns01", "t01")
+	should.NoError(req.Validate())
+	query := req.QueryPipelineRequest()
+	should.Equal("t01", query.TemplateId)
+	should.True(query.MatchStatus(p))
+}
@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.1
+// source: api/apps/pipeline/pb/status.proto
+
+// 流水线状态与step状态的枚举值同名, 枚举值在包内不能重复, 因此单独定义在一个包中
+
+package pipeline
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PIPELINE_STATUS 流水线状态
+type PIPELINE_STATUS int32
+
+const (
+	// 已经调度完成, 等待执行
+	PIPELINE_STATUS_WAITTING PIPELINE_STATUS = 0
+	// 执行中
+	PIPELINE_STATUS_EXECUTING PIPELINE_STATUS = 1
+	// 执行结束, 之前版本的结束状态, 不区分成功还是失败
+	PIPELINE_STATUS_COMPLETE PIPELINE_STATUS = 2
+	// 取消中, 等待正在运行的step取消完成
+	PIPELINE_STATUS_CANCELING PIPELINE_STATUS = 3
+	// 取消完成
+	PIPELINE_STATUS_CANCELED PIPELINE_STATUS = 4
+	// 执行成功
+	PIPELINE_STATUS_SUCCEEDED PIPELINE_STATUS = 5
+	// 执行失败, 有step执行失败导致stage中断
+	PIPELINE_STATUS_FAILED PIPELINE_STATUS = 6
+	// 执行超时
+	PIPELINE_STATUS_TIMEOUT PIPELINE_STATUS = 7
+)
+
+// Enum value maps for PIPELINE_STATUS.
+var (
+	PIPELINE_STATUS_name = map[int32]string{
+		0: "WAITTING",
+		1: "EXECUTING",
+		2: "COMPLETE",
+		3: "CANCELING",
+		4: "CANCELED",
+		5: "SUCCEEDED",
+		6: "FAILED",
+		7: "TIMEOUT",
+	}
+	PIPELINE_STATUS_value = map[string]int32{
+		"WAITTING":  0,
+		"EXECUTING": 1,
+		"COMPLETE":  2,
+		"CANCELING": 3,
+		"CANCELED":  4,
+		"SUCCEEDED": 5,
+		"FAILED":    6,
+		"TIMEOUT":   7,
+	}
+)
+
+func (x PIPELINE_STATUS) Enum() *PIPELINE_STATUS {
+	p := new(PIPELINE_STATUS)
+	*p = x
+	return p
+}
+
+func (x PIPELINE_STATUS) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PIPELINE_STATUS) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_apps_pipeline_pb_status_proto_enumTypes[0].Descriptor()
+}
+
+func (PIPELINE_STATUS) Type() protoreflect.EnumType {
+	return &file_api_apps_pipeline_pb_status_proto_enumTypes[0]
+}
+
+func (x PIPELINE_STATUS) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PIPELINE_STATUS.Descriptor instead.
+func (PIPELINE_STATUS) EnumDescriptor() ([]byte, []int) {
+	return file_api_apps_pipeline_pb_status_proto_rawDescGZIP(), []int{0}
+}
+
+var File_api_apps_pipeline_pb_status_proto protoreflect.FileDescriptor
+
+var file_api_apps_pipeline_pb_status_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x23, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0x81, 0x01, 0x0a, 0x0f, 0x50, 0x49, 0x50,
+	0x45, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x12, 0x0c, 0x0a, 0x08,
+	0x57, 0x41, 0x49, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x58,
+	0x45, 0x43, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4d,
+	0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45,
+	0x4c, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45,
+	0x44, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12,
+	0x0b, 0x0a, 0x07, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x07, 0x42, 0x32, 0x5a, 0x30,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_apps_pipeline_pb_status_proto_rawDescOnce sync.Once
+	file_api_apps_pipeline_pb_status_proto_rawDescData = file_api_apps_pipeline_pb_status_proto_rawDesc
+)
+
+func file_api_apps_pipeline_pb_status_proto_rawDescGZIP() []byte {
+	file_api_apps_pipeline_pb_status_proto_rawDescOnce.Do(func() {
+		file_api_apps_pipeline_pb_status_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_apps_pipeline_pb_status_proto_rawDescData)
+	})
+	return file_api_apps_pipeline_pb_status_proto_rawDescData
+}
+
+var file_api_apps_pipeline_pb_status_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_apps_pipeline_pb_status_proto_goTypes = []interface{}{
+	(PIPELINE_STATUS)(0), // 0: infraboard.workflow.pipeline.status.PIPELINE_STATUS
+}
+var file_api_apps_pipeline_pb_status_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_api_apps_pipeline_pb_status_proto_init() }
+func file_api_apps_pipeline_pb_status_proto_init() {
+	if File_api_apps_pipeline_pb_status_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_apps_pipeline_pb_status_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_apps_pipeline_pb_status_proto_goTypes,
+		DependencyIndexes: file_api_apps_pipeline_pb_status_proto_depIdxs,
+		EnumInfos:         file_api_apps_pipeline_pb_status_proto_enumTypes,
+	}.Build()
+	File_api_apps_pipeline_pb_status_proto = out.File
+	file_api_apps_pipeline_pb_status_proto_rawDesc = nil
+	file_api_apps_pipeline_pb_status_proto_goTypes = nil
+	file_api_apps_pipeline_pb_status_proto_depIdxs = nil
+}
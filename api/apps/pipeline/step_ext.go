@@ -9,10 +9,16 @@ import (
 
 	"github.com/infraboard/mcube/http/request"
 	"google.golang.org/protobuf/proto"
+
+	"github.com/infraboard/workflow/api/apps/pipeline/variable"
 )
 
 const (
 	AUDIT_NOTIFY_MARK_KEY = "AUDIT_NOTIFY_HAS_SEND"
+
+	// runner上传日志后记录在response中
+	LOG_DRIVER_KEY = "log_driver"
+	LOG_PATH_KEY   = "log_path"
 )
 
 func NewFlow(number int64, items []*Step) *Flow {
@@ -27,7 +33,7 @@ type Flow struct {
 	items  []*Step
 }
 
-// 判断 这个flow有没有中断
+// 判断 这个flow有没有中断, 被跳过的step不算中断
 func (f *Flow) IsBreak() bool {
 	for i := range f.items {
 		step := f.items[i]
@@ -83,6 +89,27 @@ func (s *Stage) UpdateStep(item *Step) error {
 	return nil
 }
 
+// stepsBefore 在step之前执行结束的step, 并行的step和它之前连续的并行step在同一组中执行
+func (s *Stage) stepsBefore(current *Step) []*Step {
+	index := -1
+	for i := range s.Steps {
+		if s.Steps[i].Key == current.Key {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil
+	}
+
+	if current.IsParallel {
+		for index > 0 && s.Steps[index-1].IsParallel {
+			index--
+		}
+	}
+	return s.Steps[:index]
+}
+
 func (s *Stage) GetStepByKey(key string) (*Step, error) {
 	for i := range s.Steps {
 		step := s.Steps[i]
@@ -139,8 +166,9 @@ func (s *Stage) GetFlow(flowNumber int64) *Flow {
 	for i := range s.Steps {
 		step := s.Steps[i]
 
+		// 同一个stage中step的flow编号是递增的
 		if step.FlowNumber() > flowNumber {
-			return nil
+			break
 		}
 
 		if step.FlowNumber() == flowNumber {
@@ -155,6 +183,21 @@ func (s *Stage) GetFlow(flowNumber int64) *Flow {
 	return NewFlow(flowNumber, steps)
 }
 
+// CurrentFlow stage最近一次调度的flow, 还没有调度时返回nil
+func (s *Stage) CurrentFlow() *Flow {
+	var current int64
+	for i := range s.Steps {
+		if n := s.Steps[i].FlowNumber(); n > current {
+			current = n
+		}
+	}
+
+	if current == 0 {
+		return nil
+	}
+	return s.GetFlow(current)
+}
+
 func (s *Stage) IsRunning() bool {
 	for i := range s.Steps {
 		step := s.Steps[i]
@@ -181,14 +224,56 @@ func (s *Stage) IsBreakNow() bool {
 	return false
 }
 
-// 最后一个step都没有中断
+// IsComplete 所有step都执行结束, 包括被跳过的
+func (s *Stage) IsComplete() bool {
+	for i := range s.Steps {
+		if !s.Steps[i].IsComplete() {
+			return false
+		}
+	}
+	return true
+}
+
+// 所有step都执行结束, 并且没有中断
+// 中断后 if条件为failure()的step仍然会执行, 因此不能只看最后一个step
 func (s *Stage) IsPassed() bool {
-	step := s.LastStep()
-	if step == nil {
-		return true
+	return s.IsComplete() && !s.IsBreakNow()
+}
+
+// 第一个失败, 取消或者审核拒绝的step, 没有时返回-1
+func (s *Stage) failedIndex() int {
+	for i := range s.Steps {
+		step := s.Steps[i]
+		if step.IgnoreFailed || step.Status == nil {
+			continue
+		}
+		if step.Status.Status.IsIn(
+			STEP_STATUS_FAILED,
+			STEP_STATUS_CANCELED,
+			STEP_STATUS_REFUSE,
+			STEP_STATUS_SCHEDULE_FAILED,
+		) {
+			return i
+		}
 	}
+	return -1
+}
+
+// 重置从index开始的所有step, 返回已经调度过的step
+func (s *Stage) resetSteps(index int) (stale []*Step) {
+	for i := index; i < len(s.Steps); i++ {
+		stale = append(stale, s.Steps[i].reset()...)
+	}
+	return
+}
 
-	return step.IsPassed()
+// 重置step状态等待重新调度, 已经调度过的step需要删除etcd中旧的step
+func (s *Step) reset() (stale []*Step) {
+	if s.FlowNumber() > 0 {
+		stale = append(stale, s)
+	}
+	s.Status = NewDefaultStepStatus()
+	return
 }
 
 // LoadStepFromBytes 解析etcd 的step数据
@@ -234,6 +319,8 @@ func NewStep(t STEP_CREATE_BY, req *CreateStepRequest) *Step {
 		NotifyParams: req.NotifyParams,
 		Webhooks:     req.Webhooks,
 		NodeSelector: req.NodeSelector,
+		Retry:        req.Retry,
+		Timeout:      req.Timeout,
 		Status:       NewDefaultStepStatus(),
 	}
 }
@@ -295,6 +382,21 @@ func (s *Step) Failed(format string, a ...interface{}) {
 	s.Status.Message = fmt.Sprintf(format, a...)
 }
 
+// Skip if条件不满足, 跳过执行
+func (s *Step) Skip(format string, a ...interface{}) {
+	s.Status.EndAt = time.Now().UnixMilli()
+	s.Status.Status = STEP_STATUS_SKIP
+	s.Status.Message = fmt.Sprintf(format, a...)
+}
+
+// ConditionExpr step的执行条件, 没有设置时为success()
+func (s *Step) ConditionExpr() string {
+	if s.If == "" {
+		return variable.FUNC_SUCCESS + "()"
+	}
+	return s.If
+}
+
 func (s *Step) ScheduleFailed(format string, a ...interface{}) {
 	s.Status.EndAt = time.Now().UnixMilli()
 	s.Status.Status = STEP_STATUS_SCHEDULE_FAILED
@@ -306,6 +408,13 @@ func (s *Step) Cancel(format string, a ...interface{}) {
 	s.Status.Message = fmt.Sprintf(format, a...)
 }
 
+// Canceled 还没有开始运行的step, 不需要节点处理, 直接取消完成
+func (s *Step) Canceled(format string, a ...interface{}) {
+	s.Status.EndAt = time.Now().UnixMilli()
+	s.Status.Status = STEP_STATUS_CANCELED
+	s.Status.Message = fmt.Sprintf(format, a...)
+}
+
 func (s *Step) Audit(resp AUDIT_RESPONSE, message string) {
 	s.Status.AuditAt = time.Now().UnixMilli()
 	s.Status.AuditResponse = resp
@@ -356,6 +465,38 @@ func (s *Step) UpdateCtx(resp map[string]string) {
 	}
 }
 
+// Outputs step运行时产生的输出, 不包含系统内部使用的标记
+func (s *Step) Outputs() map[string]string {
+	m := map[string]string{}
+	if s.Status == nil {
+		return m
+	}
+
+	for k, v := range s.Status.ContextMap {
+		if k == AUDIT_NOTIFY_MARK_KEY {
+			continue
+		}
+		m[k] = v
+	}
+	return m
+}
+
+// LogDriver 日志保存的存储, 和LogPath一起用于读取持久化的日志
+func (s *Step) LogDriver() string {
+	if s.Status == nil {
+		return ""
+	}
+	return s.Status.Response[LOG_DRIVER_KEY]
+}
+
+// LogPath 日志在存储中的ObjectID
+func (s *Step) LogPath() string {
+	if s.Status == nil {
+		return ""
+	}
+	return s.Status.Response[LOG_PATH_KEY]
+}
+
 func (s *Step) MakeObjectKey() string {
 	return StepObjectKey(s.Key)
 }
@@ -409,6 +550,11 @@ func (s *Step) IsComplete() bool {
 		return false
 	}
 
+	// 等待重试的step还没有结束
+	if s.IsRetryable() {
+		return false
+	}
+
 	return s.Status.Status.IsIn(
 		STEP_STATUS_SUCCEEDED,
 		STEP_STATUS_FAILED,
@@ -434,6 +580,11 @@ func (s *Step) IsBreakNow() bool {
 		return false
 	}
 
+	// 还可以重试的不算中断
+	if s.IsRetryable() {
+		return false
+	}
+
 	// 忽略失败的已计算为通过
 	if s.IgnoreFailed {
 		return false
@@ -447,11 +598,23 @@ func (s *Step) IsBreakNow() bool {
 	)
 }
 
+func (s *Step) IsSucceeded() bool {
+	if s.Status == nil {
+		return false
+	}
+
+	return s.Status.Status.Equal(STEP_STATUS_SUCCEEDED)
+}
+
 func (s *Step) IsPassed() bool {
 	if s.Status == nil {
 		return false
 	}
 
+	if s.IsRetryable() {
+		return false
+	}
+
 	// 忽略失败的已计算为通过
 	if s.IgnoreFailed {
 		return true
@@ -534,11 +697,89 @@ func NewDefaultStepStatus() *StepStatus {
 // NewQueryStepRequest 查询book列表
 func NewQueryStepRequest() *QueryStepRequest {
 	return &QueryStepRequest{
-		Page: request.NewDefaultPageRequest(),
+		Page: &request.NewDefaultPageRequest().PageRequest,
 	}
 }
 
+// ParseStatus 解析逗号分隔的状态过滤条件, 例如: FAILED,CANCELED
+func (req *QueryStepRequest) ParseStatus(s string) error {
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		status, err := ParseSTEP_STATUSFromString(item)
+		if err != nil {
+			return err
+		}
+		req.Status = append(req.Status, status)
+	}
+	return nil
+}
+
+// EtcdPrefix 查询的key前缀
+// pipeline创建的step的key以空间和pipeline id开头, 同时指定时只查询该pipeline的step
+func (req *QueryStepRequest) EtcdPrefix() string {
+	switch {
+	case req.Key != "":
+		return StepObjectKey(req.Key)
+	case req.isPipelineScoped():
+		return StepObjectKey(fmt.Sprintf("%s.%s.", req.Namespace, req.PipelineId))
+	default:
+		return EtcdStepPrefix() + "/"
+	}
+}
+
+func (req *QueryStepRequest) isPipelineScoped() bool {
+	return req.Key == "" && req.Namespace != "" && req.PipelineId != ""
+}
+
+// HasFilter 是否有需要解析对象后才能判断的过滤条件
+func (req *QueryStepRequest) HasFilter() bool {
+	if (req.Namespace != "" || req.PipelineId != "") && !req.isPipelineScoped() {
+		return true
+	}
+	return req.Name != "" || len(req.Status) > 0 ||
+		req.CreateStartAt > 0 || req.CreateEndAt > 0
+}
+
+// Match step是否满足所有过滤条件
+func (req *QueryStepRequest) Match(s *Step) bool {
+	if req.Namespace != "" && s.Namespace != req.Namespace {
+		return false
+	}
+	if req.PipelineId != "" && s.PipelineId != req.PipelineId {
+		return false
+	}
+	if req.Name != "" && s.Name != req.Name {
+		return false
+	}
+	if !isInTimeRange(s.CreateAt, req.CreateStartAt, req.CreateEndAt) {
+		return false
+	}
+	if len(req.Status) == 0 {
+		return true
+	}
+	return s.Status != nil && s.Status.Status.IsIn(req.Status...)
+}
+
 // NewDescribeStepRequestWithKey 查询book列表
+func NewWatchStepLogRequest(key string) *WatchStepLogRequest {
+	return &WatchStepLogRequest{
+		Key: key,
+	}
+}
+
+func (req *WatchStepLogRequest) Validate() error {
+	if req.Key == "" {
+		return fmt.Errorf("step key required")
+	}
+	if req.Offset < 0 || req.Tail < 0 {
+		return fmt.Errorf("offset and tail must not be negative")
+	}
+	return nil
+}
+
 func NewDescribeStepRequestWithKey(key string) *DescribeStepRequest {
 	return &DescribeStepRequest{
 		Key: key,
@@ -580,3 +821,125 @@ func (h *WebHook) IsMatch(t STEP_STATUS) bool {
 func (req *DeleteStepRequest) Validate() error {
 	return validate.Struct(req)
 }
+
+// Attempt 当前是第几次执行, 从1开始
+func (s *Step) Attempt() int32 {
+	if s.Status == nil {
+		return 1
+	}
+	return int32(len(s.Status.Attempts)) + 1
+}
+
+// AttemptKey 每次执行使用不同的标识, 用于runner创建容器或者Job, 防止重试时和之前的冲突
+func (s *Step) AttemptKey() string {
+	if n := s.Attempt(); n > 1 {
+		return fmt.Sprintf("%s.%d", s.Key, n)
+	}
+	return s.Key
+}
+
+// IsRetryable step执行失败后, 是否还可以按照重试策略重试
+func (s *Step) IsRetryable() bool {
+	if s.Retry == nil || s.Status == nil {
+		return false
+	}
+
+	if s.Attempt() >= s.Retry.MaxAttempts {
+		return false
+	}
+
+	return s.Retry.IsRetryOn(s.Status.Status)
+}
+
+// NextAttempt 保存本次执行的记录, 重置状态后等待重新调度
+func (s *Step) NextAttempt() {
+	st := s.Status
+	st.Attempts = append(st.Attempts, &StepAttempt{
+		Attempt:       s.Attempt(),
+		StartAt:       st.StartAt,
+		EndAt:         st.EndAt,
+		Status:        st.Status,
+		ScheduledNode: st.ScheduledNode,
+		Message:       st.Message,
+	})
+
+	backoff := s.Retry.BackoffDuration(int32(len(st.Attempts)))
+	st.RetryAt = time.Now().Add(backoff).UnixMilli()
+	st.StartAt = 0
+	st.EndAt = 0
+	st.Status = STEP_STATUS_PENDDING
+	st.ScheduledNode = ""
+	st.Message = ""
+	st.Response = nil
+}
+
+// RetryWait 距离下次重试还需要等待的时间
+func (s *Step) RetryWait() time.Duration {
+	if s.Status == nil || s.Status.RetryAt == 0 {
+		return 0
+	}
+
+	wait := time.Until(time.UnixMilli(s.Status.RetryAt))
+	if wait < 0 {
+		return 0
+	}
+	return wait
+}
+
+func (p *RetryPolicy) Validate() error {
+	for _, st := range p.RetryOn {
+		if !st.IsIn(STEP_STATUS_FAILED, STEP_STATUS_SCHEDULE_FAILED) {
+			return fmt.Errorf("retry on status %s not support, only support %s and %s",
+				st, STEP_STATUS_FAILED, STEP_STATUS_SCHEDULE_FAILED)
+		}
+	}
+	if p.Backoff < 0 || p.MaxBackoff < 0 {
+		return fmt.Errorf("backoff must be greater than or equal to 0")
+	}
+	return nil
+}
+
+// IsRetryOn 该状态是否需要重试, 默认只有FAILED重试
+func (p *RetryPolicy) IsRetryOn(st STEP_STATUS) bool {
+	if len(p.RetryOn) == 0 {
+		return st.Equal(STEP_STATUS_FAILED)
+	}
+	return st.IsIn(p.RetryOn...)
+}
+
+// BackoffDuration 第n次重试前需要等待的时间
+func (p *RetryPolicy) BackoffDuration(n int32) time.Duration {
+	backoff := float64(p.Backoff)
+	if p.BackoffFactor > 1 {
+		for i := int32(1); i < n; i++ {
+			backoff *= p.BackoffFactor
+			if p.MaxBackoff > 0 && backoff >= float64(p.MaxBackoff) {
+				break
+			}
+		}
+	}
+
+	if p.MaxBackoff > 0 && backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+	return time.Duration(backoff * float64(time.Second))
+}
+
+// TimeoutDuration step的超时时间, 0表示不限制
+func (s *Step) TimeoutDuration() time.Duration {
+	return time.Duration(s.Timeout) * time.Second
+}
+
+// Deadline step本次执行的截止时间, 从开始执行计算, 没有设置超时或者还没有开始执行时返回false
+func (s *Step) Deadline() (time.Time, bool) {
+	if s.Timeout <= 0 || s.Status == nil || s.Status.StartAt == 0 {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(s.Status.StartAt).Add(s.TimeoutDuration()), true
+}
+
+// IsTimeout 在t时刻step是否已经超时
+func (s *Step) IsTimeout(t time.Time) bool {
+	deadline, ok := s.Deadline()
+	return ok && t.After(deadline)
+}
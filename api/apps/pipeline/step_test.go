@@ -2,6 +2,7 @@ package pipeline_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -15,3 +16,128 @@ func TestStepNamespace(t *testing.T) {
 	s.Key = "c16mhsddrei91m4ri0jg.c3iqcama0brimaq08e40.2.1"
 	should.Equal(s.GetNamespace(), "c16mhsddrei91m4ri0jg")
 }
+
+func TestStepRetry(t *testing.T) {
+	should := assert.New(t)
+
+	s := pipeline.NewDefaultStep()
+	s.Key = "c16mhsddrei91m4ri0jg.c3iqcama0brimaq08e40.2.1"
+	s.Retry = &pipeline.RetryPolicy{MaxAttempts: 3}
+
+	s.SetScheduleNode("node-01")
+	s.Run()
+	s.Failed("network error")
+	should.True(s.IsRetryable())
+	should.False(s.IsComplete())
+	should.False(s.IsBreakNow())
+	should.False(s.IsPassed())
+
+	s.NextAttempt()
+	should.Equal(int32(2), s.Attempt())
+	should.Equal(s.Key+".2", s.AttemptKey())
+	should.Equal(pipeline.STEP_STATUS_PENDDING, s.Status.Status)
+	should.Equal("", s.ScheduledNodeName())
+	if should.Len(s.Status.Attempts, 1) {
+		a := s.Status.Attempts[0]
+		should.Equal(int32(1), a.Attempt)
+		should.Equal(pipeline.STEP_STATUS_FAILED, a.Status)
+		should.Equal("node-01", a.ScheduledNode)
+		should.Equal("network error", a.Message)
+	}
+
+	s.Failed("network error again")
+	should.True(s.IsRetryable())
+	s.NextAttempt()
+
+	// 达到最大次数后不再重试
+	s.Failed("network error again")
+	should.False(s.IsRetryable())
+	should.True(s.IsComplete())
+	should.True(s.IsBreakNow())
+	should.Len(s.Status.Attempts, 2)
+
+	// 默认只重试FAILED
+	s = pipeline.NewDefaultStep()
+	s.Retry = &pipeline.RetryPolicy{MaxAttempts: 3}
+	s.ScheduleFailed("no node")
+	should.False(s.IsRetryable())
+	s.Retry.RetryOn = []pipeline.STEP_STATUS{pipeline.STEP_STATUS_SCHEDULE_FAILED}
+	should.True(s.IsRetryable())
+	s.Cancel("cancel by user")
+	should.False(s.IsRetryable())
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	cases := []struct {
+		policy *pipeline.RetryPolicy
+		n      int32
+		expect time.Duration
+	}{
+		{&pipeline.RetryPolicy{Backoff: 5}, 1, 5 * time.Second},
+		{&pipeline.RetryPolicy{Backoff: 5}, 3, 5 * time.Second},
+		{&pipeline.RetryPolicy{Backoff: 5, BackoffFactor: 2}, 1, 5 * time.Second},
+		{&pipeline.RetryPolicy{Backoff: 5, BackoffFactor: 2}, 3, 20 * time.Second},
+		{&pipeline.RetryPolicy{Backoff: 5, BackoffFactor: 2, MaxBackoff: 15}, 3, 15 * time.Second},
+		{&pipeline.RetryPolicy{}, 2, 0},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.expect, c.policy.BackoffDuration(c.n))
+	}
+
+	should := assert.New(t)
+	should.NoError((&pipeline.RetryPolicy{RetryOn: []pipeline.STEP_STATUS{pipeline.STEP_STATUS_FAILED}}).Validate())
+	should.Error((&pipeline.RetryPolicy{RetryOn: []pipeline.STEP_STATUS{pipeline.STEP_STATUS_CANCELED}}).Validate())
+}
+
+func TestStepTimeout(t *testing.T) {
+	should := assert.New(t)
+
+	s := pipeline.NewDefaultStep()
+	_, ok := s.Deadline()
+	should.False(ok)
+
+	// 还没有开始执行
+	s.Timeout = 60
+	_, ok = s.Deadline()
+	should.False(ok)
+
+	s.Run()
+	deadline, ok := s.Deadline()
+	if should.True(ok) {
+		should.Equal(time.UnixMilli(s.Status.StartAt).Add(time.Minute), deadline)
+	}
+	should.False(s.IsTimeout(time.Now()))
+	should.True(s.IsTimeout(time.Now().Add(2 * time.Minute)))
+}
+
+func TestQueryStepRequestMatch(t *testing.T) {
+	should := assert.New(t)
+
+	s := pipeline.NewDefaultStep()
+	s.Namespace = "ns01"
+	s.PipelineId = "p01"
+	s.Name = "build"
+	s.CreateAt = 1000
+	s.Failed("exit code 1")
+
+	req := pipeline.NewQueryStepRequest()
+	should.False(req.HasFilter())
+	should.True(req.Match(s))
+
+	// 同时指定空间和pipeline时通过key前缀过滤
+	req.Namespace = "ns01"
+	should.True(req.HasFilter())
+	req.PipelineId = "p01"
+	should.False(req.HasFilter())
+	should.True(req.Match(s))
+
+	should.NoError(req.ParseStatus("SUCCEEDED"))
+	should.True(req.HasFilter())
+	should.False(req.Match(s))
+	should.NoError(req.ParseStatus("failed"))
+	should.True(req.Match(s))
+
+	req.CreateStartAt = 2000
+	should.False(req.Match(s))
+}
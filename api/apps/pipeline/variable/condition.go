@@ -0,0 +1,425 @@
+package variable
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// 之前的step都执行成功, if为空时的默认条件
+	FUNC_SUCCESS = "success"
+	// 之前的step有执行失败的
+	FUNC_FAILURE = "failure"
+	// 无论之前的step是否成功都执行
+	FUNC_ALWAYS = "always"
+)
+
+const (
+	CONDITION_TRUE  = "true"
+	CONDITION_FALSE = "false"
+)
+
+// ConditionContext if表达式求值时的上下文
+type ConditionContext struct {
+	// 之前的step是否有失败
+	Failed bool
+	// 查询变量引用的值, 比如 pipeline.with.ENV
+	Resolver *Resolver
+}
+
+// ParseCondition 解析step的if表达式, 支持的语法:
+//   1. 变量引用: pipeline.with.KEY, steps.NAME.outputs.KEY
+//   2. 字面量: 'prod', "prod", true, false, 数字
+//   3. 函数: success(), failure(), always()
+//   4. 运算: ==, !=, !, &&, ||, ()
+// 表达式也可以写在 ${{ }} 中
+func ParseCondition(expr string) (*Condition, error) {
+	c := &Condition{expr: expr}
+
+	content := strings.TrimSpace(expr)
+	if strings.HasPrefix(content, EXPRESSION_START) && strings.HasSuffix(content, EXPRESSION_END) {
+		content = strings.TrimSpace(content[len(EXPRESSION_START) : len(content)-len(EXPRESSION_END)])
+	}
+
+	// 为空时等同于 success()
+	if content == "" {
+		c.root = &funcNode{name: FUNC_SUCCESS}
+		c.hasStatusFunc = true
+		return c, nil
+	}
+
+	tokens, err := tokenize(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid condition %s, %s", expr, err)
+	}
+
+	p := &conditionParser{tokens: tokens, cond: c}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("invalid condition %s, %s", expr, err)
+	}
+	if !p.isEnd() {
+		return nil, fmt.Errorf("invalid condition %s, unexpected %s", expr, p.peek().value)
+	}
+	c.root = root
+
+	return c, nil
+}
+
+// Condition 解析后的if表达式
+type Condition struct {
+	expr string
+	root conditionNode
+	refs []*Reference
+	// 表达式中没有使用状态函数时, 等同于 success() && (expr)
+	hasStatusFunc bool
+}
+
+func (c *Condition) String() string {
+	return c.expr
+}
+
+// References 表达式中的变量引用
+func (c *Condition) References() []*Reference {
+	return c.refs
+}
+
+// Eval 计算表达式的值
+func (c *Condition) Eval(ctx *ConditionContext) (bool, error) {
+	if !c.hasStatusFunc && ctx.Failed {
+		return false, nil
+	}
+
+	v, err := c.root.eval(ctx)
+	if err != nil {
+		return false, err
+	}
+	return isTrue(v), nil
+}
+
+func isTrue(v string) bool {
+	return v != "" && v != CONDITION_FALSE && v != "0"
+}
+
+func boolValue(b bool) string {
+	if b {
+		return CONDITION_TRUE
+	}
+	return CONDITION_FALSE
+}
+
+type conditionNode interface {
+	eval(ctx *ConditionContext) (string, error)
+}
+
+type literalNode struct {
+	value string
+}
+
+func (n *literalNode) eval(ctx *ConditionContext) (string, error) {
+	return n.value, nil
+}
+
+type referenceNode struct {
+	ref *Reference
+}
+
+// 引用的值不存在时为空字符串, 比如 step被跳过时没有输出
+func (n *referenceNode) eval(ctx *ConditionContext) (string, error) {
+	if ctx.Resolver == nil {
+		return "", nil
+	}
+	v, err := ctx.Resolver.Lookup(n.ref)
+	if err != nil {
+		return "", nil
+	}
+	return v, nil
+}
+
+type funcNode struct {
+	name string
+}
+
+func (n *funcNode) eval(ctx *ConditionContext) (string, error) {
+	switch n.name {
+	case FUNC_SUCCESS:
+		return boolValue(!ctx.Failed), nil
+	case FUNC_FAILURE:
+		return boolValue(ctx.Failed), nil
+	case FUNC_ALWAYS:
+		return CONDITION_TRUE, nil
+	default:
+		return "", fmt.Errorf("unknown function %s()", n.name)
+	}
+}
+
+type notNode struct {
+	x conditionNode
+}
+
+func (n *notNode) eval(ctx *ConditionContext) (string, error) {
+	v, err := n.x.eval(ctx)
+	if err != nil {
+		return "", err
+	}
+	return boolValue(!isTrue(v)), nil
+}
+
+type binaryNode struct {
+	op   string
+	x, y conditionNode
+}
+
+func (n *binaryNode) eval(ctx *ConditionContext) (string, error) {
+	x, err := n.x.eval(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// 短路求值
+	switch n.op {
+	case "&&":
+		if !isTrue(x) {
+			return CONDITION_FALSE, nil
+		}
+	case "||":
+		if isTrue(x) {
+			return CONDITION_TRUE, nil
+		}
+	}
+
+	y, err := n.y.eval(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	switch n.op {
+	case "==":
+		return boolValue(x == y), nil
+	case "!=":
+		return boolValue(x != y), nil
+	default:
+		return boolValue(isTrue(y)), nil
+	}
+}
+
+const (
+	tokenIdent = iota
+	tokenString
+	tokenOperator
+)
+
+type token struct {
+	kind  int
+	value string
+}
+
+func tokenize(s string) ([]*token, error) {
+	tokens := []*token{}
+	for i := 0; i < len(s); {
+		ch := s[i]
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r':
+			i++
+		case ch == '\'' || ch == '"':
+			end := strings.IndexByte(s[i+1:], ch)
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed string at %d", i)
+			}
+			tokens = append(tokens, &token{kind: tokenString, value: s[i+1 : i+1+end]})
+			i += end + 2
+		case ch == '(' || ch == ')':
+			tokens = append(tokens, &token{kind: tokenOperator, value: string(ch)})
+			i++
+		case ch == '!' || ch == '=' || ch == '&' || ch == '|':
+			if i+1 < len(s) {
+				op := s[i : i+2]
+				if op == "==" || op == "!=" || op == "&&" || op == "||" {
+					tokens = append(tokens, &token{kind: tokenOperator, value: op})
+					i += 2
+					continue
+				}
+			}
+			if ch != '!' {
+				return nil, fmt.Errorf("unknown operator %c at %d", ch, i)
+			}
+			tokens = append(tokens, &token{kind: tokenOperator, value: "!"})
+			i++
+		case isIdentChar(ch):
+			start := i
+			for i < len(s) && isIdentChar(s[i]) {
+				i++
+			}
+			tokens = append(tokens, &token{kind: tokenIdent, value: s[start:i]})
+		default:
+			return nil, fmt.Errorf("unexpected character %c at %d", ch, i)
+		}
+	}
+	return tokens, nil
+}
+
+// step名称中可能包含 . 和 -
+func isIdentChar(ch byte) bool {
+	return ch == '_' || ch == '.' || ch == '-' ||
+		(ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || (ch >= '0' && ch <= '9')
+}
+
+type conditionParser struct {
+	tokens []*token
+	pos    int
+	cond   *Condition
+}
+
+func (p *conditionParser) isEnd() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *conditionParser) peek() *token {
+	if p.isEnd() {
+		return &token{kind: tokenOperator, value: "end of expression"}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *conditionParser) isOperator(op string) bool {
+	t := p.peek()
+	return !p.isEnd() && t.kind == tokenOperator && t.value == op
+}
+
+func (p *conditionParser) expect(op string) error {
+	if !p.isOperator(op) {
+		return fmt.Errorf("expect %s, but got %s", op, p.peek().value)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *conditionParser) parseOr() (conditionNode, error) {
+	x, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator("||") {
+		p.pos++
+		y, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryNode{op: "||", x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *conditionParser) parseAnd() (conditionNode, error) {
+	x, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator("&&") {
+		p.pos++
+		y, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryNode{op: "&&", x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *conditionParser) parseUnary() (conditionNode, error) {
+	if p.isOperator("!") {
+		p.pos++
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{x: x}, nil
+	}
+	return p.parseCompare()
+}
+
+func (p *conditionParser) parseCompare() (conditionNode, error) {
+	x, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	if p.isOperator("==") || p.isOperator("!=") {
+		op := p.peek().value
+		p.pos++
+		y, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		return &binaryNode{op: op, x: x, y: y}, nil
+	}
+	return x, nil
+}
+
+func (p *conditionParser) parsePrimary() (conditionNode, error) {
+	if p.isEnd() {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+
+	t := p.peek()
+	switch t.kind {
+	case tokenString:
+		p.pos++
+		return &literalNode{value: t.value}, nil
+	case tokenOperator:
+		if t.value != "(" {
+			return nil, fmt.Errorf("unexpected %s", t.value)
+		}
+		p.pos++
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return x, nil
+	}
+
+	p.pos++
+	// 函数调用
+	if p.isOperator("(") {
+		p.pos++
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		switch t.value {
+		case FUNC_SUCCESS, FUNC_FAILURE, FUNC_ALWAYS:
+			p.cond.hasStatusFunc = true
+			return &funcNode{name: t.value}, nil
+		default:
+			return nil, fmt.Errorf("unknown function %s(), support: %s()",
+				t.value, strings.Join([]string{FUNC_SUCCESS, FUNC_FAILURE, FUNC_ALWAYS}, "(), "))
+		}
+	}
+
+	// 字面量
+	if t.value == CONDITION_TRUE || t.value == CONDITION_FALSE || isNumber(t.value) {
+		return &literalNode{value: t.value}, nil
+	}
+
+	// 变量引用, 只能引用pipeline参数和之前step的输出
+	ref, err := ParseReference(t.value)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Scope != SCOPE_PIPELINE && ref.Scope != SCOPE_STEPS {
+		return nil, fmt.Errorf("reference %s not support in condition, only %s and %s can be used",
+			ref, SCOPE_PIPELINE, SCOPE_STEPS)
+	}
+	p.cond.refs = append(p.cond.refs, ref)
+	return &referenceNode{ref: ref}, nil
+}
+
+func isNumber(s string) bool {
+	for i := range s {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
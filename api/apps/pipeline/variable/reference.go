@@ -0,0 +1,155 @@
+package variable
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// ${{ pipeline.with.KEY }}, pipeline 全局参数
+	SCOPE_PIPELINE = "pipeline"
+	// ${{ steps.NAME.outputs.KEY }}, 之前step的输出
+	SCOPE_STEPS = "steps"
+	// ${{ env.KEY }}, 执行step的Node节点的环境变量
+	SCOPE_ENV = "env"
+	// ${{ secrets.NAME }}, secret中存储的敏感信息
+	SCOPE_SECRETS = "secrets"
+)
+
+const (
+	EXPRESSION_START = "${{"
+	EXPRESSION_END   = "}}"
+	// 参数值类型为SECRET_REF时的前缀, 比如 $s$TOKEN, 等同于 ${{ secrets.TOKEN }}
+	SECRET_REF_PREFIX = "$s$"
+)
+
+var (
+	expressionRegexp = regexp.MustCompile(`\$\{\{(.*?)\}\}`)
+	keyRegexp        = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_\-]*$`)
+)
+
+// Reference 变量引用, 比如: ${{ steps.build.outputs.IMAGE_TAG }}
+type Reference struct {
+	// 原始表达式
+	Expression string
+	// 引用的范围
+	Scope string
+	// 引用的step名称, 只有steps范围有
+	Step string
+	// 引用的变量名称
+	Key string
+}
+
+func (r *Reference) String() string {
+	return r.Expression
+}
+
+// ParseReference 解析 ${{ }} 中的内容
+func ParseReference(expression string) (*Reference, error) {
+	content := strings.TrimSpace(expression)
+	content = strings.TrimPrefix(content, EXPRESSION_START)
+	content = strings.TrimSuffix(content, EXPRESSION_END)
+	content = strings.TrimSpace(content)
+
+	ref := &Reference{Expression: expression}
+	parts := strings.Split(content, ".")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid reference %s, format must be <scope>.<name>", expression)
+	}
+
+	ref.Scope = parts[0]
+	switch ref.Scope {
+	case SCOPE_PIPELINE:
+		if len(parts) != 3 || parts[1] != "with" {
+			return nil, fmt.Errorf("invalid reference %s, format must be pipeline.with.<key>", expression)
+		}
+		ref.Key = parts[2]
+	case SCOPE_STEPS:
+		// step名称中可能包含 . 比如 step1.1
+		n := len(parts)
+		if n < 4 || parts[n-2] != "outputs" {
+			return nil, fmt.Errorf("invalid reference %s, format must be steps.<name>.outputs.<key>", expression)
+		}
+		ref.Step = strings.Join(parts[1:n-2], ".")
+		ref.Key = parts[n-1]
+	case SCOPE_ENV, SCOPE_SECRETS:
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid reference %s, format must be %s.<key>", expression, ref.Scope)
+		}
+		ref.Key = parts[1]
+	default:
+		return nil, fmt.Errorf("unknown reference scope %s in %s, support: %s",
+			ref.Scope, expression, strings.Join([]string{SCOPE_PIPELINE, SCOPE_STEPS, SCOPE_ENV, SCOPE_SECRETS}, ","))
+	}
+
+	if !keyRegexp.MatchString(ref.Key) {
+		return nil, fmt.Errorf("invalid reference %s, key %s is illegal", expression, ref.Key)
+	}
+	if ref.Scope == SCOPE_STEPS && strings.TrimSpace(ref.Step) == "" {
+		return nil, fmt.Errorf("invalid reference %s, step name is empty", expression)
+	}
+
+	return ref, nil
+}
+
+// ParseReferences 解析值中所有的变量引用, 没有引用时返回空列表
+func ParseReferences(value string) ([]*Reference, error) {
+	if strings.HasPrefix(value, SECRET_REF_PREFIX) {
+		ref, err := parseSecretRef(value)
+		if err != nil {
+			return nil, err
+		}
+		return []*Reference{ref}, nil
+	}
+
+	refs := []*Reference{}
+	for _, expr := range expressionRegexp.FindAllString(value, -1) {
+		ref, err := ParseReference(expr)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, ref)
+	}
+
+	// 检查未闭合的表达式
+	rest := expressionRegexp.ReplaceAllString(value, "")
+	if strings.Contains(rest, EXPRESSION_START) {
+		return nil, fmt.Errorf("unclosed expression in %s", value)
+	}
+
+	return refs, nil
+}
+
+func parseSecretRef(value string) (*Reference, error) {
+	ref := &Reference{
+		Expression: value,
+		Scope:      SCOPE_SECRETS,
+		Key:        strings.TrimPrefix(value, SECRET_REF_PREFIX),
+	}
+	if !keyRegexp.MatchString(ref.Key) {
+		return nil, fmt.Errorf("invalid secret reference %s, name %s is illegal", value, ref.Key)
+	}
+	return ref, nil
+}
+
+// IsReference 判断值中是否包含变量引用
+func IsReference(value string) bool {
+	return strings.HasPrefix(value, SECRET_REF_PREFIX) || strings.Contains(value, EXPRESSION_START)
+}
+
+// IsSecretReference 整个值只是一个secret引用, 比如 ${{ secrets.TOKEN }} 或者 $s$TOKEN, 不能包含其他内容
+func IsSecretReference(value string) bool {
+	if strings.HasPrefix(value, SECRET_REF_PREFIX) {
+		_, err := parseSecretRef(value)
+		return err == nil
+	}
+
+	v := strings.TrimSpace(value)
+	loc := expressionRegexp.FindStringIndex(v)
+	if loc == nil || loc[0] != 0 || loc[1] != len(v) {
+		return false
+	}
+	ref, err := ParseReference(v)
+	return err == nil && ref.Scope == SCOPE_SECRETS
+}
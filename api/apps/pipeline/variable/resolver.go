@@ -0,0 +1,168 @@
+package variable
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var _ ValueGetter = (*Resolver)(nil)
+
+// SecretGetter 查询secret的值, 由使用方绑定namespace
+type SecretGetter interface {
+	GetSecret(name string) (string, error)
+}
+
+// NewResolver 默认所有范围的变量都无法解析, env不读取进程的环境变量, 需要显式设置
+func NewResolver() *Resolver {
+	return &Resolver{
+		stepOutputs: map[string]map[string]string{},
+		env:         map[string]string{},
+	}
+}
+
+// Resolver 运行时解析变量引用
+type Resolver struct {
+	pipelineWith map[string]string
+	stepOutputs  map[string]map[string]string
+	env          map[string]string
+	secrets      SecretGetter
+}
+
+// SetPipelineWith 设置pipeline全局参数, 未设置时表示step不属于pipeline
+func (r *Resolver) SetPipelineWith(with map[string]string) *Resolver {
+	if with == nil {
+		with = map[string]string{}
+	}
+	r.pipelineWith = with
+	return r
+}
+
+func (r *Resolver) SetStepOutputs(name string, outputs map[string]string) *Resolver {
+	r.stepOutputs[name] = outputs
+	return r
+}
+
+// SetEnv 设置env范围可以引用的变量, 比如节点配置的环境变量白名单
+func (r *Resolver) SetEnv(env map[string]string) *Resolver {
+	if env == nil {
+		env = map[string]string{}
+	}
+	r.env = env
+	return r
+}
+
+func (r *Resolver) SetSecretGetter(g SecretGetter) *Resolver {
+	r.secrets = g
+	return r
+}
+
+// Lookup 查询引用对应的值
+func (r *Resolver) Lookup(ref *Reference) (string, error) {
+	switch ref.Scope {
+	case SCOPE_PIPELINE:
+		if r.pipelineWith == nil {
+			return "", fmt.Errorf("reference %s only available in pipeline", ref)
+		}
+		v, ok := r.pipelineWith[ref.Key]
+		if !ok {
+			return "", fmt.Errorf("reference %s not found, pipeline with has no key %s", ref, ref.Key)
+		}
+		return v, nil
+	case SCOPE_STEPS:
+		outputs, ok := r.stepOutputs[ref.Step]
+		if !ok {
+			return "", fmt.Errorf("reference %s not found, step %s not exist or not succeeded", ref, ref.Step)
+		}
+		v, ok := outputs[ref.Key]
+		if !ok {
+			return "", fmt.Errorf("reference %s not found, step %s has no output %s", ref, ref.Step, ref.Key)
+		}
+		return v, nil
+	case SCOPE_ENV:
+		v, ok := r.env[ref.Key]
+		if !ok {
+			return "", fmt.Errorf("reference %s not found, env %s not set or not allowed", ref, ref.Key)
+		}
+		return v, nil
+	case SCOPE_SECRETS:
+		if r.secrets == nil {
+			return "", fmt.Errorf("reference %s can't resolve, secret getter not set", ref)
+		}
+		v, err := r.secrets.GetSecret(ref.Key)
+		if err != nil {
+			return "", fmt.Errorf("reference %s resolve error, %s", ref, err)
+		}
+		return v, nil
+	default:
+		return "", fmt.Errorf("unknown reference scope %s", ref.Scope)
+	}
+}
+
+// Render 替换值中所有的变量引用
+func (r *Resolver) Render(value string) (string, error) {
+	if !IsReference(value) {
+		return value, nil
+	}
+
+	refs, err := ParseReferences(value)
+	if err != nil {
+		return "", err
+	}
+
+	// SECRET_REF 整个值都是引用
+	if strings.HasPrefix(value, SECRET_REF_PREFIX) {
+		return r.Lookup(refs[0])
+	}
+
+	var renderErr error
+	result := expressionRegexp.ReplaceAllStringFunc(value, func(expr string) string {
+		if renderErr != nil {
+			return expr
+		}
+		ref, err := ParseReference(expr)
+		if err != nil {
+			renderErr = err
+			return expr
+		}
+		v, err := r.Lookup(ref)
+		if err != nil {
+			renderErr = err
+			return expr
+		}
+		return v
+	})
+	if renderErr != nil {
+		return "", renderErr
+	}
+
+	return result, nil
+}
+
+// RenderMap 替换参数中的变量引用, 返回新的参数, 不修改原参数
+func (r *Resolver) RenderMap(params map[string]string) (map[string]string, error) {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	m := make(map[string]string, len(params))
+	for _, k := range keys {
+		v, err := r.Render(params[k])
+		if err != nil {
+			return nil, fmt.Errorf("render param %s error, %s", k, err)
+		}
+		m[k] = v
+	}
+	return m, nil
+}
+
+// Get 实现ValueGetter, 解析失败时返回原值
+func (r *Resolver) Get(v string) string {
+	value, err := r.Render(v)
+	if err != nil {
+		return v
+	}
+	return value
+}
@@ -0,0 +1,126 @@
+package variable
+
+import (
+	"fmt"
+	"sort"
+)
+
+// NewValidator 校验单独创建的step, 不能引用pipeline和其他step
+func NewValidator() *Validator {
+	return &Validator{
+		steps: map[string]bool{},
+	}
+}
+
+// NewPipelineValidator 校验pipeline中的step
+func NewPipelineValidator(with map[string]string) *Validator {
+	if with == nil {
+		with = map[string]string{}
+	}
+	return &Validator{
+		inPipeline:   true,
+		pipelineWith: with,
+		steps:        map[string]bool{},
+	}
+}
+
+// Validator 创建时校验变量引用, 只校验能够静态确定的部分
+type Validator struct {
+	inPipeline   bool
+	pipelineWith map[string]string
+	steps        map[string]bool
+}
+
+// AddStep 添加之前的step, 后面的step可以引用它的输出
+func (v *Validator) AddStep(name string) {
+	v.steps[name] = true
+}
+
+// ResetSteps 只保留可以引用的step, 比如切换stage时只能引用依赖的stage中的step
+func (v *Validator) ResetSteps(names ...string) {
+	v.steps = map[string]bool{}
+	for _, name := range names {
+		v.AddStep(name)
+	}
+}
+
+func (v *Validator) Validate(params map[string]string) error {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		refs, err := ParseReferences(params[k])
+		if err != nil {
+			return fmt.Errorf("param %s error, %s", k, err)
+		}
+		for _, ref := range refs {
+			if err := v.validateReference(ref); err != nil {
+				return fmt.Errorf("param %s error, %s", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidatePipelineWith 校验pipeline的参数, 运行时最先解析, 只能引用env和secrets
+func ValidatePipelineWith(with map[string]string) error {
+	keys := make([]string, 0, len(with))
+	for k := range with {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		refs, err := ParseReferences(with[k])
+		if err != nil {
+			return fmt.Errorf("param %s error, %s", k, err)
+		}
+		for _, ref := range refs {
+			if ref.Scope != SCOPE_ENV && ref.Scope != SCOPE_SECRETS {
+				return fmt.Errorf("param %s error, reference %s not allowed, only env and secrets can be referenced", k, ref)
+			}
+		}
+	}
+	return nil
+}
+
+func (v *Validator) validateReference(ref *Reference) error {
+	switch ref.Scope {
+	case SCOPE_PIPELINE:
+		if !v.inPipeline {
+			return fmt.Errorf("reference %s only available in pipeline", ref)
+		}
+		if _, ok := v.pipelineWith[ref.Key]; !ok {
+			return fmt.Errorf("reference %s not found, pipeline with has no key %s", ref, ref.Key)
+		}
+	case SCOPE_STEPS:
+		if !v.inPipeline {
+			return fmt.Errorf("reference %s only available in pipeline", ref)
+		}
+		if !v.steps[ref.Step] {
+			return fmt.Errorf("reference %s not found, step %s must run before current step, "+
+				"in a needed stage or earlier in the same stage", ref, ref.Step)
+		}
+	}
+
+	return nil
+}
+
+// ValidateCondition 校验step的if表达式
+func (v *Validator) ValidateCondition(expr string) error {
+	cond, err := ParseCondition(expr)
+	if err != nil {
+		return err
+	}
+
+	for _, ref := range cond.References() {
+		if err := v.validateReference(ref); err != nil {
+			return fmt.Errorf("condition %s error, %s", expr, err)
+		}
+	}
+	return nil
+}
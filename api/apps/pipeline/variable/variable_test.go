@@ -0,0 +1,197 @@
+package variable_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/workflow/api/apps/pipeline/variable"
+)
+
+type testSecrets map[string]string
+
+func (s testSecrets) GetSecret(name string) (string, error) {
+	v, ok := s[name]
+	if !ok {
+		return "", fmt.Errorf("secret %s not found", name)
+	}
+	return v, nil
+}
+
+func errorContains(should *assert.Assertions, err error, contains string) {
+	if should.Error(err) {
+		should.Contains(err.Error(), contains)
+	}
+}
+
+func TestParseReference(t *testing.T) {
+	should := assert.New(t)
+
+	ref, err := variable.ParseReference("${{ steps.step1.1.outputs.IMAGE_TAG }}")
+	if should.NoError(err) {
+		should.Equal(variable.SCOPE_STEPS, ref.Scope)
+		should.Equal("step1.1", ref.Step)
+		should.Equal("IMAGE_TAG", ref.Key)
+	}
+
+	_, err = variable.ParseReference("${{ app.NAME }}")
+	errorContains(should, err, "unknown reference scope")
+
+	_, err = variable.ParseReference("${{ pipeline.BRANCH }}")
+	should.Error(err)
+
+	_, err = variable.ParseReferences("image:${{ env.TAG")
+	errorContains(should, err, "unclosed")
+}
+
+func TestIsSecretReference(t *testing.T) {
+	should := assert.New(t)
+
+	should.True(variable.IsSecretReference("${{ secrets.TOKEN }}"))
+	should.True(variable.IsSecretReference(" ${{secrets.TOKEN}} "))
+	should.True(variable.IsSecretReference("$s$TOKEN"))
+
+	should.False(variable.IsSecretReference("hunter2"))
+	should.False(variable.IsSecretReference("hunter2${{ pipeline.with.x }}"))
+	should.False(variable.IsSecretReference("hunter2${{ secrets.TOKEN }}"))
+	should.False(variable.IsSecretReference("${{ secrets.TOKEN }}${{ secrets.TOKEN }}"))
+	should.False(variable.IsSecretReference("${{ pipeline.with.TOKEN }}"))
+	should.False(variable.IsSecretReference("${{ secrets.TOKEN "))
+}
+
+func TestRender(t *testing.T) {
+	should := assert.New(t)
+
+	r := variable.NewResolver().
+		SetPipelineWith(map[string]string{"BRANCH": "master"}).
+		SetStepOutputs("build", map[string]string{"IMAGE_TAG": "v1"}).
+		SetEnv(map[string]string{"REGISTRY": "hub.io"}).
+		SetSecretGetter(testSecrets{"TOKEN": "123456"})
+
+	with, err := r.RenderMap(map[string]string{
+		"IMAGE":  "${{ env.REGISTRY }}/app:${{steps.build.outputs.IMAGE_TAG}}",
+		"BRANCH": "${{ pipeline.with.BRANCH }}",
+		"TOKEN":  "${{ secrets.TOKEN }}",
+		"PLAIN":  "plain",
+		"REF":    "$s$TOKEN",
+	})
+	if should.NoError(err) {
+		should.Equal(map[string]string{
+			"IMAGE":  "hub.io/app:v1",
+			"BRANCH": "master",
+			"TOKEN":  "123456",
+			"PLAIN":  "plain",
+			"REF":    "123456",
+		}, with)
+	}
+
+	_, err = r.Render("${{ steps.deploy.outputs.IMAGE_TAG }}")
+	errorContains(should, err, "step deploy not exist")
+
+	_, err = r.Render("${{ pipeline.with.NOT_EXIST }}")
+	errorContains(should, err, "NOT_EXIST")
+
+	_, err = variable.NewResolver().Render("${{ secrets.TOKEN }}")
+	errorContains(should, err, "secret getter not set")
+
+	// 不读取进程的环境变量, 只能引用显式设置的变量
+	_, err = variable.NewResolver().Render("${{ env.PATH }}")
+	errorContains(should, err, "env PATH not set or not allowed")
+}
+
+func TestValidate(t *testing.T) {
+	should := assert.New(t)
+
+	v := variable.NewPipelineValidator(map[string]string{"BRANCH": "master"})
+	errorContains(should, v.Validate(map[string]string{"TAG": "${{ steps.build.outputs.TAG }}"}), "must run before")
+
+	v.AddStep("build")
+	should.NoError(v.Validate(map[string]string{
+		"TAG":    "${{ steps.build.outputs.TAG }}",
+		"BRANCH": "${{ pipeline.with.BRANCH }}",
+		"TOKEN":  "${{ secrets.TOKEN }}",
+	}))
+	should.Error(v.Validate(map[string]string{"BRANCH": "${{ pipeline.with.TAG }}"}))
+
+	// 切换到不依赖build的stage后不能再引用
+	v.ResetSteps("test")
+	should.Error(v.Validate(map[string]string{"TAG": "${{ steps.build.outputs.TAG }}"}))
+
+	errorContains(should, variable.NewValidator().Validate(map[string]string{"BRANCH": "${{ pipeline.with.BRANCH }}"}), "only available in pipeline")
+}
+
+func TestValidatePipelineWith(t *testing.T) {
+	should := assert.New(t)
+
+	should.NoError(variable.ValidatePipelineWith(map[string]string{
+		"TOKEN": "${{ secrets.TOKEN }}",
+		"HOME":  "${{ env.HOME }}",
+		"ENV":   "prod",
+	}))
+	errorContains(should, variable.ValidatePipelineWith(map[string]string{"TAG": "${{ steps.build.outputs.TAG }}"}), "not allowed")
+	errorContains(should, variable.ValidatePipelineWith(map[string]string{"A": "${{ pipeline.with.B }}"}), "not allowed")
+	should.Error(variable.ValidatePipelineWith(map[string]string{"A": "${{ unknown.B }}"}))
+}
+
+func TestCondition(t *testing.T) {
+	resolver := variable.NewResolver().
+		SetPipelineWith(map[string]string{"ENV": "prod"}).
+		SetStepOutputs("build", map[string]string{"PUSHED": "true"})
+
+	cases := []struct {
+		expr   string
+		failed bool
+		expect bool
+	}{
+		{"", false, true},
+		{"", true, false},
+		{"success()", true, false},
+		{"failure()", true, true},
+		{"failure()", false, false},
+		{"always()", true, true},
+		{`pipeline.with.ENV == "prod"`, false, true},
+		{"${{ pipeline.with.ENV != 'prod' }}", false, false},
+		{`pipeline.with.ENV == "prod"`, true, false},
+		{`always() && pipeline.with.ENV == "prod"`, true, true},
+		{`pipeline.with.NOT_EXIST == ""`, false, true},
+		{"steps.build.outputs.PUSHED", false, true},
+		{"!steps.build.outputs.PUSHED || failure()", false, false},
+		{`(pipeline.with.ENV == "dev" || pipeline.with.ENV == "prod") && true`, false, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.expr, func(t *testing.T) {
+			should := assert.New(t)
+			cond, err := variable.ParseCondition(c.expr)
+			if should.NoError(err) {
+				ok, err := cond.Eval(&variable.ConditionContext{Failed: c.failed, Resolver: resolver})
+				should.NoError(err)
+				should.Equal(c.expect, ok)
+			}
+		})
+	}
+}
+
+func TestParseConditionError(t *testing.T) {
+	should := assert.New(t)
+
+	_, err := variable.ParseCondition("cancelled()")
+	errorContains(should, err, "unknown function cancelled()")
+
+	_, err = variable.ParseCondition("env.HOME == '/root'")
+	errorContains(should, err, "not support in condition")
+
+	_, err = variable.ParseCondition(`pipeline.with.ENV == "prod`)
+	errorContains(should, err, "unclosed string")
+
+	_, err = variable.ParseCondition("(always()")
+	errorContains(should, err, "expect )")
+
+	_, err = variable.ParseCondition("pipeline.with.ENV = 'prod'")
+	errorContains(should, err, "unknown operator")
+
+	vv := variable.NewPipelineValidator(map[string]string{"ENV": "prod"})
+	should.NoError(vv.ValidateCondition("pipeline.with.ENV == 'prod'"))
+	errorContains(should, vv.ValidateCondition("steps.build.outputs.PUSHED"), "must run before current step")
+}
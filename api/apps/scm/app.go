@@ -0,0 +1,5 @@
+package scm
+
+const (
+	AppName = "scm"
+)
@@ -0,0 +1,36 @@
+package scm
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	GITEE_PUSH_HOOK          = "Push Hook"
+	GITEE_TAG_PUSH_HOOK      = "Tag Push Hook"
+	GITEE_MERGE_REQUEST_HOOK = "Merge Request Hook"
+)
+
+// https://gitee.com/help/articles/4186
+// Gitee的事件格式与GitHub基本相同, 事件名称与GitLab相同
+func parseGiteeEvent(event string, body []byte) (*WebHookEvent, error) {
+	switch event {
+	case GITEE_PUSH_HOOK, GITEE_TAG_PUSH_HOOK:
+		data := &githubPushEvent{}
+		if err := json.Unmarshal(body, data); err != nil {
+			return nil, fmt.Errorf("unmarshal gitee push event error, %s", err)
+		}
+		return data.event(), nil
+	case GITEE_MERGE_REQUEST_HOOK:
+		data := &githubPullRequestEvent{}
+		if err := json.Unmarshal(body, data); err != nil {
+			return nil, fmt.Errorf("unmarshal gitee merge request event error, %s", err)
+		}
+		if !isMergeRequestAction(data.Action) {
+			return nil, ErrEventIgnored
+		}
+		return data.event(), nil
+	default:
+		return nil, ErrEventIgnored
+	}
+}
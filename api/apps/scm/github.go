@@ -0,0 +1,169 @@
+package scm
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	GITHUB_PUSH_EVENT         = "push"
+	GITHUB_PULL_REQUEST_EVENT = "pull_request"
+)
+
+type githubCommit struct {
+	Id        string `json:"id"`
+	Message   string `json:"message"`
+	Timestamp string `json:"timestamp"`
+	URL       string `json:"url"`
+	Author    struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	} `json:"author"`
+}
+
+func (c *githubCommit) commit() *Commit {
+	if c == nil {
+		return nil
+	}
+	return &Commit{
+		Id:          c.Id,
+		Message:     c.Message,
+		Timestamp:   c.Timestamp,
+		Url:         c.URL,
+		AuthorName:  c.Author.Name,
+		AuthorEmail: c.Author.Email,
+	}
+}
+
+type githubRepository struct {
+	Name     string `json:"name"`
+	FullName string `json:"full_name"`
+	HTMLURL  string `json:"html_url"`
+	CloneURL string `json:"clone_url"`
+	SSHURL   string `json:"ssh_url"`
+}
+
+func (r *githubRepository) repository() *Repository {
+	return &Repository{
+		Name:       r.Name,
+		FullName:   r.FullName,
+		WebUrl:     r.HTMLURL,
+		GitHttpUrl: r.CloneURL,
+		GitSshUrl:  r.SSHURL,
+	}
+}
+
+type githubUser struct {
+	Name  string `json:"name"`
+	Login string `json:"login"`
+}
+
+func (u *githubUser) name() string {
+	if u.Name != "" {
+		return u.Name
+	}
+	return u.Login
+}
+
+// https://docs.github.com/en/webhooks/webhook-events-and-payloads#push
+// Gitee的推送事件与GitHub格式相同
+type githubPushEvent struct {
+	Ref        string           `json:"ref"`
+	Before     string           `json:"before"`
+	After      string           `json:"after"`
+	Pusher     githubUser       `json:"pusher"`
+	Repository githubRepository `json:"repository"`
+	HeadCommit *githubCommit    `json:"head_commit"`
+}
+
+func (data *githubPushEvent) event() *WebHookEvent {
+	return &WebHookEvent{
+		EventType:  EVENT_TYPE_PUSH,
+		Ref:        data.Ref,
+		Before:     data.Before,
+		After:      data.After,
+		UserName:   data.Pusher.name(),
+		Repository: data.Repository.repository(),
+		HeadCommit: data.HeadCommit.commit(),
+	}
+}
+
+// https://docs.github.com/en/webhooks/webhook-events-and-payloads#pull_request
+// Gitee的合并请求事件与GitHub格式相同
+type githubPullRequestEvent struct {
+	Action      string           `json:"action"`
+	Sender      githubUser       `json:"sender"`
+	Repository  githubRepository `json:"repository"`
+	PullRequest struct {
+		Number  int64  `json:"number"`
+		Title   string `json:"title"`
+		State   string `json:"state"`
+		Merged  bool   `json:"merged"`
+		HTMLURL string `json:"html_url"`
+		Head    struct {
+			Ref string `json:"ref"`
+			SHA string `json:"sha"`
+		} `json:"head"`
+		Base struct {
+			Ref string `json:"ref"`
+		} `json:"base"`
+	} `json:"pull_request"`
+}
+
+func (data *githubPullRequestEvent) event() *WebHookEvent {
+	pr := data.PullRequest
+	return &WebHookEvent{
+		EventType:  EVENT_TYPE_MERGE_REQUEST,
+		Ref:        BRANCH_REF_PREFIX + pr.Base.Ref,
+		Branch:     pr.Base.Ref,
+		After:      pr.Head.SHA,
+		UserName:   data.Sender.name(),
+		Repository: data.Repository.repository(),
+		HeadCommit: &Commit{Id: pr.Head.SHA, Message: pr.Title, AuthorName: data.Sender.name()},
+		MergeRequest: &MergeRequest{
+			Number:       pr.Number,
+			Title:        pr.Title,
+			Action:       data.Action,
+			State:        pr.State,
+			SourceBranch: pr.Head.Ref,
+			TargetBranch: pr.Base.Ref,
+			Url:          pr.HTMLURL,
+		},
+	}
+}
+
+func parseGithubEvent(event string, body []byte) (*WebHookEvent, error) {
+	switch event {
+	case GITHUB_PUSH_EVENT:
+		data := &githubPushEvent{}
+		if err := json.Unmarshal(body, data); err != nil {
+			return nil, fmt.Errorf("unmarshal github push event error, %s", err)
+		}
+		return data.event(), nil
+	case GITHUB_PULL_REQUEST_EVENT:
+		data := &githubPullRequestEvent{}
+		if err := json.Unmarshal(body, data); err != nil {
+			return nil, fmt.Errorf("unmarshal github pull request event error, %s", err)
+		}
+		// 关闭时只有合并才触发
+		if data.Action == "closed" && data.PullRequest.Merged {
+			data.Action = "merge"
+		}
+		if !isMergeRequestAction(data.Action) {
+			return nil, ErrEventIgnored
+		}
+		return data.event(), nil
+	default:
+		// 比如ping事件
+		return nil, ErrEventIgnored
+	}
+}
+
+// 只有创建, 更新, 合并合并请求时才触发
+func isMergeRequestAction(action string) bool {
+	switch action {
+	case "open", "opened", "reopen", "reopened", "update", "synchronize", "merge":
+		return true
+	}
+	return false
+}
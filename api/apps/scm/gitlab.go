@@ -0,0 +1,138 @@
+package scm
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	GITLAB_PUSH_HOOK          = "Push Hook"
+	GITLAB_TAG_PUSH_HOOK      = "Tag Push Hook"
+	GITLAB_MERGE_REQUEST_HOOK = "Merge Request Hook"
+)
+
+type gitlabCommit struct {
+	Id        string `json:"id"`
+	Message   string `json:"message"`
+	Timestamp string `json:"timestamp"`
+	URL       string `json:"url"`
+	Author    struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	} `json:"author"`
+}
+
+func (c *gitlabCommit) commit() *Commit {
+	return &Commit{
+		Id:          c.Id,
+		Message:     c.Message,
+		Timestamp:   c.Timestamp,
+		Url:         c.URL,
+		AuthorName:  c.Author.Name,
+		AuthorEmail: c.Author.Email,
+	}
+}
+
+type gitlabProject struct {
+	Name              string `json:"name"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	WebURL            string `json:"web_url"`
+	GitHTTPURL        string `json:"git_http_url"`
+	GitSSHURL         string `json:"git_ssh_url"`
+}
+
+func (p *gitlabProject) repository() *Repository {
+	return &Repository{
+		Name:       p.Name,
+		FullName:   p.PathWithNamespace,
+		WebUrl:     p.WebURL,
+		GitHttpUrl: p.GitHTTPURL,
+		GitSshUrl:  p.GitSSHURL,
+	}
+}
+
+// https://docs.gitlab.com/ee/user/project/integrations/webhook_events.html#push-events
+type gitlabPushEvent struct {
+	Ref         string          `json:"ref"`
+	Before      string          `json:"before"`
+	After       string          `json:"after"`
+	CheckoutSHA string          `json:"checkout_sha"`
+	UserName    string          `json:"user_name"`
+	Project     gitlabProject   `json:"project"`
+	Commits     []*gitlabCommit `json:"commits"`
+}
+
+// https://docs.gitlab.com/ee/user/project/integrations/webhook_events.html#merge-request-events
+type gitlabMergeRequestEvent struct {
+	User struct {
+		Name string `json:"name"`
+	} `json:"user"`
+	Project          gitlabProject `json:"project"`
+	ObjectAttributes struct {
+		IId          int64        `json:"iid"`
+		Title        string       `json:"title"`
+		State        string       `json:"state"`
+		Action       string       `json:"action"`
+		SourceBranch string       `json:"source_branch"`
+		TargetBranch string       `json:"target_branch"`
+		URL          string       `json:"url"`
+		LastCommit   gitlabCommit `json:"last_commit"`
+	} `json:"object_attributes"`
+}
+
+func parseGitlabEvent(event string, body []byte) (*WebHookEvent, error) {
+	switch event {
+	case GITLAB_PUSH_HOOK, GITLAB_TAG_PUSH_HOOK:
+		data := &gitlabPushEvent{}
+		if err := json.Unmarshal(body, data); err != nil {
+			return nil, fmt.Errorf("unmarshal gitlab push event error, %s", err)
+		}
+
+		e := &WebHookEvent{
+			EventType:  EVENT_TYPE_PUSH,
+			Ref:        data.Ref,
+			Before:     data.Before,
+			After:      data.After,
+			UserName:   data.UserName,
+			Repository: data.Project.repository(),
+		}
+		// commits按照时间排序, 最后一个为最新的
+		for i := range data.Commits {
+			if data.Commits[i].Id == data.CheckoutSHA || i == len(data.Commits)-1 {
+				e.HeadCommit = data.Commits[i].commit()
+				break
+			}
+		}
+		return e, nil
+	case GITLAB_MERGE_REQUEST_HOOK:
+		data := &gitlabMergeRequestEvent{}
+		if err := json.Unmarshal(body, data); err != nil {
+			return nil, fmt.Errorf("unmarshal gitlab merge request event error, %s", err)
+		}
+
+		attr := data.ObjectAttributes
+		if !isMergeRequestAction(attr.Action) {
+			return nil, ErrEventIgnored
+		}
+		return &WebHookEvent{
+			EventType:  EVENT_TYPE_MERGE_REQUEST,
+			Ref:        BRANCH_REF_PREFIX + attr.TargetBranch,
+			Branch:     attr.TargetBranch,
+			After:      attr.LastCommit.Id,
+			UserName:   data.User.Name,
+			Repository: data.Project.repository(),
+			HeadCommit: attr.LastCommit.commit(),
+			MergeRequest: &MergeRequest{
+				Number:       attr.IId,
+				Title:        attr.Title,
+				Action:       attr.Action,
+				State:        attr.State,
+				SourceBranch: attr.SourceBranch,
+				TargetBranch: attr.TargetBranch,
+				Url:          attr.URL,
+			},
+		}, nil
+	default:
+		return nil, ErrEventIgnored
+	}
+}
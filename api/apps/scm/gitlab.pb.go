@@ -0,0 +1,770 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.1
+// source: api/apps/scm/pb/gitlab.proto
+
+package scm
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PROVIDER 代码仓库提供方
+type PROVIDER int32
+
+const (
+	// GitLab
+	PROVIDER_GITLAB PROVIDER = 0
+	// GitHub
+	PROVIDER_GITHUB PROVIDER = 1
+	// Gitee
+	PROVIDER_GITEE PROVIDER = 2
+)
+
+// Enum value maps for PROVIDER.
+var (
+	PROVIDER_name = map[int32]string{
+		0: "GITLAB",
+		1: "GITHUB",
+		2: "GITEE",
+	}
+	PROVIDER_value = map[string]int32{
+		"GITLAB": 0,
+		"GITHUB": 1,
+		"GITEE":  2,
+	}
+)
+
+func (x PROVIDER) Enum() *PROVIDER {
+	p := new(PROVIDER)
+	*p = x
+	return p
+}
+
+func (x PROVIDER) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PROVIDER) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_apps_scm_pb_gitlab_proto_enumTypes[0].Descriptor()
+}
+
+func (PROVIDER) Type() protoreflect.EnumType {
+	return &file_api_apps_scm_pb_gitlab_proto_enumTypes[0]
+}
+
+func (x PROVIDER) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PROVIDER.Descriptor instead.
+func (PROVIDER) EnumDescriptor() ([]byte, []int) {
+	return file_api_apps_scm_pb_gitlab_proto_rawDescGZIP(), []int{0}
+}
+
+// EVENT_TYPE 归一化后的事件类型
+type EVENT_TYPE int32
+
+const (
+	// 分支推送
+	EVENT_TYPE_PUSH EVENT_TYPE = 0
+	// 标签推送
+	EVENT_TYPE_TAG EVENT_TYPE = 1
+	// 合并请求
+	EVENT_TYPE_MERGE_REQUEST EVENT_TYPE = 2
+)
+
+// Enum value maps for EVENT_TYPE.
+var (
+	EVENT_TYPE_name = map[int32]string{
+		0: "PUSH",
+		1: "TAG",
+		2: "MERGE_REQUEST",
+	}
+	EVENT_TYPE_value = map[string]int32{
+		"PUSH":          0,
+		"TAG":           1,
+		"MERGE_REQUEST": 2,
+	}
+)
+
+func (x EVENT_TYPE) Enum() *EVENT_TYPE {
+	p := new(EVENT_TYPE)
+	*p = x
+	return p
+}
+
+func (x EVENT_TYPE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EVENT_TYPE) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_apps_scm_pb_gitlab_proto_enumTypes[1].Descriptor()
+}
+
+func (EVENT_TYPE) Type() protoreflect.EnumType {
+	return &file_api_apps_scm_pb_gitlab_proto_enumTypes[1]
+}
+
+func (x EVENT_TYPE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EVENT_TYPE.Descriptor instead.
+func (EVENT_TYPE) EnumDescriptor() ([]byte, []int) {
+	return file_api_apps_scm_pb_gitlab_proto_rawDescGZIP(), []int{1}
+}
+
+// WebHookEvent 代码仓库推送过来的事件, 不同提供方的格式归一化后保存
+type WebHookEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 事件来源
+	// @gotags: bson:"provider" json:"provider"
+	Provider PROVIDER `protobuf:"varint,1,opt,name=provider,proto3,enum=infraboard.workflow.scm.PROVIDER" json:"provider" bson:"provider"`
+	// 事件类型
+	// @gotags: bson:"event_type" json:"event_type"
+	EventType EVENT_TYPE `protobuf:"varint,2,opt,name=event_type,json=eventType,proto3,enum=infraboard.workflow.scm.EVENT_TYPE" json:"event_type" bson:"event_type"`
+	// 完整的ref, 比如refs/heads/master
+	// @gotags: bson:"ref" json:"ref"
+	Ref string `protobuf:"bytes,3,opt,name=ref,proto3" json:"ref" bson:"ref"`
+	// 分支名称, 合并请求时为目标分支
+	// @gotags: bson:"branch" json:"branch"
+	Branch string `protobuf:"bytes,4,opt,name=branch,proto3" json:"branch" bson:"branch"`
+	// 标签名称
+	// @gotags: bson:"tag" json:"tag"
+	Tag string `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag" bson:"tag"`
+	// 推送前的commit
+	// @gotags: bson:"before" json:"before"
+	Before string `protobuf:"bytes,6,opt,name=before,proto3" json:"before" bson:"before"`
+	// 推送后的commit
+	// @gotags: bson:"after" json:"after"
+	After string `protobuf:"bytes,7,opt,name=after,proto3" json:"after" bson:"after"`
+	// 代码仓库
+	// @gotags: bson:"repository" json:"repository"
+	Repository *Repository `protobuf:"bytes,8,opt,name=repository,proto3" json:"repository" bson:"repository"`
+	// 最新的commit
+	// @gotags: bson:"head_commit" json:"head_commit"
+	HeadCommit *Commit `protobuf:"bytes,9,opt,name=head_commit,json=headCommit,proto3" json:"head_commit" bson:"head_commit"`
+	// 触发事件的用户
+	// @gotags: bson:"user_name" json:"user_name"
+	UserName string `protobuf:"bytes,10,opt,name=user_name,json=userName,proto3" json:"user_name" bson:"user_name"`
+	// 合并请求信息
+	// @gotags: bson:"merge_request" json:"merge_request,omitempty"
+	MergeRequest *MergeRequest `protobuf:"bytes,11,opt,name=merge_request,json=mergeRequest,proto3" json:"merge_request,omitempty" bson:"merge_request"`
+}
+
+func (x *WebHookEvent) Reset() {
+	*x = WebHookEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_apps_scm_pb_gitlab_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebHookEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebHookEvent) ProtoMessage() {}
+
+func (x *WebHookEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_apps_scm_pb_gitlab_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebHookEvent.ProtoReflect.Descriptor instead.
+func (*WebHookEvent) Descriptor() ([]byte, []int) {
+	return file_api_apps_scm_pb_gitlab_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WebHookEvent) GetProvider() PROVIDER {
+	if x != nil {
+		return x.Provider
+	}
+	return PROVIDER_GITLAB
+}
+
+func (x *WebHookEvent) GetEventType() EVENT_TYPE {
+	if x != nil {
+		return x.EventType
+	}
+	return EVENT_TYPE_PUSH
+}
+
+func (x *WebHookEvent) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *WebHookEvent) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *WebHookEvent) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *WebHookEvent) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *WebHookEvent) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *WebHookEvent) GetRepository() *Repository {
+	if x != nil {
+		return x.Repository
+	}
+	return nil
+}
+
+func (x *WebHookEvent) GetHeadCommit() *Commit {
+	if x != nil {
+		return x.HeadCommit
+	}
+	return nil
+}
+
+func (x *WebHookEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *WebHookEvent) GetMergeRequest() *MergeRequest {
+	if x != nil {
+		return x.MergeRequest
+	}
+	return nil
+}
+
+// Repository 代码仓库信息
+type Repository struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 仓库名称
+	// @gotags: bson:"name" json:"name"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name" bson:"name"`
+	// 包含命名空间的名称, 比如infraboard/workflow
+	// @gotags: bson:"full_name" json:"full_name"
+	FullName string `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name" bson:"full_name"`
+	// 仓库主页
+	// @gotags: bson:"web_url" json:"web_url"
+	WebUrl string `protobuf:"bytes,3,opt,name=web_url,json=webUrl,proto3" json:"web_url" bson:"web_url"`
+	// http clone地址
+	// @gotags: bson:"git_http_url" json:"git_http_url"
+	GitHttpUrl string `protobuf:"bytes,4,opt,name=git_http_url,json=gitHttpUrl,proto3" json:"git_http_url" bson:"git_http_url"`
+	// ssh clone地址
+	// @gotags: bson:"git_ssh_url" json:"git_ssh_url"
+	GitSshUrl string `protobuf:"bytes,5,opt,name=git_ssh_url,json=gitSshUrl,proto3" json:"git_ssh_url" bson:"git_ssh_url"`
+}
+
+func (x *Repository) Reset() {
+	*x = Repository{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_apps_scm_pb_gitlab_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Repository) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Repository) ProtoMessage() {}
+
+func (x *Repository) ProtoReflect() protoreflect.Message {
+	mi := &file_api_apps_scm_pb_gitlab_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Repository.ProtoReflect.Descriptor instead.
+func (*Repository) Descriptor() ([]byte, []int) {
+	return file_api_apps_scm_pb_gitlab_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Repository) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Repository) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Repository) GetWebUrl() string {
+	if x != nil {
+		return x.WebUrl
+	}
+	return ""
+}
+
+func (x *Repository) GetGitHttpUrl() string {
+	if x != nil {
+		return x.GitHttpUrl
+	}
+	return ""
+}
+
+func (x *Repository) GetGitSshUrl() string {
+	if x != nil {
+		return x.GitSshUrl
+	}
+	return ""
+}
+
+// Commit 提交信息
+type Commit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// commit sha
+	// @gotags: bson:"id" json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" bson:"id"`
+	// 提交信息
+	// @gotags: bson:"message" json:"message"
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message" bson:"message"`
+	// 提交时间
+	// @gotags: bson:"timestamp" json:"timestamp"
+	Timestamp string `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp" bson:"timestamp"`
+	// 提交详情地址
+	// @gotags: bson:"url" json:"url"
+	Url string `protobuf:"bytes,4,opt,name=url,proto3" json:"url" bson:"url"`
+	// 作者名称
+	// @gotags: bson:"author_name" json:"author_name"
+	AuthorName string `protobuf:"bytes,5,opt,name=author_name,json=authorName,proto3" json:"author_name" bson:"author_name"`
+	// 作者邮箱
+	// @gotags: bson:"author_email" json:"author_email"
+	AuthorEmail string `protobuf:"bytes,6,opt,name=author_email,json=authorEmail,proto3" json:"author_email" bson:"author_email"`
+}
+
+func (x *Commit) Reset() {
+	*x = Commit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_apps_scm_pb_gitlab_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Commit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Commit) ProtoMessage() {}
+
+func (x *Commit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_apps_scm_pb_gitlab_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Commit.ProtoReflect.Descriptor instead.
+func (*Commit) Descriptor() ([]byte, []int) {
+	return file_api_apps_scm_pb_gitlab_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Commit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Commit) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Commit) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *Commit) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Commit) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *Commit) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
+	}
+	return ""
+}
+
+// MergeRequest 合并请求信息
+type MergeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 编号
+	// @gotags: bson:"number" json:"number"
+	Number int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number" bson:"number"`
+	// 标题
+	// @gotags: bson:"title" json:"title"
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title" bson:"title"`
+	// 动作, 比如open, update, merge
+	// @gotags: bson:"action" json:"action"
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action" bson:"action"`
+	// 状态
+	// @gotags: bson:"state" json:"state"
+	State string `protobuf:"bytes,4,opt,name=state,proto3" json:"state" bson:"state"`
+	// 源分支
+	// @gotags: bson:"source_branch" json:"source_branch"
+	SourceBranch string `protobuf:"bytes,5,opt,name=source_branch,json=sourceBranch,proto3" json:"source_branch" bson:"source_branch"`
+	// 目标分支
+	// @gotags: bson:"target_branch" json:"target_branch"
+	TargetBranch string `protobuf:"bytes,6,opt,name=target_branch,json=targetBranch,proto3" json:"target_branch" bson:"target_branch"`
+	// 详情地址
+	// @gotags: bson:"url" json:"url"
+	Url string `protobuf:"bytes,7,opt,name=url,proto3" json:"url" bson:"url"`
+}
+
+func (x *MergeRequest) Reset() {
+	*x = MergeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_apps_scm_pb_gitlab_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeRequest) ProtoMessage() {}
+
+func (x *MergeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_apps_scm_pb_gitlab_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeRequest.ProtoReflect.Descriptor instead.
+func (*MergeRequest) Descriptor() ([]byte, []int) {
+	return file_api_apps_scm_pb_gitlab_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MergeRequest) GetNumber() int64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *MergeRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MergeRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *MergeRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *MergeRequest) GetSourceBranch() string {
+	if x != nil {
+		return x.SourceBranch
+	}
+	return ""
+}
+
+func (x *MergeRequest) GetTargetBranch() string {
+	if x != nil {
+		return x.TargetBranch
+	}
+	return ""
+}
+
+func (x *MergeRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+var File_api_apps_scm_pb_gitlab_proto protoreflect.FileDescriptor
+
+var file_api_apps_scm_pb_gitlab_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x2f, 0x73, 0x63, 0x6d, 0x2f, 0x70,
+	0x62, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17,
+	0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x6d, 0x22, 0xeb, 0x03, 0x0a, 0x0c, 0x57, 0x65, 0x62, 0x48,
+	0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x69, 0x6e, 0x66,
+	0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x2e, 0x73, 0x63, 0x6d, 0x2e, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x2e, 0x73, 0x63, 0x6d, 0x2e, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72,
+	0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6e, 0x66, 0x72,
+	0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2e,
+	0x73, 0x63, 0x6d, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x0a,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x40, 0x0a, 0x0b, 0x68, 0x65,
+	0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x6d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x77, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x73, 0x63, 0x6d, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x65, 0x62, 0x55, 0x72, 0x6c, 0x12, 0x20,
+	0x0a, 0x0c, 0x67, 0x69, 0x74, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x69, 0x74, 0x48, 0x74, 0x74, 0x70, 0x55, 0x72, 0x6c,
+	0x12, 0x1e, 0x0a, 0x0b, 0x67, 0x69, 0x74, 0x5f, 0x73, 0x73, 0x68, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x69, 0x74, 0x53, 0x73, 0x68, 0x55, 0x72, 0x6c,
+	0x22, 0xa6, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xc6, 0x01, 0x0a, 0x0c, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x2a, 0x2d, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x12, 0x0a,
+	0x0a, 0x06, 0x47, 0x49, 0x54, 0x4c, 0x41, 0x42, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x49,
+	0x54, 0x48, 0x55, 0x42, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x49, 0x54, 0x45, 0x45, 0x10,
+	0x02, 0x2a, 0x32, 0x0a, 0x0a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12,
+	0x08, 0x0a, 0x04, 0x50, 0x55, 0x53, 0x48, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x41, 0x47,
+	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x10, 0x02, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2f, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x73,
+	0x2f, 0x73, 0x63, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_apps_scm_pb_gitlab_proto_rawDescOnce sync.Once
+	file_api_apps_scm_pb_gitlab_proto_rawDescData = file_api_apps_scm_pb_gitlab_proto_rawDesc
+)
+
+func file_api_apps_scm_pb_gitlab_proto_rawDescGZIP() []byte {
+	file_api_apps_scm_pb_gitlab_proto_rawDescOnce.Do(func() {
+		file_api_apps_scm_pb_gitlab_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_apps_scm_pb_gitlab_proto_rawDescData)
+	})
+	return file_api_apps_scm_pb_gitlab_proto_rawDescData
+}
+
+var file_api_apps_scm_pb_gitlab_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_apps_scm_pb_gitlab_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_api_apps_scm_pb_gitlab_proto_goTypes = []interface{}{
+	(PROVIDER)(0),        // 0: infraboard.workflow.scm.PROVIDER
+	(EVENT_TYPE)(0),      // 1: infraboard.workflow.scm.EVENT_TYPE
+	(*WebHookEvent)(nil), // 2: infraboard.workflow.scm.WebHookEvent
+	(*Repository)(nil),   // 3: infraboard.workflow.scm.Repository
+	(*Commit)(nil),       // 4: infraboard.workflow.scm.Commit
+	(*MergeRequest)(nil), // 5: infraboard.workflow.scm.MergeRequest
+}
+var file_api_apps_scm_pb_gitlab_proto_depIdxs = []int32{
+	0, // 0: infraboard.workflow.scm.WebHookEvent.provider:type_name -> infraboard.workflow.scm.PROVIDER
+	1, // 1: infraboard.workflow.scm.WebHookEvent.event_type:type_name -> infraboard.workflow.scm.EVENT_TYPE
+	3, // 2: infraboard.workflow.scm.WebHookEvent.repository:type_name -> infraboard.workflow.scm.Repository
+	4, // 3: infraboard.workflow.scm.WebHookEvent.head_commit:type_name -> infraboard.workflow.scm.Commit
+	5, // 4: infraboard.workflow.scm.WebHookEvent.merge_request:type_name -> infraboard.workflow.scm.MergeRequest
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_api_apps_scm_pb_gitlab_proto_init() }
+func file_api_apps_scm_pb_gitlab_proto_init() {
+	if File_api_apps_scm_pb_gitlab_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_apps_scm_pb_gitlab_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebHookEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_apps_scm_pb_gitlab_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Repository); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_apps_scm_pb_gitlab_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Commit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_apps_scm_pb_gitlab_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_apps_scm_pb_gitlab_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_apps_scm_pb_gitlab_proto_goTypes,
+		DependencyIndexes: file_api_apps_scm_pb_gitlab_proto_depIdxs,
+		EnumInfos:         file_api_apps_scm_pb_gitlab_proto_enumTypes,
+		MessageInfos:      file_api_apps_scm_pb_gitlab_proto_msgTypes,
+	}.Build()
+	File_api_apps_scm_pb_gitlab_proto = out.File
+	file_api_apps_scm_pb_gitlab_proto_rawDesc = nil
+	file_api_apps_scm_pb_gitlab_proto_goTypes = nil
+	file_api_apps_scm_pb_gitlab_proto_depIdxs = nil
+}
@@ -0,0 +1,100 @@
+// Code generated by github.com/infraboard/mcube
+// DO NOT EDIT
+
+package scm
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ParsePROVIDERFromString Parse PROVIDER from string
+func ParsePROVIDERFromString(str string) (PROVIDER, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := PROVIDER_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown PROVIDER: %s", str)
+	}
+
+	return PROVIDER(v), nil
+}
+
+// Equal type compare
+func (t PROVIDER) Equal(target PROVIDER) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t PROVIDER) IsIn(targets ...PROVIDER) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t PROVIDER) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *PROVIDER) UnmarshalJSON(b []byte) error {
+	ins, err := ParsePROVIDERFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
+
+// ParseEVENT_TYPEFromString Parse EVENT_TYPE from string
+func ParseEVENT_TYPEFromString(str string) (EVENT_TYPE, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := EVENT_TYPE_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown EVENT_TYPE: %s", str)
+	}
+
+	return EVENT_TYPE(v), nil
+}
+
+// Equal type compare
+func (t EVENT_TYPE) Equal(target EVENT_TYPE) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t EVENT_TYPE) IsIn(targets ...EVENT_TYPE) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t EVENT_TYPE) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *EVENT_TYPE) UnmarshalJSON(b []byte) error {
+	ins, err := ParseEVENT_TYPEFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
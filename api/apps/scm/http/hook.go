@@ -0,0 +1,101 @@
+package http
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/infraboard/mcube/exception"
+	"github.com/infraboard/mcube/http/response"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/api/apps/scm"
+	"github.com/infraboard/workflow/api/apps/template"
+)
+
+const (
+	// webhook请求体最大大小
+	MAX_HOOK_BODY_SIZE = 5 << 20
+)
+
+func (h *handler) GitlabHook(w http.ResponseWriter, r *http.Request) {
+	h.handleHook(w, r, scm.PROVIDER_GITLAB)
+}
+
+func (h *handler) GithubHook(w http.ResponseWriter, r *http.Request) {
+	h.handleHook(w, r, scm.PROVIDER_GITHUB)
+}
+
+func (h *handler) GiteeHook(w http.ResponseWriter, r *http.Request) {
+	h.handleHook(w, r, scm.PROVIDER_GITEE)
+}
+
+// 通过query参数指定模版: /hooks/gitlab?template_id=xxx
+// 使用模版的WebHook Token校验请求, 只匹配该模版中的pipeline, pipeline创建在模版所属的空间
+func (h *handler) handleHook(w http.ResponseWriter, r *http.Request, p scm.PROVIDER) {
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, MAX_HOOK_BODY_SIZE))
+	if err != nil {
+		response.Failed(w, exception.NewBadRequest("read body error, %s", err))
+		return
+	}
+	defer r.Body.Close()
+
+	templateId := r.URL.Query().Get("template_id")
+	if templateId == "" {
+		response.Failed(w, exception.NewBadRequest("template_id required"))
+		return
+	}
+	t, err := h.template.DescribeTemplate(r.Context(), template.NewDescribeTemplateRequestWithID(templateId))
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	if err := scm.VerifyWebHook(p, r.Header, body, t.WebhookToken); err != nil {
+		response.Failed(w, exception.NewPermissionDeny(err.Error()))
+		return
+	}
+
+	e, err := scm.ParseWebHook(p, r.Header, body)
+	if err == scm.ErrEventIgnored {
+		h.log.Debugf("%s webhook event ignored", p)
+		response.Success(w, pipeline.NewPipelineSet())
+		return
+	}
+	if err != nil {
+		response.Failed(w, exception.NewBadRequest(err.Error()))
+		return
+	}
+	h.log.Infof("template %s receive webhook event: %s", t.Id, e.ShortDescribe())
+
+	set, err := h.trigger(r.Context(), t, e)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	response.Success(w, set)
+}
+
+// 创建触发条件匹配的pipeline, 单个pipeline创建失败不影响其他pipeline
+func (h *handler) trigger(ctx context.Context, t *template.Template, e *scm.WebHookEvent) (
+	*pipeline.PipelineSet, error) {
+	reqs, err := t.HookPipelines(e)
+	if err != nil {
+		return nil, exception.NewInternalServerError(err.Error())
+	}
+
+	set := pipeline.NewPipelineSet()
+	for i := range reqs {
+		ins, err := h.pipeline.CreatePipeline(ctx, reqs[i])
+		if err != nil {
+			h.log.Errorf("template %s create pipeline %s error, %s", t.Name, reqs[i].Name, err)
+			continue
+		}
+		h.log.Infof("template %s create pipeline %s by %s", t.Name, ins.Id, e.ShortDescribe())
+		set.Add(ins)
+	}
+	set.Total = int64(len(set.Items))
+	return set, nil
+}
@@ -0,0 +1,50 @@
+package http
+
+import (
+	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/http/label"
+	"github.com/infraboard/mcube/http/router"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/api/apps/scm"
+	"github.com/infraboard/workflow/api/apps/template"
+)
+
+var (
+	api = &handler{log: zap.L().Named("SCM")}
+)
+
+type handler struct {
+	pipeline pipeline.ServiceServer
+	template template.ServiceServer
+	log      logger.Logger
+}
+
+// Registry 注册HTTP服务路由
+// 代码仓库回调时不会携带用户token, 通过模版的webhook token校验请求
+func (h *handler) Registry(router router.SubRouter) {
+	r := router.ResourceRouter("hooks")
+	r.Auth(false)
+	r.Permission(false)
+	r.RequiredNamespace(false)
+	r.BasePath("hooks")
+	r.Handle("POST", "/gitlab", h.GitlabHook).AddLabel(label.Create)
+	r.Handle("POST", "/github", h.GithubHook).AddLabel(label.Create)
+	r.Handle("POST", "/gitee", h.GiteeHook).AddLabel(label.Create)
+}
+
+func (h *handler) Config() error {
+	h.pipeline = app.GetGrpcApp(pipeline.AppName).(pipeline.ServiceServer)
+	h.template = app.GetGrpcApp(template.AppName).(template.ServiceServer)
+	return nil
+}
+
+func (h *handler) Name() string {
+	return scm.AppName
+}
+
+func init() {
+	app.RegistryHttpApp(api)
+}
@@ -0,0 +1,226 @@
+package scm
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	GITLAB_EVENT_HEADER = "X-Gitlab-Event"
+	GITLAB_TOKEN_HEADER = "X-Gitlab-Token"
+
+	GITHUB_EVENT_HEADER     = "X-GitHub-Event"
+	GITHUB_SIGNATURE_HEADER = "X-Hub-Signature-256"
+
+	GITEE_EVENT_HEADER     = "X-Gitee-Event"
+	GITEE_TOKEN_HEADER     = "X-Gitee-Token"
+	GITEE_TIMESTAMP_HEADER = "X-Gitee-Timestamp"
+)
+
+const (
+	// 注入到pipeline with中的变量
+	GIT_EVENT_KEY          = "GIT_EVENT"
+	GIT_PROVIDER_KEY       = "GIT_PROVIDER"
+	GIT_REF_KEY            = "GIT_REF"
+	GIT_BRANCH_KEY         = "GIT_BRANCH"
+	GIT_TAG_KEY            = "GIT_TAG"
+	GIT_COMMIT_SHA_KEY     = "GIT_COMMIT_SHA"
+	GIT_COMMIT_MESSAGE_KEY = "GIT_COMMIT_MESSAGE"
+	GIT_AUTHOR_KEY         = "GIT_AUTHOR"
+	GIT_AUTHOR_EMAIL_KEY   = "GIT_AUTHOR_EMAIL"
+	GIT_REPOSITORY_KEY     = "GIT_REPOSITORY"
+	GIT_REPOSITORY_URL_KEY = "GIT_REPOSITORY_URL"
+	GIT_SOURCE_BRANCH_KEY  = "GIT_SOURCE_BRANCH"
+)
+
+const (
+	BRANCH_REF_PREFIX = "refs/heads/"
+	TAG_REF_PREFIX    = "refs/tags/"
+	// 分支或者标签被删除时, after为全0
+	EMPTY_COMMIT_SHA = "0000000000000000000000000000000000000000"
+	// Gitee签名中的时间戳和当前时间允许的最大偏差, 超过后认为是重放的请求
+	GITEE_TIMESTAMP_MAX_SKEW = 5 * time.Minute
+)
+
+var (
+	// ErrEventIgnored 不需要触发pipeline的事件, 比如ping, 删除分支
+	ErrEventIgnored = errors.New("event ignored")
+)
+
+// VerifyWebHook 校验请求是否携带了正确的token, GitLab比较X-Gitlab-Token,
+// GitHub校验X-Hub-Signature-256签名, Gitee的X-Gitee-Token可以是token或者签名
+func VerifyWebHook(p PROVIDER, header http.Header, body []byte, token string) error {
+	if token == "" {
+		return fmt.Errorf("webhook token not config")
+	}
+
+	switch p {
+	case PROVIDER_GITLAB:
+		if !equalString(header.Get(GITLAB_TOKEN_HEADER), token) {
+			return fmt.Errorf("gitlab token not match")
+		}
+	case PROVIDER_GITHUB:
+		sign := strings.TrimPrefix(header.Get(GITHUB_SIGNATURE_HEADER), "sha256=")
+		if !equalString(sign, GithubSignature(body, token)) {
+			return fmt.Errorf("github signature not match")
+		}
+	case PROVIDER_GITEE:
+		value := header.Get(GITEE_TOKEN_HEADER)
+		if equalString(value, token) {
+			return nil
+		}
+		ts := header.Get(GITEE_TIMESTAMP_HEADER)
+		if ts == "" || !equalString(value, GiteeSignature(ts, token)) {
+			return fmt.Errorf("gitee token not match")
+		}
+		if err := checkGiteeTimestamp(ts, time.Now()); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown provider %s", p)
+	}
+	return nil
+}
+
+// ParseWebHook 把不同提供方的事件归一化为WebHookEvent
+func ParseWebHook(p PROVIDER, header http.Header, body []byte) (*WebHookEvent, error) {
+	var (
+		e   *WebHookEvent
+		err error
+	)
+
+	switch p {
+	case PROVIDER_GITLAB:
+		e, err = parseGitlabEvent(header.Get(GITLAB_EVENT_HEADER), body)
+	case PROVIDER_GITHUB:
+		e, err = parseGithubEvent(header.Get(GITHUB_EVENT_HEADER), body)
+	case PROVIDER_GITEE:
+		e, err = parseGiteeEvent(header.Get(GITEE_EVENT_HEADER), body)
+	default:
+		return nil, fmt.Errorf("unknown provider %s", p)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	e.Provider = p
+	e.fillRef()
+	if e.IsDeleted() {
+		return nil, ErrEventIgnored
+	}
+	return e, nil
+}
+
+// GithubSignature GitHub使用token对body做HMAC-SHA256签名
+func GithubSignature(body []byte, token string) string {
+	mac := hmac.New(sha256.New, []byte(token))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// GiteeSignature Gitee使用token对 timestamp\ntoken 做HMAC-SHA256签名
+func GiteeSignature(timestamp, token string) string {
+	mac := hmac.New(sha256.New, []byte(token))
+	mac.Write([]byte(timestamp + "\n" + token))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Gitee的时间戳为毫秒
+func checkGiteeTimestamp(ts string, now time.Time) error {
+	ms, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid gitee timestamp %s", ts)
+	}
+	skew := now.Sub(time.UnixMilli(ms))
+	if skew > GITEE_TIMESTAMP_MAX_SKEW || skew < -GITEE_TIMESTAMP_MAX_SKEW {
+		return fmt.Errorf("gitee timestamp %s expired", ts)
+	}
+	return nil
+}
+
+func equalString(a, b string) bool {
+	return a != "" && subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+// 根据ref补充分支和标签, 推送标签时事件类型修正为TAG
+func (e *WebHookEvent) fillRef() {
+	switch {
+	case strings.HasPrefix(e.Ref, TAG_REF_PREFIX):
+		e.Tag = strings.TrimPrefix(e.Ref, TAG_REF_PREFIX)
+		e.EventType = EVENT_TYPE_TAG
+	case strings.HasPrefix(e.Ref, BRANCH_REF_PREFIX) && e.Branch == "":
+		e.Branch = strings.TrimPrefix(e.Ref, BRANCH_REF_PREFIX)
+	}
+}
+
+// IsDeleted 分支或者标签被删除
+func (e *WebHookEvent) IsDeleted() bool {
+	return e.EventType != EVENT_TYPE_MERGE_REQUEST && e.After == EMPTY_COMMIT_SHA
+}
+
+// EventName 用于匹配Trigger中的events, 比如push, tag, merge_request
+func (e *WebHookEvent) EventName() string {
+	return strings.ToLower(e.EventType.String())
+}
+
+// MatchRef 用于匹配Trigger中的branches, 推送标签时为标签名称
+func (e *WebHookEvent) MatchRef() string {
+	if e.EventType == EVENT_TYPE_TAG {
+		return e.Tag
+	}
+	return e.Branch
+}
+
+// CommitSHA 本次事件对应的commit
+func (e *WebHookEvent) CommitSHA() string {
+	if e.HeadCommit != nil && e.HeadCommit.Id != "" {
+		return e.HeadCommit.Id
+	}
+	return e.After
+}
+
+// Variables 注入到pipeline with中的变量
+func (e *WebHookEvent) Variables() map[string]string {
+	m := map[string]string{
+		GIT_EVENT_KEY:      e.EventName(),
+		GIT_PROVIDER_KEY:   strings.ToLower(e.Provider.String()),
+		GIT_REF_KEY:        e.Ref,
+		GIT_BRANCH_KEY:     e.Branch,
+		GIT_TAG_KEY:        e.Tag,
+		GIT_COMMIT_SHA_KEY: e.CommitSHA(),
+	}
+	if e.HeadCommit != nil {
+		m[GIT_COMMIT_MESSAGE_KEY] = strings.TrimSpace(e.HeadCommit.Message)
+		m[GIT_AUTHOR_KEY] = e.HeadCommit.AuthorName
+		m[GIT_AUTHOR_EMAIL_KEY] = e.HeadCommit.AuthorEmail
+	}
+	if m[GIT_AUTHOR_KEY] == "" {
+		m[GIT_AUTHOR_KEY] = e.UserName
+	}
+	if e.Repository != nil {
+		m[GIT_REPOSITORY_KEY] = e.Repository.FullName
+		m[GIT_REPOSITORY_URL_KEY] = e.Repository.GitHttpUrl
+	}
+	if e.MergeRequest != nil {
+		m[GIT_SOURCE_BRANCH_KEY] = e.MergeRequest.SourceBranch
+	}
+	return m
+}
+
+// ShortDescribe 用于日志
+func (e *WebHookEvent) ShortDescribe() string {
+	repo := ""
+	if e.Repository != nil {
+		repo = e.Repository.FullName
+	}
+	return fmt.Sprintf("%s %s %s@%s", e.Provider, e.EventName(), repo, e.MatchRef())
+}
@@ -0,0 +1,194 @@
+package scm_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/api/apps/scm"
+	"github.com/infraboard/workflow/api/apps/template"
+)
+
+const (
+	token = "workflow@123"
+)
+
+var gitlabPush = []byte(`{
+  "object_kind": "push",
+  "before": "f8a831144634f5810e17014582b5ba21267bb257",
+  "after": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7",
+  "ref": "refs/heads/main",
+  "checkout_sha": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7",
+  "user_name": "yumaojun03",
+  "project": {
+    "name": "sample-devcloud",
+    "web_url": "https://gitlab.com/yumaojun03/sample-devcloud",
+    "git_http_url": "https://gitlab.com/yumaojun03/sample-devcloud.git",
+    "path_with_namespace": "yumaojun03/sample-devcloud"
+  },
+  "commits": [
+    {
+      "id": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7",
+      "message": "fix build\n",
+      "author": {"name": "Jordi Mallach", "email": "jordi@softcatala.org"}
+    }
+  ]
+}`)
+
+var githubPullRequest = []byte(`{
+  "action": "synchronize",
+  "sender": {"login": "octocat"},
+  "repository": {"name": "workflow", "full_name": "infraboard/workflow", "clone_url": "https://github.com/infraboard/workflow.git"},
+  "pull_request": {
+    "number": 12,
+    "title": "add webhook",
+    "state": "open",
+    "head": {"ref": "feature/hook", "sha": "a908fde0e931cf5c0806d4338bdadeec9d4538a0"},
+    "base": {"ref": "master"}
+  }
+}`)
+
+func TestParseGitlabPush(t *testing.T) {
+	should := assert.New(t)
+
+	header := http.Header{}
+	header.Set(scm.GITLAB_EVENT_HEADER, scm.GITLAB_PUSH_HOOK)
+	header.Set(scm.GITLAB_TOKEN_HEADER, token)
+	should.NoError(scm.VerifyWebHook(scm.PROVIDER_GITLAB, header, gitlabPush, token))
+	should.Error(scm.VerifyWebHook(scm.PROVIDER_GITLAB, header, gitlabPush, "other"))
+	should.Error(scm.VerifyWebHook(scm.PROVIDER_GITLAB, header, gitlabPush, ""))
+
+	e, err := scm.ParseWebHook(scm.PROVIDER_GITLAB, header, gitlabPush)
+	if should.NoError(err) {
+		should.Equal("push", e.EventName())
+		should.Equal("main", e.MatchRef())
+		vars := e.Variables()
+		should.Equal("da1560886d4f094c3e6c9ef40349f7d38b5d27d7", vars[scm.GIT_COMMIT_SHA_KEY])
+		should.Equal("main", vars[scm.GIT_BRANCH_KEY])
+		should.Equal("Jordi Mallach", vars[scm.GIT_AUTHOR_KEY])
+		should.Equal("fix build", vars[scm.GIT_COMMIT_MESSAGE_KEY])
+		should.Equal("yumaojun03/sample-devcloud", vars[scm.GIT_REPOSITORY_KEY])
+	}
+
+	// 不支持的事件忽略
+	header.Set(scm.GITLAB_EVENT_HEADER, "Issue Hook")
+	_, err = scm.ParseWebHook(scm.PROVIDER_GITLAB, header, gitlabPush)
+	should.Equal(scm.ErrEventIgnored, err)
+}
+
+func TestParseGithubPullRequest(t *testing.T) {
+	should := assert.New(t)
+
+	header := http.Header{}
+	header.Set(scm.GITHUB_EVENT_HEADER, scm.GITHUB_PULL_REQUEST_EVENT)
+	header.Set(scm.GITHUB_SIGNATURE_HEADER, "sha256="+scm.GithubSignature(githubPullRequest, token))
+	should.NoError(scm.VerifyWebHook(scm.PROVIDER_GITHUB, header, githubPullRequest, token))
+	should.Error(scm.VerifyWebHook(scm.PROVIDER_GITHUB, header, append(githubPullRequest, ' '), token))
+
+	e, err := scm.ParseWebHook(scm.PROVIDER_GITHUB, header, githubPullRequest)
+	if should.NoError(err) {
+		should.Equal("merge_request", e.EventName())
+		should.Equal("master", e.MatchRef())
+		should.Equal("feature/hook", e.Variables()[scm.GIT_SOURCE_BRANCH_KEY])
+		should.Equal("a908fde0e931cf5c0806d4338bdadeec9d4538a0", e.CommitSHA())
+	}
+
+	header.Set(scm.GITHUB_EVENT_HEADER, "ping")
+	_, err = scm.ParseWebHook(scm.PROVIDER_GITHUB, header, githubPullRequest)
+	should.Equal(scm.ErrEventIgnored, err)
+}
+
+func TestParseGiteePush(t *testing.T) {
+	should := assert.New(t)
+
+	body, err := ioutil.ReadFile("../../../docs/sample/gitlab_hook.json")
+	if !should.NoError(err) {
+		return
+	}
+
+	header := http.Header{}
+	header.Set(scm.GITEE_EVENT_HEADER, scm.GITEE_PUSH_HOOK)
+	ts := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	header.Set(scm.GITEE_TIMESTAMP_HEADER, ts)
+	header.Set(scm.GITEE_TOKEN_HEADER, scm.GiteeSignature(ts, token))
+	should.NoError(scm.VerifyWebHook(scm.PROVIDER_GITEE, header, body, token))
+
+	// 过期的签名不能重放
+	expired := strconv.FormatInt(time.Now().Add(-scm.GITEE_TIMESTAMP_MAX_SKEW-time.Minute).UnixMilli(), 10)
+	header.Set(scm.GITEE_TIMESTAMP_HEADER, expired)
+	header.Set(scm.GITEE_TOKEN_HEADER, scm.GiteeSignature(expired, token))
+	should.Error(scm.VerifyWebHook(scm.PROVIDER_GITEE, header, body, token))
+
+	header.Set(scm.GITEE_TOKEN_HEADER, token)
+	should.NoError(scm.VerifyWebHook(scm.PROVIDER_GITEE, header, body, token))
+
+	e, err := scm.ParseWebHook(scm.PROVIDER_GITEE, header, body)
+	if should.NoError(err) {
+		should.Equal("dev", e.Branch)
+		should.Equal("a908fde0e931cf5c0806d4338bdadeec9d4538a0", e.CommitSHA())
+		should.Equal("Admin", e.Variables()[scm.GIT_AUTHOR_KEY])
+		should.Equal("https://gitee.com/infraboard/keyauth.git", e.Repository.GitHttpUrl)
+	}
+}
+
+func TestParseTagAndDelete(t *testing.T) {
+	should := assert.New(t)
+
+	header := http.Header{}
+	header.Set(scm.GITLAB_EVENT_HEADER, scm.GITLAB_TAG_PUSH_HOOK)
+	e, err := scm.ParseWebHook(scm.PROVIDER_GITLAB, header,
+		[]byte(`{"ref": "refs/tags/v1.0.0", "after": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7"}`))
+	if should.NoError(err) {
+		should.Equal("tag", e.EventName())
+		should.Equal("v1.0.0", e.MatchRef())
+	}
+
+	// 删除分支不触发
+	header.Set(scm.GITLAB_EVENT_HEADER, scm.GITLAB_PUSH_HOOK)
+	_, err = scm.ParseWebHook(scm.PROVIDER_GITLAB, header,
+		[]byte(`{"ref": "refs/heads/dev", "after": "`+scm.EMPTY_COMMIT_SHA+`"}`))
+	should.Equal(scm.ErrEventIgnored, err)
+}
+
+func TestTemplateHookPipelines(t *testing.T) {
+	should := assert.New(t)
+
+	header := http.Header{}
+	header.Set(scm.GITLAB_EVENT_HEADER, scm.GITLAB_PUSH_HOOK)
+	e, err := scm.ParseWebHook(scm.PROVIDER_GITLAB, header, gitlabPush)
+	if !should.NoError(err) {
+		return
+	}
+
+	build := pipeline.NewCreatePipelineRequest()
+	build.Name = "build"
+	build.With = map[string]string{"IMAGE": "workflow"}
+	build.On = &pipeline.Trigger{Events: []string{"push"}, Branches: []string{"main|release-.*"}}
+	release := pipeline.NewCreatePipelineRequest()
+	release.Name = "release"
+	release.On = &pipeline.Trigger{Events: []string{"tag"}, Branches: []string{"v.*"}}
+	manual := pipeline.NewCreatePipelineRequest()
+	manual.Name = "manual"
+
+	tpl := template.NewDefaultTemplate()
+	tpl.Id = "tpl01"
+	tpl.Namespace = "namespace01"
+	tpl.Pipelines = []*pipeline.CreatePipelineRequest{build, release, manual}
+
+	reqs, err := tpl.HookPipelines(e)
+	if should.NoError(err) && should.Len(reqs, 1) {
+		should.Equal("build", reqs[0].Name)
+		should.Equal("tpl01", reqs[0].TemplateId)
+		should.Equal("namespace01", reqs[0].Namespace)
+		should.Equal("workflow", reqs[0].With["IMAGE"])
+		should.Equal("main", reqs[0].With[scm.GIT_BRANCH_KEY])
+		should.NotNil(reqs[0].HookEvent)
+	}
+	// 模版中的定义不会被修改
+	should.Len(build.With, 1)
+}
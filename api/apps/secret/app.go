@@ -0,0 +1,5 @@
+package secret
+
+const (
+	AppName = "secret"
+)
@@ -0,0 +1,45 @@
+package http
+
+import (
+	"github.com/infraboard/mcube/app"
+	"github.com/infraboard/mcube/http/label"
+	"github.com/infraboard/mcube/http/router"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+
+	"github.com/infraboard/workflow/api/apps/secret"
+)
+
+var (
+	api = &handler{log: zap.L().Named("Secret")}
+)
+
+type handler struct {
+	service secret.ServiceServer
+	log     logger.Logger
+}
+
+// Registry 注册HTTP服务路由, 解密接口只提供给Node使用, 不注册HTTP路由
+func (h *handler) Registry(router router.SubRouter) {
+	r := router.ResourceRouter("secrets")
+	r.Permission(true)
+	r.BasePath("secrets")
+	r.Handle("POST", "/", h.CreateSecret).AddLabel(label.Create)
+	r.Handle("GET", "/", h.QuerySecret).AddLabel(label.List)
+	r.Handle("GET", "/:name", h.DescribeSecret).AddLabel(label.Get)
+	r.Handle("PUT", "/:name", h.UpdateSecret).AddLabel(label.Update)
+	r.Handle("DELETE", "/:name", h.DeleteSecret).AddLabel(label.Delete)
+}
+
+func (h *handler) Config() error {
+	h.service = app.GetGrpcApp(secret.AppName).(secret.ServiceServer)
+	return nil
+}
+
+func (h *handler) Name() string {
+	return secret.AppName
+}
+
+func init() {
+	app.RegistryHttpApp(api)
+}
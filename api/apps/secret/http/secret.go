@@ -0,0 +1,115 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/infraboard/keyauth/app/token"
+	"github.com/infraboard/mcube/http/context"
+	"github.com/infraboard/mcube/http/request"
+	"github.com/infraboard/mcube/http/response"
+
+	"github.com/infraboard/workflow/api/apps/secret"
+)
+
+func (h *handler) CreateSecret(w http.ResponseWriter, r *http.Request) {
+	ctx := context.GetContext(r)
+	tk := ctx.AuthInfo.(*token.Token)
+
+	req := secret.NewCreateSecretRequest()
+	if err := request.GetDataFromRequest(r, req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.UpdateOwner(tk)
+
+	ins, err := h.service.CreateSecret(
+		r.Context(),
+		req,
+	)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	response.Success(w, ins)
+}
+
+func (h *handler) QuerySecret(w http.ResponseWriter, r *http.Request) {
+	ctx := context.GetContext(r)
+	tk := ctx.AuthInfo.(*token.Token)
+
+	page := request.NewPageRequestFromHTTP(r)
+	req := secret.NewQuerySecretRequest(page)
+	req.Namespace = tk.Namespace
+	req.Name = r.URL.Query().Get("name")
+
+	set, err := h.service.QuerySecret(
+		r.Context(),
+		req,
+	)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	response.Success(w, set)
+}
+
+func (h *handler) DescribeSecret(w http.ResponseWriter, r *http.Request) {
+	ctx := context.GetContext(r)
+	tk := ctx.AuthInfo.(*token.Token)
+
+	req := secret.NewDescribeSecretRequest(tk.Namespace, ctx.PS.ByName("name"))
+	ins, err := h.service.DescribeSecret(
+		r.Context(),
+		req,
+	)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	response.Success(w, ins)
+}
+
+func (h *handler) UpdateSecret(w http.ResponseWriter, r *http.Request) {
+	ctx := context.GetContext(r)
+	tk := ctx.AuthInfo.(*token.Token)
+
+	req := secret.NewUpdateSecretRequest()
+	if err := request.GetDataFromRequest(r, req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.Namespace = tk.Namespace
+	req.Name = ctx.PS.ByName("name")
+	req.UpdateBy = tk.Account
+
+	ins, err := h.service.UpdateSecret(
+		r.Context(),
+		req,
+	)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	response.Success(w, ins)
+}
+
+func (h *handler) DeleteSecret(w http.ResponseWriter, r *http.Request) {
+	ctx := context.GetContext(r)
+	tk := ctx.AuthInfo.(*token.Token)
+
+	req := secret.NewDeleteSecretRequest(tk.Namespace, ctx.PS.ByName("name"))
+	ins, err := h.service.DeleteSecret(
+		r.Context(),
+		req,
+	)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	response.Success(w, ins)
+}
@@ -0,0 +1,45 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/keyauth/app/micro"
+	"github.com/infraboard/keyauth/common/header"
+	"github.com/infraboard/mcube/exception"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/infraboard/workflow/conf"
+)
+
+// checkDecryptClient 解密只允许配置的内部服务调用, 比如node和scheduler, 未配置时拒绝所有请求
+func (i *impl) checkDecryptClient(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return exception.NewUnauthorized("client credential not found in grpc metadata")
+	}
+
+	clientID, clientSecret := firstMeta(md, header.ClientHeaderKey), firstMeta(md, header.ClientSecretKey)
+	if clientID == "" || clientSecret == "" {
+		return exception.NewUnauthorized("client_id or client_secret is \"\"")
+	}
+	if !conf.C().IsDecryptClient(clientID) {
+		return exception.NewPermissionDeny("client %s not allowed to decrypt secret", clientID)
+	}
+
+	kc, err := conf.C().Keyauth.Client()
+	if err != nil {
+		return exception.NewInternalServerError("get keyauth client error, %s", err)
+	}
+	req := micro.NewValidateClientCredentialRequest(clientID, clientSecret)
+	if _, err := kc.Micro().ValidateClientCredential(ctx, req); err != nil {
+		return exception.NewUnauthorized("service auth error, %s", err)
+	}
+	return nil
+}
+
+func firstMeta(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
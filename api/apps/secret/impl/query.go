@@ -0,0 +1,49 @@
+package impl
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/infraboard/workflow/api/apps/secret"
+)
+
+func newQuerySecretRequest(req *secret.QuerySecretRequest) *queryRequest {
+	return &queryRequest{
+		QuerySecretRequest: req,
+	}
+}
+
+type queryRequest struct {
+	*secret.QuerySecretRequest
+}
+
+func (r *queryRequest) FindOptions() *options.FindOptions {
+	pageSize := int64(r.Page.PageSize)
+	skip := int64(r.Page.PageSize) * int64(r.Page.PageNumber-1)
+
+	opt := &options.FindOptions{
+		Sort:  bson.D{{Key: "create_at", Value: -1}},
+		Limit: &pageSize,
+		Skip:  &skip,
+	}
+
+	return opt
+}
+
+func (r *queryRequest) FindFilter() bson.M {
+	filter := bson.M{}
+	if r.Namespace != "" {
+		filter["namespace"] = r.Namespace
+	}
+	if r.Name != "" {
+		filter["name"] = r.Name
+	}
+	return filter
+}
+
+func newFilter(namespace, name string) bson.M {
+	return bson.M{
+		"namespace": namespace,
+		"name":      name,
+	}
+}
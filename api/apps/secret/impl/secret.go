@@ -0,0 +1,149 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/exception"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/infraboard/workflow/api/apps/secret"
+)
+
+func (i *impl) CreateSecret(ctx context.Context, req *secret.CreateSecretRequest) (
+	*secret.Secret, error) {
+	ins, err := secret.NewSecret(req, i.key)
+	if err != nil {
+		return nil, exception.NewBadRequest("validate create secret error, %s", err)
+	}
+
+	if _, err := i.col.InsertOne(context.TODO(), ins); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, exception.NewBadRequest("secret %s already exist", req.Name)
+		}
+		return nil, exception.NewInternalServerError("inserted a secret document error, %s", err)
+	}
+
+	ins.Desensitize()
+	return ins, nil
+}
+
+func (i *impl) QuerySecret(ctx context.Context, req *secret.QuerySecretRequest) (
+	*secret.SecretSet, error) {
+	query := newQuerySecretRequest(req)
+	resp, err := i.col.Find(context.TODO(), query.FindFilter(), query.FindOptions())
+	if err != nil {
+		return nil, exception.NewInternalServerError("find secret error, error is %s", err)
+	}
+
+	set := secret.NewSecretSet()
+	// 循环
+	for resp.Next(context.TODO()) {
+		ins := secret.NewDefaultSecret()
+		if err := resp.Decode(ins); err != nil {
+			return nil, exception.NewInternalServerError("decode secret error, error is %s", err)
+		}
+
+		set.Add(ins)
+	}
+
+	// count
+	count, err := i.col.CountDocuments(context.TODO(), query.FindFilter())
+	if err != nil {
+		return nil, exception.NewInternalServerError("get secret count error, error is %s", err)
+	}
+	set.Total = count
+	set.Desensitize()
+	return set, nil
+}
+
+func (i *impl) DescribeSecret(ctx context.Context, req *secret.DescribeSecretRequest) (
+	*secret.Secret, error) {
+	ins, err := i.describeSecret(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ins.Desensitize()
+	return ins, nil
+}
+
+// describeSecret 返回的值为密文
+func (i *impl) describeSecret(ctx context.Context, req *secret.DescribeSecretRequest) (
+	*secret.Secret, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest("validate describe secret error, %s", err)
+	}
+
+	ins := secret.NewDefaultSecret()
+	if err := i.col.FindOne(context.TODO(), newFilter(req.Namespace, req.Name)).Decode(ins); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, exception.NewNotFound("secret %s not found", req.Name)
+		}
+
+		return nil, exception.NewInternalServerError("find secret %s error, %s", req.Name, err)
+	}
+
+	return ins, nil
+}
+
+func (i *impl) UpdateSecret(ctx context.Context, req *secret.UpdateSecretRequest) (
+	*secret.Secret, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest("validate update secret error, %s", err)
+	}
+
+	ins, err := i.describeSecret(ctx, secret.NewDescribeSecretRequest(req.Namespace, req.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ins.Update(req, i.key); err != nil {
+		return nil, exception.NewInternalServerError("update secret error, %s", err)
+	}
+	_, err = i.col.UpdateOne(context.TODO(), newFilter(req.Namespace, req.Name), bson.M{"$set": ins})
+	if err != nil {
+		return nil, exception.NewInternalServerError("update secret(%s) error, %s", req.Name, err)
+	}
+
+	ins.Desensitize()
+	return ins, nil
+}
+
+func (i *impl) DeleteSecret(ctx context.Context, req *secret.DeleteSecretRequest) (
+	*secret.Secret, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest("validate delete secret error, %s", err)
+	}
+
+	ins, err := i.DescribeSecret(ctx, secret.NewDescribeSecretRequest(req.Namespace, req.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := i.col.DeleteOne(context.TODO(), newFilter(req.Namespace, req.Name)); err != nil {
+		return nil, exception.NewInternalServerError("delete secret(%s) error, %s", req.Name, err)
+	}
+
+	return ins, nil
+}
+
+func (i *impl) DecryptSecret(ctx context.Context, req *secret.DecryptSecretRequest) (
+	*secret.Secret, error) {
+	if err := i.checkDecryptClient(ctx); err != nil {
+		return nil, err
+	}
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest("validate decrypt secret error, %s", err)
+	}
+
+	ins, err := i.describeSecret(ctx, secret.NewDescribeSecretRequest(req.Namespace, req.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ins.Decrypt(i.key); err != nil {
+		return nil, exception.NewInternalServerError(err.Error())
+	}
+	return ins, nil
+}
@@ -0,0 +1,215 @@
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/infraboard/keyauth/app/token"
+	"github.com/infraboard/mcube/http/request"
+	"github.com/rs/xid"
+)
+
+const (
+	// 日志中Secret的值会被替换成该掩码
+	MASK = "***"
+)
+
+// use a single instance of Validate, it caches struct info
+var (
+	validate = validator.New()
+)
+
+// NewSecret 创建Secret, 值使用key加密后保存
+func NewSecret(req *CreateSecretRequest, key string) (*Secret, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	value, err := Encrypt(req.Value, key)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixMilli()
+	return &Secret{
+		Id:          xid.New().String(),
+		Domain:      req.Domain,
+		Namespace:   req.Namespace,
+		CreateBy:    req.CreateBy,
+		CreateAt:    now,
+		UpdateAt:    now,
+		Name:        req.Name,
+		Value:       value,
+		Description: req.Description,
+	}, nil
+}
+
+func NewDefaultSecret() *Secret {
+	return &Secret{}
+}
+
+// Desensitize 返回给用户前清除值
+func (s *Secret) Desensitize() {
+	s.Value = ""
+}
+
+// Decrypt 解密保存的值
+func (s *Secret) Decrypt(key string) error {
+	value, err := Decrypt(s.Value, key)
+	if err != nil {
+		return fmt.Errorf("decrypt secret %s error, %s", s.Name, err)
+	}
+	s.Value = value
+	return nil
+}
+
+func (s *Secret) Update(req *UpdateSecretRequest, key string) error {
+	if req.Value != "" {
+		value, err := Encrypt(req.Value, key)
+		if err != nil {
+			return err
+		}
+		s.Value = value
+	}
+	s.Description = req.Description
+	s.UpdateBy = req.UpdateBy
+	s.UpdateAt = time.Now().UnixMilli()
+	return nil
+}
+
+func NewSecretSet() *SecretSet {
+	return &SecretSet{
+		Items: []*Secret{},
+	}
+}
+
+func (s *SecretSet) Add(item *Secret) {
+	s.Items = append(s.Items, item)
+}
+
+func (s *SecretSet) Desensitize() {
+	for i := range s.Items {
+		s.Items[i].Desensitize()
+	}
+}
+
+func NewCreateSecretRequest() *CreateSecretRequest {
+	return &CreateSecretRequest{}
+}
+
+func (req *CreateSecretRequest) UpdateOwner(tk *token.Token) {
+	req.Domain = tk.Domain
+	req.Namespace = tk.Namespace
+	req.CreateBy = tk.Account
+}
+
+func (req *CreateSecretRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewUpdateSecretRequest() *UpdateSecretRequest {
+	return &UpdateSecretRequest{}
+}
+
+func (req *UpdateSecretRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewQuerySecretRequest(page *request.PageRequest) *QuerySecretRequest {
+	return &QuerySecretRequest{
+		Page: page,
+	}
+}
+
+func NewDescribeSecretRequest(namespace, name string) *DescribeSecretRequest {
+	return &DescribeSecretRequest{
+		Namespace: namespace,
+		Name:      name,
+	}
+}
+
+func (req *DescribeSecretRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewDeleteSecretRequest(namespace, name string) *DeleteSecretRequest {
+	return &DeleteSecretRequest{
+		Namespace: namespace,
+		Name:      name,
+	}
+}
+
+func (req *DeleteSecretRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewDecryptSecretRequest(namespace, name string) *DecryptSecretRequest {
+	return &DecryptSecretRequest{
+		Namespace: namespace,
+		Name:      name,
+	}
+}
+
+func (req *DecryptSecretRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+// Encrypt 使用AES-GCM加密, key通过sha256转换为32位, 结果为base64编码的 nonce+密文
+func Encrypt(plain, key string) (string, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", fmt.Errorf("generate nonce error, %s", err)
+	}
+
+	data := gcm.Seal(nonce, nonce, []byte(plain), nil)
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// Decrypt 解密Encrypt加密的密文
+func Decrypt(crypted, key string) (string, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := base64.StdEncoding.DecodeString(crypted)
+	if err != nil {
+		return "", fmt.Errorf("decode cipher text error, %s", err)
+	}
+
+	n := gcm.NonceSize()
+	if len(data) < n {
+		return "", fmt.Errorf("cipher text too short")
+	}
+
+	plain, err := gcm.Open(nil, data[:n], data[n:], nil)
+	if err != nil {
+		return "", fmt.Errorf("open cipher text error, %s", err)
+	}
+	return string(plain), nil
+}
+
+func newGCM(key string) (cipher.AEAD, error) {
+	if key == "" {
+		return nil, fmt.Errorf("encrypt key is empty")
+	}
+
+	k := sha256.Sum256([]byte(key))
+	block, err := aes.NewCipher(k[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
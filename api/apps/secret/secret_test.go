@@ -0,0 +1,54 @@
+package secret_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/workflow/api/apps/secret"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	should := assert.New(t)
+
+	crypted, err := secret.Encrypt("123456", "key01")
+	if should.NoError(err) {
+		should.NotContains(crypted, "123456")
+
+		plain, err := secret.Decrypt(crypted, "key01")
+		should.NoError(err)
+		should.Equal("123456", plain)
+
+		_, err = secret.Decrypt(crypted, "key02")
+		should.Error(err)
+	}
+
+	_, err = secret.Encrypt("123456", "")
+	should.Error(err)
+}
+
+func TestNewSecret(t *testing.T) {
+	should := assert.New(t)
+
+	req := secret.NewCreateSecretRequest()
+	req.Domain = "domain01"
+	req.Namespace = "namespace01"
+	req.CreateBy = "admin"
+	req.Name = "TOKEN"
+	req.Value = "123456"
+
+	ins, err := secret.NewSecret(req, "key01")
+	if should.NoError(err) {
+		should.NotEqual("123456", ins.Value)
+
+		clone := &secret.Secret{Name: ins.Name, Value: ins.Value}
+		should.NoError(clone.Decrypt("key01"))
+		should.Equal("123456", clone.Value)
+
+		ins.Desensitize()
+		should.Equal("", ins.Value)
+	}
+
+	_, err = secret.NewSecret(secret.NewCreateSecretRequest(), "key01")
+	should.Error(err)
+}
@@ -29,10 +29,14 @@ func (h *handler) Registry(router router.SubRouter) {
 	r.Handle("PUT", "/:id", h.PutTemplate)
 	r.Handle("PATCH", "/:id", h.PatchTemplate)
 	r.Handle("DELETE", "/:id", h.DeleteTemplate)
+	r.Handle("POST", "/:id/run", h.RunTemplate)
+	r.Handle("GET", "/:id/revisions", h.QueryTemplateRevision)
+	r.Handle("GET", "/:id/diff", h.DiffTemplateRevision)
+	r.Handle("POST", "/:id/rollback", h.RollbackTemplate)
 }
 
 func (h *handler) Config() error {
-	h.service = nil
+	h.service = app.GetGrpcApp(template.AppName).(template.ServiceServer)
 
 	h.log = zap.L().Named(template.AppName)
 	return nil
@@ -2,8 +2,10 @@ package http
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/infraboard/keyauth/app/token"
+	"github.com/infraboard/mcube/exception"
 	"github.com/infraboard/mcube/http/context"
 	"github.com/infraboard/mcube/http/request"
 	"github.com/infraboard/mcube/http/response"
@@ -130,3 +132,98 @@ func (h *handler) PatchTemplate(w http.ResponseWriter, r *http.Request) {
 
 	response.Success(w, ins)
 }
+
+func (h *handler) RunTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := context.GetContext(r)
+	tk := ctx.AuthInfo.(*token.Token)
+
+	req := template.NewRunTemplateRequest(ctx.PS.ByName("id"))
+	if err := request.GetDataFromRequest(r, req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.TemplateId = ctx.PS.ByName("id")
+	req.UpdateOwner(tk)
+
+	ins, err := h.service.RunTemplate(
+		r.Context(),
+		req,
+	)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+
+	response.Success(w, ins)
+}
+
+func (h *handler) QueryTemplateRevision(w http.ResponseWriter, r *http.Request) {
+	ctx := context.GetContext(r)
+
+	page := request.NewPageRequestFromHTTP(r)
+	req := template.NewQueryTemplateRevisionRequest(ctx.PS.ByName("id"), page)
+
+	set, err := h.service.QueryTemplateRevision(
+		r.Context(),
+		req,
+	)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, set)
+}
+
+// DiffTemplateRevision 参数: from 旧版本, to 新版本, to为空时和当前版本对比
+func (h *handler) DiffTemplateRevision(w http.ResponseWriter, r *http.Request) {
+	ctx := context.GetContext(r)
+	qs := r.URL.Query()
+
+	from, err := strconv.ParseInt(qs.Get("from"), 10, 64)
+	if err != nil {
+		response.Failed(w, exception.NewBadRequest("from revision invalid, %s", err))
+		return
+	}
+	var to int64
+	if v := qs.Get("to"); v != "" {
+		to, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			response.Failed(w, exception.NewBadRequest("to revision invalid, %s", err))
+			return
+		}
+	}
+	req := template.NewDiffTemplateRevisionRequest(ctx.PS.ByName("id"), from, to)
+
+	ins, err := h.service.DiffTemplateRevision(
+		r.Context(),
+		req,
+	)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
+
+func (h *handler) RollbackTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := context.GetContext(r)
+	tk := ctx.AuthInfo.(*token.Token)
+
+	req := template.NewRollbackTemplateRequest(ctx.PS.ByName("id"), 0)
+	if err := request.GetDataFromRequest(r, req); err != nil {
+		response.Failed(w, err)
+		return
+	}
+	req.TemplateId = ctx.PS.ByName("id")
+	req.UpdateBy = tk.Account
+
+	ins, err := h.service.RollbackTemplate(
+		r.Context(),
+		req,
+	)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	response.Success(w, ins)
+}
@@ -4,17 +4,86 @@ import (
 	"context"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/infraboard/mcube/exception"
 
 	"github.com/infraboard/workflow/api/apps/template"
 )
 
-func (s *impl) update(ctx context.Context, app *template.Template) error {
-	_, err := s.col.UpdateOne(ctx, bson.M{"_id": app.Id}, bson.M{"$set": app})
+// commit 保存修改后的模版, 并生成新的版本
+// 先写入版本, 版本号的唯一索引保证同一个版本只有一次修改成功
+func (s *impl) commit(ctx context.Context, old, app *template.Template, updater, summary string) error {
+	// 之前创建的模版没有版本, 把修改前的定义保存为第一个版本
+	if old.Revision == 0 {
+		old.Revision = 1
+		base, err := template.NewTemplateRevision(old, old.CreateBy, "initial revision")
+		if err != nil {
+			return exception.NewInternalServerError(err.Error())
+		}
+		if err := s.insertRevision(ctx, base); err != nil && !mongo.IsDuplicateKeyError(err) {
+			return err
+		}
+	}
+
+	app.Revision = old.Revision + 1
+	rev, err := template.NewTemplateRevision(app, updater, summary)
+	if err != nil {
+		return exception.NewInternalServerError(err.Error())
+	}
+	if err := s.insertRevision(ctx, rev); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return exception.NewConflict("template %s revision %d already exist, please retry", app.Id, app.Revision)
+		}
+		return err
+	}
+
+	if err := s.update(ctx, old.Revision, app); err != nil {
+		if _, err := s.revision.DeleteOne(ctx, bson.M{"_id": rev.Id}); err != nil {
+			s.log.Errorf("delete template revision %s error, %s", rev.Id, err)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *impl) insertRevision(ctx context.Context, rev *template.TemplateRevision) error {
+	if _, err := s.revision.InsertOne(ctx, rev); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return err
+		}
+		return exception.NewInternalServerError("inserted a template revision document error, %s", err)
+	}
+	return nil
+}
+
+// update 只有版本没有变化时才更新, 旧数据没有revision字段
+func (s *impl) update(ctx context.Context, revision int64, app *template.Template) error {
+	filter := bson.M{"_id": app.Id, "revision": revision}
+	if revision == 1 {
+		filter["revision"] = bson.M{"$in": bson.A{0, 1, nil}}
+	}
+
+	resp, err := s.col.UpdateOne(ctx, filter, bson.M{"$set": app})
 	if err != nil {
 		return exception.NewInternalServerError("update template(%s) error, %s", app.Id, err)
 	}
+	if resp.MatchedCount == 0 {
+		return exception.NewConflict("template %s has been modified, please retry", app.Id)
+	}
 
 	return nil
 }
+
+func (s *impl) describeRevision(ctx context.Context, templateId string, revision int64) (
+	*template.TemplateRevision, error) {
+	ins := template.NewDefaultTemplateRevision()
+	id := template.RevisionId(templateId, revision)
+	if err := s.revision.FindOne(ctx, bson.M{"_id": id}).Decode(ins); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, exception.NewNotFound("template revision %s not found", id)
+		}
+		return nil, exception.NewInternalServerError("find template revision %s error, %s", id, err)
+	}
+	return ins, nil
+}
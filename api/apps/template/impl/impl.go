@@ -11,6 +11,7 @@ import (
 	"go.mongodb.org/mongo-driver/x/bsonx"
 	"google.golang.org/grpc"
 
+	"github.com/infraboard/workflow/api/apps/pipeline"
 	"github.com/infraboard/workflow/api/apps/template"
 	"github.com/infraboard/workflow/conf"
 )
@@ -21,8 +22,10 @@ var (
 )
 
 type impl struct {
-	col *mongo.Collection
-	log logger.Logger
+	col      *mongo.Collection
+	revision *mongo.Collection
+	log      logger.Logger
+	pipeline pipeline.ServiceServer
 
 	template.UnimplementedServiceServer
 }
@@ -50,7 +53,24 @@ func (s *impl) Config() error {
 	}
 
 	s.col = dc
+
+	rc := db.Collection("template_revisions")
+	revisionIndexs := []mongo.IndexModel{
+		{
+			Keys: bsonx.Doc{
+				{Key: "template_id", Value: bsonx.Int32(-1)},
+				{Key: "revision", Value: bsonx.Int32(-1)},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+	}
+	if _, err := rc.Indexes().CreateMany(context.Background(), revisionIndexs); err != nil {
+		return err
+	}
+	s.revision = rc
+
 	s.log = zap.L().Named("Action")
+	s.pipeline = app.GetGrpcApp(pipeline.AppName).(pipeline.ServiceServer)
 
 	return nil
 }
@@ -68,3 +68,31 @@ func (req *describeRequest) FindFilter() bson.M {
 
 	return filter
 }
+
+func newQueryRevisionRequest(req *template.QueryTemplateRevisionRequest) *queryRevisionRequest {
+	return &queryRevisionRequest{
+		QueryTemplateRevisionRequest: req,
+	}
+}
+
+type queryRevisionRequest struct {
+	*template.QueryTemplateRevisionRequest
+}
+
+func (r *queryRevisionRequest) FindOptions() *options.FindOptions {
+	opt := &options.FindOptions{
+		Sort: bson.D{{Key: "revision", Value: -1}},
+	}
+	if r.Page != nil {
+		pageSize := int64(r.Page.PageSize)
+		skip := int64(r.Page.PageSize) * int64(r.Page.PageNumber-1)
+		opt.Limit = &pageSize
+		opt.Skip = &skip
+	}
+
+	return opt
+}
+
+func (r *queryRevisionRequest) FindFilter() bson.M {
+	return bson.M{"template_id": r.TemplateId}
+}
@@ -22,6 +22,13 @@ func (i *impl) CreateTemplate(ctx context.Context, req *template.CreateTemplateR
 	if _, err := i.col.InsertOne(context.TODO(), a); err != nil {
 		return nil, exception.NewInternalServerError("inserted a template document error, %s", err)
 	}
+	rev, err := template.NewTemplateRevision(a, a.CreateBy, "created")
+	if err != nil {
+		return nil, exception.NewInternalServerError(err.Error())
+	}
+	if err := i.insertRevision(ctx, rev); err != nil {
+		return nil, err
+	}
 	return a, nil
 }
 
@@ -87,6 +94,10 @@ func (i *impl) UpdateTemplate(ctx context.Context, req *template.UpdateTemplateR
 	if err != nil {
 		return nil, err
 	}
+	old, err := temp.Clone()
+	if err != nil {
+		return nil, exception.NewInternalServerError(err.Error())
+	}
 
 	switch req.UpdateMode {
 	case request.UpdateMode_PUT:
@@ -97,7 +108,16 @@ func (i *impl) UpdateTemplate(ctx context.Context, req *template.UpdateTemplateR
 		return nil, fmt.Errorf("unknown update mode: %s", req.UpdateMode)
 	}
 
-	if err := i.update(ctx, temp); err != nil {
+	if err := temp.Validate(); err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+
+	// 没有修改时不生成新的版本
+	changes := template.DiffTemplate(old, temp)
+	if len(changes) == 0 {
+		return old, nil
+	}
+	if err := i.commit(ctx, old, temp, req.UpdateBy, template.ChangeSummary(changes)); err != nil {
 		return nil, err
 	}
 	return temp, nil
@@ -116,3 +136,136 @@ func (i *impl) DeleteTemplate(ctx context.Context, req *template.DeleteTemplateR
 
 	return ins, nil
 }
+
+// RunTemplate 使用模版创建pipeline, 所有pipeline都检查通过后才开始创建
+func (i *impl) RunTemplate(ctx context.Context, req *template.RunTemplateRequest) (
+	*template.RunTemplateResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest("validate run template error, %s", err)
+	}
+
+	t, err := i.DescribeTemplate(ctx, template.NewDescribeTemplateRequestWithID(req.TemplateId))
+	if err != nil {
+		return nil, err
+	}
+	if !t.IsVisiable(req.Namespace) {
+		return nil, exception.NewNotFound("template %s not found", req.TemplateId)
+	}
+
+	reqs, err := t.RunPipelines(req)
+	if err != nil {
+		return nil, exception.NewBadRequest(err.Error())
+	}
+	for _, p := range reqs {
+		if err := p.Validate(); err != nil {
+			return nil, exception.NewBadRequest("validate template %s pipeline %s error, %s", t.Name, p.Name, err)
+		}
+	}
+
+	resp := template.NewRunTemplateResponse(t.Id)
+	for _, p := range reqs {
+		ins, err := i.pipeline.CreatePipeline(ctx, p)
+		if err != nil && len(resp.PipelineIds) == 0 {
+			return nil, err
+		}
+		if err != nil {
+			return nil, exception.NewInternalServerError("template %s create pipeline %s error, created: %v, %s",
+				t.Name, p.Name, resp.PipelineIds, err)
+		}
+		i.log.Infof("template %s create pipeline %s by %s", t.Name, ins.Id, req.CreateBy)
+		resp.PipelineIds = append(resp.PipelineIds, ins.Id)
+	}
+	return resp, nil
+}
+
+func (i *impl) QueryTemplateRevision(ctx context.Context, req *template.QueryTemplateRevisionRequest) (
+	*template.TemplateRevisionSet, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest("validate query template revision error, %s", err)
+	}
+
+	query := newQueryRevisionRequest(req)
+	resp, err := i.revision.Find(ctx, query.FindFilter(), query.FindOptions())
+	if err != nil {
+		return nil, exception.NewInternalServerError("find template revision error, error is %s", err)
+	}
+
+	set := template.NewTemplateRevisionSet()
+	for resp.Next(ctx) {
+		ins := template.NewDefaultTemplateRevision()
+		if err := resp.Decode(ins); err != nil {
+			return nil, exception.NewInternalServerError("decode template revision error, error is %s", err)
+		}
+		set.Add(ins)
+	}
+
+	count, err := i.revision.CountDocuments(ctx, query.FindFilter())
+	if err != nil {
+		return nil, exception.NewInternalServerError("get template revision count error, error is %s", err)
+	}
+	set.Total = count
+	return set, nil
+}
+
+// DiffTemplateRevision 对比两个版本, to为0时和模版当前的定义对比
+func (i *impl) DiffTemplateRevision(ctx context.Context, req *template.DiffTemplateRevisionRequest) (
+	*template.TemplateDiff, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest("validate diff template revision error, %s", err)
+	}
+
+	from, err := i.describeRevision(ctx, req.TemplateId, req.From)
+	if err != nil {
+		return nil, err
+	}
+
+	var to *template.Template
+	if req.To == 0 {
+		to, err = i.DescribeTemplate(ctx, template.NewDescribeTemplateRequestWithID(req.TemplateId))
+	} else {
+		var rev *template.TemplateRevision
+		rev, err = i.describeRevision(ctx, req.TemplateId, req.To)
+		if rev != nil {
+			to = rev.Template
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	changes := template.DiffTemplate(from.Template, to)
+	return template.NewTemplateDiff(req.TemplateId, from.Revision, to.Revision, changes), nil
+}
+
+// RollbackTemplate 使用历史版本的定义生成新的版本, 历史版本不会被删除
+func (i *impl) RollbackTemplate(ctx context.Context, req *template.RollbackTemplateRequest) (
+	*template.Template, error) {
+	if err := req.Validate(); err != nil {
+		return nil, exception.NewBadRequest("validate rollback template error, %s", err)
+	}
+
+	temp, err := i.DescribeTemplate(ctx, template.NewDescribeTemplateRequestWithID(req.TemplateId))
+	if err != nil {
+		return nil, err
+	}
+	rev, err := i.describeRevision(ctx, req.TemplateId, req.Revision)
+	if err != nil {
+		return nil, err
+	}
+	old, err := temp.Clone()
+	if err != nil {
+		return nil, exception.NewInternalServerError(err.Error())
+	}
+
+	temp.Rollback(req.UpdateBy, rev.Template)
+	changes := template.DiffTemplate(old, temp)
+	if len(changes) == 0 {
+		return nil, exception.NewBadRequest("template %s is same as revision %d", temp.Id, req.Revision)
+	}
+
+	summary := fmt.Sprintf("rollback to revision %d, %s", req.Revision, template.ChangeSummary(changes))
+	if err := i.commit(ctx, old, temp, req.UpdateBy, summary); err != nil {
+		return nil, err
+	}
+	return temp, nil
+}
@@ -0,0 +1,137 @@
+package template
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Validate 检查参数定义, 默认值和可选值需要符合参数的类型和约束
+func (p *TemplateParam) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("param name required")
+	}
+	if p.Regex != "" {
+		if _, err := regexp.Compile(p.Regex); err != nil {
+			return fmt.Errorf("param %s regex %s invalid, %s", p.Name, p.Regex, err)
+		}
+	}
+	for _, v := range p.Enum {
+		if err := p.checkType(v); err != nil {
+			return err
+		}
+	}
+	if p.DefaultValue != "" {
+		if err := p.Check(p.DefaultValue); err != nil {
+			return fmt.Errorf("param %s default value invalid, %s", p.Name, err)
+		}
+	}
+	return nil
+}
+
+// Check 检查传入的值是否符合参数的类型和约束
+func (p *TemplateParam) Check(value string) error {
+	if err := p.checkType(value); err != nil {
+		return err
+	}
+
+	if len(p.Enum) > 0 && !p.isEnum(value) {
+		return fmt.Errorf("param %s value %s not in %s", p.Name, value, strings.Join(p.Enum, ","))
+	}
+
+	if p.Regex != "" {
+		ok, err := regexp.MatchString(p.Regex, value)
+		if err != nil {
+			return fmt.Errorf("param %s regex %s invalid, %s", p.Name, p.Regex, err)
+		}
+		if !ok {
+			return fmt.Errorf("param %s value %s not match %s", p.Name, value, p.Regex)
+		}
+	}
+	return nil
+}
+
+func (p *TemplateParam) checkType(value string) error {
+	switch p.Type {
+	case PARAM_TYPE_NUMBER:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("param %s value %s is not a number", p.Name, value)
+		}
+	case PARAM_TYPE_BOOL:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("param %s value %s is not a bool", p.Name, value)
+		}
+	}
+	return nil
+}
+
+func (p *TemplateParam) isEnum(value string) bool {
+	for _, v := range p.Enum {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func validateParams(params []*TemplateParam) error {
+	nameMap := map[string]struct{}{}
+	for i := range params {
+		if err := params[i].Validate(); err != nil {
+			return err
+		}
+		if _, ok := nameMap[params[i].Name]; ok {
+			return fmt.Errorf("param %s duplicate", params[i].Name)
+		}
+		nameMap[params[i].Name] = struct{}{}
+	}
+	return nil
+}
+
+// RenderInputs 按照模版的参数定义检查传入的值, 未传入的参数使用默认值
+// 所有不合法的参数一起返回, 模版中未定义的参数不允许传入
+func (t *Template) RenderInputs(inputs map[string]string) (map[string]string, error) {
+	values := map[string]string{}
+	msg := []string{}
+
+	declared := map[string]struct{}{}
+	for i := range t.Params {
+		param := t.Params[i]
+		declared[param.Name] = struct{}{}
+
+		v, ok := inputs[param.Name]
+		if !ok || v == "" {
+			v = param.DefaultValue
+		}
+		if v == "" {
+			if param.Required {
+				msg = append(msg, "required param "+param.Name)
+			}
+			continue
+		}
+
+		if err := param.Check(v); err != nil {
+			msg = append(msg, err.Error())
+			continue
+		}
+		values[param.Name] = v
+	}
+
+	unknown := []string{}
+	for k := range inputs {
+		if _, ok := declared[k]; !ok {
+			unknown = append(unknown, k)
+		}
+	}
+	sort.Strings(unknown)
+	for _, k := range unknown {
+		msg = append(msg, "unknown param "+k)
+	}
+
+	if len(msg) > 0 {
+		return nil, fmt.Errorf("validate template inputs error, %s", strings.Join(msg, ","))
+	}
+	return values, nil
+}
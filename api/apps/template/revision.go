@@ -0,0 +1,244 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/infraboard/mcube/http/request"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+)
+
+const (
+	CHANGE_ADDED    = "added"
+	CHANGE_REMOVED  = "removed"
+	CHANGE_MODIFIED = "modified"
+)
+
+// RevisionId 版本的唯一ID, 格式为 template_id@revision
+func RevisionId(templateId string, revision int64) string {
+	return fmt.Sprintf("%s@%d", templateId, revision)
+}
+
+// NewTemplateRevision 保存模版当前的定义作为一个版本, 版本中不保存WebHook Token
+func NewTemplateRevision(t *Template, createBy, summary string) (*TemplateRevision, error) {
+	snapshot, err := t.Clone()
+	if err != nil {
+		return nil, err
+	}
+	snapshot.WebhookToken = ""
+
+	return &TemplateRevision{
+		Id:         RevisionId(t.Id, t.Revision),
+		TemplateId: t.Id,
+		Revision:   t.Revision,
+		CreateAt:   time.Now().UnixMilli(),
+		CreateBy:   createBy,
+		Summary:    summary,
+		Template:   snapshot,
+	}, nil
+}
+
+func NewDefaultTemplateRevision() *TemplateRevision {
+	return &TemplateRevision{
+		Template: NewDefaultTemplate(),
+	}
+}
+
+// NewTemplateRevisionSet todo
+func NewTemplateRevisionSet() *TemplateRevisionSet {
+	return &TemplateRevisionSet{
+		Items: []*TemplateRevision{},
+	}
+}
+
+func (s *TemplateRevisionSet) Add(item *TemplateRevision) {
+	s.Items = append(s.Items, item)
+}
+
+// Clone 复制一份, 用于修改前保留原来的定义
+func (t *Template) Clone() (*Template, error) {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	ins := NewDefaultTemplate()
+	if err := json.Unmarshal(data, ins); err != nil {
+		return nil, err
+	}
+	return ins, nil
+}
+
+// Rollback 使用历史版本的定义覆盖当前的定义, 版本号由保存时生成
+func (t *Template) Rollback(updater string, target *Template) {
+	t.UpdateAt = time.Now().UnixMilli()
+	t.UpdateBy = updater
+	t.Name = target.Name
+	t.Tags = target.Tags
+	t.Description = target.Description
+	t.VisiableMode = target.VisiableMode
+	t.Pipelines = target.Pipelines
+	t.Params = target.Params
+}
+
+// DiffTemplate 对比两个版本的模版定义, pipeline和参数按照名称对比
+func DiffTemplate(from, to *Template) []*TemplateChange {
+	changes := []*TemplateChange{}
+	add := func(field string, f, t interface{}) {
+		if c := newTemplateChange(field, f, t); c != nil {
+			changes = append(changes, c)
+		}
+	}
+
+	add("name", from.Name, to.Name)
+	add("description", from.Description, to.Description)
+	add("visiable_mode", from.VisiableMode.String(), to.VisiableMode.String())
+	add("tags", from.Tags, to.Tags)
+	// token只记录修改, 不记录值
+	if from.WebhookToken != to.WebhookToken {
+		changes = append(changes, &TemplateChange{Field: "webhook_token", Action: CHANGE_MODIFIED})
+	}
+
+	fromParams, toParams := map[string]interface{}{}, map[string]interface{}{}
+	for _, p := range from.Params {
+		fromParams[p.Name] = p
+	}
+	for _, p := range to.Params {
+		toParams[p.Name] = p
+	}
+	for _, k := range unionKeys(fromParams, toParams) {
+		add("params."+k, fromParams[k], toParams[k])
+	}
+
+	fromPipelines, toPipelines := map[string]interface{}{}, map[string]interface{}{}
+	for _, p := range from.Pipelines {
+		fromPipelines[p.Name] = p
+	}
+	for _, p := range to.Pipelines {
+		toPipelines[p.Name] = p
+	}
+	for _, k := range unionKeys(fromPipelines, toPipelines) {
+		add("pipelines."+k, fromPipelines[k], toPipelines[k])
+	}
+
+	return changes
+}
+
+// 值相同时返回nil, nil表示属性不存在
+func newTemplateChange(field string, from, to interface{}) *TemplateChange {
+	f, t := encodeValue(from), encodeValue(to)
+	if f == t {
+		return nil
+	}
+
+	c := &TemplateChange{Field: field, From: f, To: t}
+	switch {
+	case f == "":
+		c.Action = CHANGE_ADDED
+	case t == "":
+		c.Action = CHANGE_REMOVED
+	default:
+		c.Action = CHANGE_MODIFIED
+	}
+	return c
+}
+
+// 空值编码为空字符串, 比如空字符串, 空map和nil
+func encodeValue(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		if value == "" {
+			return ""
+		}
+	case map[string]string:
+		if len(value) == 0 {
+			return ""
+		}
+	case *TemplateParam:
+		if value == nil {
+			return ""
+		}
+	case *pipeline.CreatePipelineRequest:
+		if value == nil {
+			return ""
+		}
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
+
+func unionKeys(a, b map[string]interface{}) []string {
+	keys := []string{}
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ChangeSummary 修改的摘要, 比如: modified name, added params.ENV
+func ChangeSummary(changes []*TemplateChange) string {
+	items := make([]string, 0, len(changes))
+	for _, c := range changes {
+		items = append(items, c.Action+" "+c.Field)
+	}
+	return strings.Join(items, ", ")
+}
+
+func NewTemplateDiff(templateId string, from, to int64, changes []*TemplateChange) *TemplateDiff {
+	return &TemplateDiff{
+		TemplateId: templateId,
+		From:       from,
+		To:         to,
+		Changes:    changes,
+	}
+}
+
+// NewQueryTemplateRevisionRequest 查询模版的版本列表
+func NewQueryTemplateRevisionRequest(templateId string, page *request.PageRequest) *QueryTemplateRevisionRequest {
+	return &QueryTemplateRevisionRequest{
+		TemplateId: templateId,
+		Page:       page,
+	}
+}
+
+func (req *QueryTemplateRevisionRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewDiffTemplateRevisionRequest(templateId string, from, to int64) *DiffTemplateRevisionRequest {
+	return &DiffTemplateRevisionRequest{
+		TemplateId: templateId,
+		From:       from,
+		To:         to,
+	}
+}
+
+func (req *DiffTemplateRevisionRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func NewRollbackTemplateRequest(templateId string, revision int64) *RollbackTemplateRequest {
+	return &RollbackTemplateRequest{
+		TemplateId: templateId,
+		Revision:   revision,
+	}
+}
+
+func (req *RollbackTemplateRequest) Validate() error {
+	return validate.Struct(req)
+}
@@ -0,0 +1,55 @@
+// Code generated by github.com/infraboard/mcube
+// DO NOT EDIT
+
+package template
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ParsePARAM_TYPEFromString Parse PARAM_TYPE from string
+func ParsePARAM_TYPEFromString(str string) (PARAM_TYPE, error) {
+	key := strings.Trim(string(str), `"`)
+	v, ok := PARAM_TYPE_value[strings.ToUpper(key)]
+	if !ok {
+		return 0, fmt.Errorf("unknown PARAM_TYPE: %s", str)
+	}
+
+	return PARAM_TYPE(v), nil
+}
+
+// Equal type compare
+func (t PARAM_TYPE) Equal(target PARAM_TYPE) bool {
+	return t == target
+}
+
+// IsIn todo
+func (t PARAM_TYPE) IsIn(targets ...PARAM_TYPE) bool {
+	for _, target := range targets {
+		if t.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarshalJSON todo
+func (t PARAM_TYPE) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBufferString(`"`)
+	b.WriteString(strings.ToUpper(t.String()))
+	b.WriteString(`"`)
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON todo
+func (t *PARAM_TYPE) UnmarshalJSON(b []byte) error {
+	ins, err := ParsePARAM_TYPEFromString(string(b))
+	if err != nil {
+		return err
+	}
+	*t = ins
+	return nil
+}
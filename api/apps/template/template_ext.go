@@ -1,13 +1,20 @@
 package template
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/infraboard/keyauth/app/token"
 	"github.com/infraboard/mcube/http/request"
+	"github.com/infraboard/mcube/pb/resource"
 	"github.com/rs/xid"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/api/apps/scm"
 )
 
 // use a single instance of Validate, it caches struct info
@@ -15,11 +22,25 @@ var (
 	validate = validator.New()
 )
 
+const (
+	// 自动生成的WebHook Token的字节数
+	WEBHOOK_TOKEN_SIZE = 20
+)
+
 func NewTemplate(req *CreateTemplateRequest) (*Template, error) {
 	if err := req.Validate(); err != nil {
 		return nil, err
 	}
 
+	token := req.WebhookToken
+	if token == "" {
+		t, err := NewWebHookToken()
+		if err != nil {
+			return nil, err
+		}
+		token = t
+	}
+
 	p := &Template{
 		Domain:       req.Domain,
 		Namespace:    req.Namespace,
@@ -32,23 +53,48 @@ func NewTemplate(req *CreateTemplateRequest) (*Template, error) {
 		VisiableMode: req.VisiableMode,
 		Pipelines:    req.Pipelines,
 		Description:  req.Description,
+		Params:       req.Params,
+		Revision:     1,
+		WebhookToken: token,
 	}
 
 	return p, nil
 }
 
+// NewWebHookToken 随机生成代码仓库WebHook使用的Token
+func NewWebHookToken() (string, error) {
+	b := make([]byte, WEBHOOK_TOKEN_SIZE)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate webhook token error, %s", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
 func (req *CreateTemplateRequest) Validate() error {
+	if err := validateDefinition(req.Pipelines, req.Params); err != nil {
+		return err
+	}
+
+	return validate.Struct(req)
+}
+
+// Validate 检查修改后的模版定义
+func (t *Template) Validate() error {
+	return validateDefinition(t.Pipelines, t.Params)
+}
+
+func validateDefinition(pipelines []*pipeline.CreatePipelineRequest, params []*TemplateParam) error {
 	// 判断同一个模版里面,Pipeline名字是否相同
 	nameMap := map[string]struct{}{}
-	for i := range req.Pipelines {
-		_, ok := nameMap[req.Pipelines[i].Name]
+	for i := range pipelines {
+		_, ok := nameMap[pipelines[i].Name]
 		if ok {
 			return fmt.Errorf("in this template name is ready exist")
 		}
-		nameMap[req.Pipelines[i].Name] = struct{}{}
+		nameMap[pipelines[i].Name] = struct{}{}
 	}
 
-	return validate.Struct(req)
+	return validateParams(params)
 }
 
 func NewCreateTemplateRequest() *CreateTemplateRequest {
@@ -83,6 +129,101 @@ func (t *Template) Update(updater string, req *UpdateTemplateData) {
 	t.Tags = req.Tags
 	t.Description = req.Description
 	t.Pipelines = req.Pipelines
+	t.Params = req.Params
+	if req.WebhookToken != "" {
+		t.WebhookToken = req.WebhookToken
+	}
+}
+
+// GetPipeline 获取模版中的pipeline, 模版中只有一个pipeline时, name可以为空
+func (t *Template) GetPipeline(name string) (*pipeline.CreatePipelineRequest, error) {
+	if name == "" {
+		if len(t.Pipelines) != 1 {
+			return nil, fmt.Errorf("template %s has %d pipelines, pipeline name required", t.Name, len(t.Pipelines))
+		}
+		return t.Pipelines[0], nil
+	}
+
+	for i := range t.Pipelines {
+		if t.Pipelines[i].Name == name {
+			return t.Pipelines[i], nil
+		}
+	}
+	return nil, fmt.Errorf("pipeline %s not found in template %s", name, t.Name)
+}
+
+// HookPipelines 返回模版中触发条件匹配该事件的pipeline, 事件信息注入到with中
+func (t *Template) HookPipelines(e *scm.WebHookEvent) ([]*pipeline.CreatePipelineRequest, error) {
+	reqs := []*pipeline.CreatePipelineRequest{}
+	for i := range t.Pipelines {
+		if !t.Pipelines[i].On.IsMatch(e.MatchRef(), e.EventName()) {
+			continue
+		}
+
+		req, err := t.copyPipeline(i)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Domain = t.Domain
+		req.Namespace = t.Namespace
+		req.CreateBy = t.CreateBy
+		req.HookEvent = e
+		for k, v := range e.Variables() {
+			req.With[k] = v
+		}
+		reqs = append(reqs, req)
+	}
+	return reqs, nil
+}
+
+// RunPipelines 使用传入的参数渲染模版中所有的pipeline, 参数注入到with中
+func (t *Template) RunPipelines(req *RunTemplateRequest) ([]*pipeline.CreatePipelineRequest, error) {
+	values, err := t.RenderInputs(req.Inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	reqs := []*pipeline.CreatePipelineRequest{}
+	for i := range t.Pipelines {
+		p, err := t.copyPipeline(i)
+		if err != nil {
+			return nil, err
+		}
+
+		p.Domain = req.Domain
+		p.Namespace = req.Namespace
+		p.CreateBy = req.CreateBy
+		for k, v := range values {
+			p.With[k] = v
+		}
+		reqs = append(reqs, p)
+	}
+	return reqs, nil
+}
+
+// 复制一份, 避免修改模版中的定义
+func (t *Template) copyPipeline(index int) (*pipeline.CreatePipelineRequest, error) {
+	data, err := json.Marshal(t.Pipelines[index])
+	if err != nil {
+		return nil, err
+	}
+	req := pipeline.NewCreatePipelineRequest()
+	if err := json.Unmarshal(data, req); err != nil {
+		return nil, err
+	}
+
+	req.TemplateId = t.Id
+	req.TemplateRevision = t.Revision
+	if req.With == nil {
+		req.With = map[string]string{}
+	}
+	return req, nil
+}
+
+// IsVisiable 全局模版所有空间都可以使用
+func (t *Template) IsVisiable(namespace string) bool {
+	return t.VisiableMode == resource.VisiableMode_GLOBAL || t.Namespace == namespace
 }
 
 func (t *Template) Patch(updater string, req *UpdateTemplateData) {
@@ -101,6 +242,12 @@ func (t *Template) Patch(updater string, req *UpdateTemplateData) {
 	if len(req.Pipelines) > 0 {
 		t.Pipelines = req.Pipelines
 	}
+	if len(req.Params) > 0 {
+		t.Params = req.Params
+	}
+	if req.WebhookToken != "" {
+		t.WebhookToken = req.WebhookToken
+	}
 }
 
 func (req *DescribeTemplateRequest) Validate() error {
@@ -138,3 +285,27 @@ func NewUpdateTemplateRequest(id string) *UpdateTemplateRequest {
 		Data: &UpdateTemplateData{},
 	}
 }
+
+func NewRunTemplateRequest(templateId string) *RunTemplateRequest {
+	return &RunTemplateRequest{
+		TemplateId: templateId,
+		Inputs:     map[string]string{},
+	}
+}
+
+func (req *RunTemplateRequest) Validate() error {
+	return validate.Struct(req)
+}
+
+func (req *RunTemplateRequest) UpdateOwner(tk *token.Token) {
+	req.Domain = tk.Domain
+	req.Namespace = tk.Namespace
+	req.CreateBy = tk.Account
+}
+
+func NewRunTemplateResponse(templateId string) *RunTemplateResponse {
+	return &RunTemplateResponse{
+		TemplateId:  templateId,
+		PipelineIds: []string{},
+	}
+}
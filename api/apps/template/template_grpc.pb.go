@@ -27,6 +27,10 @@ type ServiceClient interface {
 	DescribeTemplate(ctx context.Context, in *DescribeTemplateRequest, opts ...grpc.CallOption) (*Template, error)
 	UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*Template, error)
 	DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*Template, error)
+	RunTemplate(ctx context.Context, in *RunTemplateRequest, opts ...grpc.CallOption) (*RunTemplateResponse, error)
+	QueryTemplateRevision(ctx context.Context, in *QueryTemplateRevisionRequest, opts ...grpc.CallOption) (*TemplateRevisionSet, error)
+	DiffTemplateRevision(ctx context.Context, in *DiffTemplateRevisionRequest, opts ...grpc.CallOption) (*TemplateDiff, error)
+	RollbackTemplate(ctx context.Context, in *RollbackTemplateRequest, opts ...grpc.CallOption) (*Template, error)
 }
 
 type serviceClient struct {
@@ -82,6 +86,42 @@ func (c *serviceClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateRe
 	return out, nil
 }
 
+func (c *serviceClient) RunTemplate(ctx context.Context, in *RunTemplateRequest, opts ...grpc.CallOption) (*RunTemplateResponse, error) {
+	out := new(RunTemplateResponse)
+	err := c.cc.Invoke(ctx, "/infraboard.workflow.template.Service/RunTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) QueryTemplateRevision(ctx context.Context, in *QueryTemplateRevisionRequest, opts ...grpc.CallOption) (*TemplateRevisionSet, error) {
+	out := new(TemplateRevisionSet)
+	err := c.cc.Invoke(ctx, "/infraboard.workflow.template.Service/QueryTemplateRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) DiffTemplateRevision(ctx context.Context, in *DiffTemplateRevisionRequest, opts ...grpc.CallOption) (*TemplateDiff, error) {
+	out := new(TemplateDiff)
+	err := c.cc.Invoke(ctx, "/infraboard.workflow.template.Service/DiffTemplateRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RollbackTemplate(ctx context.Context, in *RollbackTemplateRequest, opts ...grpc.CallOption) (*Template, error) {
+	out := new(Template)
+	err := c.cc.Invoke(ctx, "/infraboard.workflow.template.Service/RollbackTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -91,6 +131,10 @@ type ServiceServer interface {
 	DescribeTemplate(context.Context, *DescribeTemplateRequest) (*Template, error)
 	UpdateTemplate(context.Context, *UpdateTemplateRequest) (*Template, error)
 	DeleteTemplate(context.Context, *DeleteTemplateRequest) (*Template, error)
+	RunTemplate(context.Context, *RunTemplateRequest) (*RunTemplateResponse, error)
+	QueryTemplateRevision(context.Context, *QueryTemplateRevisionRequest) (*TemplateRevisionSet, error)
+	DiffTemplateRevision(context.Context, *DiffTemplateRevisionRequest) (*TemplateDiff, error)
+	RollbackTemplate(context.Context, *RollbackTemplateRequest) (*Template, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -113,6 +157,18 @@ func (UnimplementedServiceServer) UpdateTemplate(context.Context, *UpdateTemplat
 func (UnimplementedServiceServer) DeleteTemplate(context.Context, *DeleteTemplateRequest) (*Template, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
 }
+func (UnimplementedServiceServer) RunTemplate(context.Context, *RunTemplateRequest) (*RunTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunTemplate not implemented")
+}
+func (UnimplementedServiceServer) QueryTemplateRevision(context.Context, *QueryTemplateRevisionRequest) (*TemplateRevisionSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTemplateRevision not implemented")
+}
+func (UnimplementedServiceServer) DiffTemplateRevision(context.Context, *DiffTemplateRevisionRequest) (*TemplateDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffTemplateRevision not implemented")
+}
+func (UnimplementedServiceServer) RollbackTemplate(context.Context, *RollbackTemplateRequest) (*Template, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackTemplate not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +272,78 @@ func _Service_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_RunTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RunTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infraboard.workflow.template.Service/RunTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RunTemplate(ctx, req.(*RunTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_QueryTemplateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTemplateRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).QueryTemplateRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infraboard.workflow.template.Service/QueryTemplateRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).QueryTemplateRevision(ctx, req.(*QueryTemplateRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_DiffTemplateRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffTemplateRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).DiffTemplateRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infraboard.workflow.template.Service/DiffTemplateRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).DiffTemplateRevision(ctx, req.(*DiffTemplateRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RollbackTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RollbackTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/infraboard.workflow.template.Service/RollbackTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RollbackTemplate(ctx, req.(*RollbackTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -243,6 +371,22 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTemplate",
 			Handler:    _Service_DeleteTemplate_Handler,
 		},
+		{
+			MethodName: "RunTemplate",
+			Handler:    _Service_RunTemplate_Handler,
+		},
+		{
+			MethodName: "QueryTemplateRevision",
+			Handler:    _Service_QueryTemplateRevision_Handler,
+		},
+		{
+			MethodName: "DiffTemplateRevision",
+			Handler:    _Service_DiffTemplateRevision_Handler,
+		},
+		{
+			MethodName: "RollbackTemplate",
+			Handler:    _Service_RollbackTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/apps/template/pb/template.proto",
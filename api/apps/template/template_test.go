@@ -0,0 +1,154 @@
+package template_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/api/apps/template"
+)
+
+func newTestTemplate() *template.Template {
+	t := template.NewDefaultTemplate()
+	t.Id = "tpl01"
+	t.Name = "test"
+	t.Revision = 3
+	t.Pipelines = []*pipeline.CreatePipelineRequest{
+		{Name: "build", With: map[string]string{"ENV": "dev"}},
+		{Name: "deploy"},
+	}
+	t.Params = []*template.TemplateParam{
+		{Name: "ENV", Required: true, Enum: []string{"dev", "prod"}},
+		{Name: "REPLICAS", Type: template.PARAM_TYPE_NUMBER, DefaultValue: "1"},
+		{Name: "VERSION", Regex: `^v\d+\.\d+\.\d+$`},
+		{Name: "DEBUG", Type: template.PARAM_TYPE_BOOL},
+	}
+	return t
+}
+
+func TestRenderInputs(t *testing.T) {
+	should := assert.New(t)
+	tpl := newTestTemplate()
+
+	values, err := tpl.RenderInputs(map[string]string{"ENV": "prod", "VERSION": "v1.2.3"})
+	if should.NoError(err) {
+		should.Equal(map[string]string{"ENV": "prod", "REPLICAS": "1", "VERSION": "v1.2.3"}, values)
+	}
+
+	_, err = tpl.RenderInputs(map[string]string{
+		"REPLICAS": "two",
+		"VERSION":  "latest",
+		"DEBUG":    "yes",
+		"OTHER":    "x",
+	})
+	if should.Error(err) {
+		should.Contains(err.Error(), "required param ENV")
+		should.Contains(err.Error(), "REPLICAS value two is not a number")
+		should.Contains(err.Error(), "VERSION value latest not match")
+		should.Contains(err.Error(), "DEBUG value yes is not a bool")
+		should.Contains(err.Error(), "unknown param OTHER")
+	}
+
+	_, err = tpl.RenderInputs(map[string]string{"ENV": "test"})
+	should.Error(err)
+}
+
+func TestRunPipelines(t *testing.T) {
+	should := assert.New(t)
+	tpl := newTestTemplate()
+
+	req := template.NewRunTemplateRequest(tpl.Id)
+	req.Namespace = "ns01"
+	req.Inputs["ENV"] = "prod"
+	reqs, err := tpl.RunPipelines(req)
+	if should.NoError(err) {
+		should.Len(reqs, 2)
+		for _, p := range reqs {
+			should.Equal("tpl01", p.TemplateId)
+			should.Equal(int64(3), p.TemplateRevision)
+			should.Equal("ns01", p.Namespace)
+			should.Equal("prod", p.With["ENV"])
+			should.Equal("1", p.With["REPLICAS"])
+		}
+	}
+
+	// 模版中的定义不会被修改
+	should.Equal("dev", tpl.Pipelines[0].With["ENV"])
+	should.Nil(tpl.Pipelines[1].With)
+}
+
+func TestValidateParams(t *testing.T) {
+	should := assert.New(t)
+
+	req := template.NewCreateTemplateRequest()
+	req.Params = []*template.TemplateParam{
+		{Name: "REPLICAS", Type: template.PARAM_TYPE_NUMBER, DefaultValue: "one"},
+	}
+	should.Error(req.Validate())
+
+	req.Params = []*template.TemplateParam{{Name: "A"}, {Name: "A"}}
+	should.Error(req.Validate())
+
+	req.Params = []*template.TemplateParam{{Name: "A", Regex: "("}}
+	should.Error(req.Validate())
+}
+
+func TestDiffTemplate(t *testing.T) {
+	should := assert.New(t)
+	from := newTestTemplate()
+	from.Revision = 1
+
+	to, err := from.Clone()
+	if !should.NoError(err) {
+		return
+	}
+	should.Empty(template.DiffTemplate(from, to))
+
+	to.Name = "new"
+	to.Params = to.Params[1:]
+	to.Params[0].DefaultValue = "2"
+	to.Pipelines = append(to.Pipelines, &pipeline.CreatePipelineRequest{Name: "test"})
+	changes := template.DiffTemplate(from, to)
+	should.Equal("modified name, removed params.ENV, modified params.REPLICAS, added pipelines.test",
+		template.ChangeSummary(changes))
+
+	// 回滚后和原来的版本一致
+	to.Rollback("admin", from)
+	should.Empty(template.DiffTemplate(from, to))
+	should.Equal("admin", to.UpdateBy)
+}
+
+func TestTemplateWebHookToken(t *testing.T) {
+	should := assert.New(t)
+
+	req := template.NewCreateTemplateRequest()
+	req.Domain = "default"
+	req.Namespace = "ns01"
+	req.CreateBy = "admin"
+	req.Name = "test"
+	tpl, err := template.NewTemplate(req)
+	if !should.NoError(err) {
+		return
+	}
+	should.Len(tpl.WebhookToken, template.WEBHOOK_TOKEN_SIZE*2)
+
+	// 版本中不保存token, 回滚时不会修改token
+	rev, err := template.NewTemplateRevision(tpl, "admin", "created")
+	if !should.NoError(err) {
+		return
+	}
+	should.Empty(rev.Template.WebhookToken)
+	should.NotEmpty(tpl.WebhookToken)
+
+	old, err := tpl.Clone()
+	if !should.NoError(err) {
+		return
+	}
+	tpl.Patch("admin", &template.UpdateTemplateData{WebhookToken: "new-token"})
+	should.Equal("modified webhook_token", template.ChangeSummary(template.DiffTemplate(old, tpl)))
+	should.NotContains(template.DiffTemplate(old, tpl)[0].To, "new-token")
+
+	tpl.Rollback("admin", rev.Template)
+	should.Equal("new-token", tpl.WebhookToken)
+}
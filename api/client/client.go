@@ -8,6 +8,7 @@ import (
 
 	"github.com/infraboard/workflow/api/apps/action"
 	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/api/apps/secret"
 	"github.com/infraboard/workflow/api/apps/template"
 )
 
@@ -61,3 +62,8 @@ func (c *ClientSet) Action() action.ServiceClient {
 func (c *ClientSet) Template() template.ServiceClient {
 	return template.NewServiceClient(c.conn)
 }
+
+// Secret 运行时解密secret
+func (c *ClientSet) Secret() secret.ServiceClient {
+	return secret.NewServiceClient(c.conn)
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/infraboard/workflow/api/protocol"
 	"github.com/infraboard/workflow/conf"
+	"github.com/infraboard/workflow/node/controller/step/store"
 
 	// 加载所有服务
 	_ "github.com/infraboard/workflow/api/apps/all"
@@ -58,6 +59,11 @@ var serviceCmd = &cobra.Command{
 			return err
 		}
 
+		// 初始化step日志存储, 节点不可用时从存储中读取日志
+		if err := store.Init(conf.C()); err != nil {
+			return err
+		}
+
 		// 初始化全局app
 		if err := app.InitAllApp(); err != nil {
 			return err
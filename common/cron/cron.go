@@ -0,0 +1,232 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// 最多向后查找多少年, 避免类似 0 0 30 2 * 这样永远不会触发的表达式导致死循环
+	MAX_SEARCH_YEARS = 5
+)
+
+var (
+	// 预定义的表达式
+	DESCRIPTORS = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+
+	MONTH_NAMES = map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}
+
+	WEEK_NAMES = map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}
+)
+
+type field struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: MONTH_NAMES}
+	dowField    = field{name: "day of week", min: 0, max: 7, names: WEEK_NAMES}
+)
+
+// Parse 解析标准的5段cron表达式: 分 时 日 月 周
+// 每段支持: *, 数字, 范围 1-5, 步长 */5 1-30/2, 列表 1,3,5, 月和周支持英文缩写
+// 同时支持 @yearly, @monthly, @weekly, @daily, @hourly 等预定义表达式
+func Parse(spec string) (*Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if v, ok := DESCRIPTORS[strings.ToLower(spec)]; ok {
+		spec = v
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %s must have 5 fields, but got %d", spec, len(fields))
+	}
+
+	s := &Schedule{spec: spec}
+	var err error
+	if s.minute, err = parseField(fields[0], minuteField); err != nil {
+		return nil, err
+	}
+	if s.hour, err = parseField(fields[1], hourField); err != nil {
+		return nil, err
+	}
+	if s.dom, err = parseField(fields[2], domField); err != nil {
+		return nil, err
+	}
+	if s.month, err = parseField(fields[3], monthField); err != nil {
+		return nil, err
+	}
+	if s.dow, err = parseField(fields[4], dowField); err != nil {
+		return nil, err
+	}
+	// 周日可以写成0或者7
+	if s.dow&(1<<7) > 0 {
+		s.dow |= 1
+	}
+	s.domStar = fields[2] == "*" || fields[2] == "?"
+	s.dowStar = fields[4] == "*" || fields[4] == "?"
+
+	return s, nil
+}
+
+// Schedule 解析后的cron表达式, 每段使用bitmap表示允许的值
+type Schedule struct {
+	spec    string
+	minute  uint64
+	hour    uint64
+	dom     uint64
+	month   uint64
+	dow     uint64
+	domStar bool
+	dowStar bool
+}
+
+func (s *Schedule) String() string {
+	return s.spec
+}
+
+// Next 返回t之后的下一次触发时间, 精确到分钟, 使用t所在的时区计算
+// 找不到时返回零值
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	yearLimit := t.Year() + MAX_SEARCH_YEARS
+
+	for t.Year() <= yearLimit {
+		if !has(s.month, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !has(s.hour, t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if !has(s.minute, t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+// 日和周都有限制时, 满足其中一个即可, 和crontab的行为保持一致
+func (s *Schedule) matchDay(t time.Time) bool {
+	domMatch := has(s.dom, t.Day())
+	dowMatch := has(s.dow, int(t.Weekday()))
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+func has(bits uint64, v int) bool {
+	return bits&(1<<uint(v)) > 0
+}
+
+func parseField(expr string, f field) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(expr, ",") {
+		b, err := parseRange(item, f)
+		if err != nil {
+			return 0, err
+		}
+		bits |= b
+	}
+	return bits, nil
+}
+
+func parseRange(expr string, f field) (uint64, error) {
+	var (
+		start, end, step int
+		err              error
+	)
+
+	rangeAndStep := strings.Split(expr, "/")
+	if len(rangeAndStep) > 2 {
+		return 0, fmt.Errorf("invalid %s %s", f.name, expr)
+	}
+
+	lowAndHigh := strings.Split(rangeAndStep[0], "-")
+	switch {
+	case rangeAndStep[0] == "*" || rangeAndStep[0] == "?":
+		start, end = f.min, f.max
+	case len(lowAndHigh) == 1:
+		if start, err = parseValue(lowAndHigh[0], f); err != nil {
+			return 0, err
+		}
+		end = start
+		// 5/10 等同于 5-max/10
+		if len(rangeAndStep) == 2 {
+			end = f.max
+		}
+	case len(lowAndHigh) == 2:
+		if start, err = parseValue(lowAndHigh[0], f); err != nil {
+			return 0, err
+		}
+		if end, err = parseValue(lowAndHigh[1], f); err != nil {
+			return 0, err
+		}
+	default:
+		return 0, fmt.Errorf("invalid %s %s", f.name, expr)
+	}
+
+	step = 1
+	if len(rangeAndStep) == 2 {
+		step, err = strconv.Atoi(rangeAndStep[1])
+		if err != nil || step <= 0 {
+			return 0, fmt.Errorf("invalid %s step %s", f.name, rangeAndStep[1])
+		}
+	}
+
+	if start > end {
+		return 0, fmt.Errorf("invalid %s range %s, start %d large than end %d", f.name, expr, start, end)
+	}
+
+	var bits uint64
+	for i := start; i <= end; i += step {
+		bits |= 1 << uint(i)
+	}
+	return bits, nil
+}
+
+func parseValue(v string, f field) (int, error) {
+	if n, ok := f.names[strings.ToLower(v)]; ok {
+		return n, nil
+	}
+
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value %s", f.name, v)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("%s value %d out of range [%d, %d]", f.name, n, f.min, f.max)
+	}
+	return n, nil
+}
@@ -0,0 +1,71 @@
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/workflow/common/cron"
+)
+
+func TestScheduleNext(t *testing.T) {
+	should := assert.New(t)
+
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	should.NoError(err)
+
+	base := time.Date(2021, 10, 15, 10, 30, 20, 0, time.UTC)
+	cases := []struct {
+		spec   string
+		from   time.Time
+		expect time.Time
+	}{
+		{"* * * * *", base, time.Date(2021, 10, 15, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", base, time.Date(2021, 10, 15, 10, 45, 0, 0, time.UTC)},
+		{"0 2 * * *", base, time.Date(2021, 10, 16, 2, 0, 0, 0, time.UTC)},
+		{"@daily", base, time.Date(2021, 10, 16, 0, 0, 0, 0, time.UTC)},
+		{"0 9 * * mon-fri", base, time.Date(2021, 10, 18, 9, 0, 0, 0, time.UTC)},
+		{"0 0 1 jan *", base, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", base, time.Date(2021, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", base, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// 日和周都有限制时满足一个即可
+		{"0 0 20 * 6", base, time.Date(2021, 10, 16, 0, 0, 0, 0, time.UTC)},
+		{"10,40 8-10/2 * * *", base, time.Date(2021, 10, 15, 10, 40, 0, 0, time.UTC)},
+		// 使用时间所在的时区计算
+		{"0 2 * * *", base.In(shanghai), time.Date(2021, 10, 16, 2, 0, 0, 0, shanghai)},
+	}
+
+	for _, c := range cases {
+		s, err := cron.Parse(c.spec)
+		if should.NoError(err, c.spec) {
+			should.True(c.expect.Equal(s.Next(c.from)), "%s expect %s, but %s", c.spec, c.expect, s.Next(c.from))
+		}
+	}
+
+	s, err := cron.Parse("0 0 30 2 *")
+	if should.NoError(err) {
+		should.True(s.Next(base).IsZero())
+	}
+}
+
+func TestParseError(t *testing.T) {
+	should := assert.New(t)
+
+	specs := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"a * * * *",
+		"* * * * 1-2-3",
+	}
+	for _, spec := range specs {
+		_, err := cron.Parse(spec)
+		should.Error(err, spec)
+	}
+}
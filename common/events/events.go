@@ -0,0 +1,215 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/infraboard/mcube/bus/event"
+	"github.com/rs/xid"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+)
+
+const (
+	// CloudEvents规范版本
+	SPEC_VERSION = "1.0"
+	// 事件数据的格式
+	DATA_CONTENT_TYPE = "application/json"
+)
+
+// 事件类型, 同时作为消息总线的topic, 发布后不能修改
+const (
+	// 新创建的pipeline, 由API或者cronjob的leader在创建时发布
+	PIPELINE_CREATED = "workflow.pipeline.created"
+	// pipeline开始执行
+	PIPELINE_STARTED = "workflow.pipeline.started"
+	// pipeline结束, 具体结果见data中的status
+	PIPELINE_COMPLETED = "workflow.pipeline.completed"
+
+	// step已经调度到执行节点
+	STEP_SCHEDULED = "workflow.step.scheduled"
+	// step开始执行
+	STEP_RUNNING = "workflow.step.running"
+	// step执行成功
+	STEP_SUCCEEDED = "workflow.step.succeeded"
+	// step执行失败或者调度失败, data中的retryable表示是否还会重试
+	STEP_FAILED = "workflow.step.failed"
+	// step需要审核, 审核通过后才会调度
+	STEP_AUDIT_REQUESTED = "workflow.step.audit_requested"
+)
+
+// 事件数据的结构版本, 数据结构有不兼容的修改时升级
+const (
+	PIPELINE_DATA_SCHEMA = "workflow/pipeline/v1"
+	STEP_DATA_SCHEMA     = "workflow/step/v1"
+)
+
+// CloudEvent 生命周期事件, 字段参考CloudEvents规范, 以JSON格式放在总线事件的body中
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	Id              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject"`
+	Time            string          `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	DataSchema      string          `json:"dataschema"`
+	Domain          string          `json:"domain,omitempty"`
+	Namespace       string          `json:"namespace,omitempty"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// PipelineData pipeline事件的数据
+type PipelineData struct {
+	Id               string `json:"id"`
+	Name             string `json:"name"`
+	Domain           string `json:"domain"`
+	Namespace        string `json:"namespace"`
+	CreateBy         string `json:"create_by"`
+	TemplateId       string `json:"template_id,omitempty"`
+	TemplateRevision int64  `json:"template_revision,omitempty"`
+	CronjobId        string `json:"cronjob_id,omitempty"`
+	RerunFrom        string `json:"rerun_from,omitempty"`
+	Status           string `json:"status"`
+	SchedulerNode    string `json:"scheduler_node"`
+	Message          string `json:"message"`
+	StartAt          int64  `json:"start_at"`
+	EndAt            int64  `json:"end_at"`
+}
+
+// StepData step事件的数据
+type StepData struct {
+	Key           string `json:"key"`
+	Name          string `json:"name"`
+	Namespace     string `json:"namespace"`
+	PipelineId    string `json:"pipeline_id"`
+	Action        string `json:"action"`
+	Status        string `json:"status"`
+	ScheduledNode string `json:"scheduled_node"`
+	Attempt       int32  `json:"attempt"`
+	Retryable     bool   `json:"retryable"`
+	WithAudit     bool   `json:"with_audit"`
+	Message       string `json:"message"`
+	StartAt       int64  `json:"start_at"`
+	EndAt         int64  `json:"end_at"`
+}
+
+// NewPipelineEvent pipeline事件, subject为pipeline id
+func NewPipelineEvent(source, eventType string, p *pipeline.Pipeline) (*CloudEvent, error) {
+	data := &PipelineData{
+		Id:               p.Id,
+		Name:             p.Name,
+		Domain:           p.Domain,
+		Namespace:        p.Namespace,
+		CreateBy:         p.CreateBy,
+		TemplateId:       p.TemplateId,
+		TemplateRevision: p.TemplateRevision,
+		CronjobId:        p.CronjobId,
+		RerunFrom:        p.RerunFrom,
+	}
+	if p.Status != nil {
+		data.Status = p.Status.Status.String()
+		data.SchedulerNode = p.Status.SchedulerNode
+		data.Message = p.Status.Message
+		data.StartAt = p.Status.StartAt
+		data.EndAt = p.Status.EndAt
+	}
+
+	e, err := newCloudEvent(source, eventType, p.Id, PIPELINE_DATA_SCHEMA, data)
+	if err != nil {
+		return nil, err
+	}
+	e.Domain, e.Namespace = p.Domain, p.Namespace
+	return e, nil
+}
+
+// NewStepEvent step事件, subject为step key
+func NewStepEvent(source, eventType string, s *pipeline.Step) (*CloudEvent, error) {
+	data := &StepData{
+		Key:        s.Key,
+		Name:       s.Name,
+		Namespace:  s.Namespace,
+		PipelineId: s.PipelineId,
+		Action:     s.Action,
+		Attempt:    s.Attempt(),
+		Retryable:  s.IsRetryable(),
+		WithAudit:  s.WithAudit,
+	}
+	if s.Status != nil {
+		data.Status = s.Status.Status.String()
+		data.ScheduledNode = s.Status.ScheduledNode
+		data.Message = s.Status.Message
+		data.StartAt = s.Status.StartAt
+		data.EndAt = s.Status.EndAt
+	}
+
+	e, err := newCloudEvent(source, eventType, s.Key, STEP_DATA_SCHEMA, data)
+	if err != nil {
+		return nil, err
+	}
+	e.Namespace = s.Namespace
+	return e, nil
+}
+
+func newCloudEvent(source, eventType, subject, schema string, data interface{}) (*CloudEvent, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s event data error, %s", eventType, err)
+	}
+
+	return &CloudEvent{
+		SpecVersion:     SPEC_VERSION,
+		Id:              xid.New().String(),
+		Source:          source,
+		Type:            eventType,
+		Subject:         subject,
+		Time:            time.Now().UTC().Format(time.RFC3339Nano),
+		DataContentType: DATA_CONTENT_TYPE,
+		DataSchema:      schema,
+		Data:            raw,
+	}, nil
+}
+
+// ToBusEvent 转换为总线事件, body为整个CloudEvent, 常用属性同时放到header meta中方便过滤
+func (e *CloudEvent) ToBusEvent() (*event.Event, error) {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s event error, %s", e.Type, err)
+	}
+
+	be := event.NewDefaultEvent()
+	be.Id = e.Id
+	be.Type = event.Type_STATUS
+	be.Header.Source = e.Source
+	be.Header.ContentType = event.ContentType_JSON
+	be.SetLevel(event.Level_INFO)
+	be.SetMeta("specversion", e.SpecVersion)
+	be.SetMeta("type", e.Type)
+	be.SetMeta("subject", e.Subject)
+	be.SetMeta("dataschema", e.DataSchema)
+	if e.Namespace != "" {
+		be.SetMeta("namespace", e.Namespace)
+	}
+	be.Body = &anypb.Any{TypeUrl: e.DataSchema, Value: body}
+	return be, nil
+}
+
+// ParseBusEvent 从总线事件中解析CloudEvent
+func ParseBusEvent(be *event.Event) (*CloudEvent, error) {
+	if be.Body == nil {
+		return nil, fmt.Errorf("event %s body is nil", be.Id)
+	}
+
+	e := &CloudEvent{}
+	if err := json.Unmarshal(be.Body.Value, e); err != nil {
+		return nil, fmt.Errorf("unmarshal event %s error, %s", be.Id, err)
+	}
+	return e, nil
+}
+
+// ParseData 解析事件数据, pipeline事件为*PipelineData, step事件为*StepData
+func (e *CloudEvent) ParseData(v interface{}) error {
+	return json.Unmarshal(e.Data, v)
+}
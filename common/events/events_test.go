@@ -0,0 +1,122 @@
+package events_test
+
+import (
+	"testing"
+
+	"github.com/infraboard/mcube/bus/event"
+	"github.com/infraboard/mcube/logger/zap"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/common/events"
+)
+
+func newTestStep(status pipeline.STEP_STATUS, node string) *pipeline.Step {
+	return &pipeline.Step{
+		Key:        "p01.1.1",
+		Name:       "build",
+		Namespace:  "ns01",
+		PipelineId: "p01",
+		Action:     "build@v1.0.0",
+		Status:     &pipeline.StepStatus{Status: status, ScheduledNode: node},
+	}
+}
+
+func newTestPipeline(status pipeline.PIPELINE_STATUS, node string) *pipeline.Pipeline {
+	return &pipeline.Pipeline{
+		Id:        "p01",
+		Name:      "test",
+		Domain:    "default",
+		Namespace: "ns01",
+		Status:    &pipeline.PipelineStatus{Status: status, SchedulerNode: node},
+	}
+}
+
+func TestPipelineEvents(t *testing.T) {
+	should := assert.New(t)
+
+	waiting := newTestPipeline(pipeline.PIPELINE_STATUS_WAITTING, "")
+	scheduled := newTestPipeline(pipeline.PIPELINE_STATUS_WAITTING, "scheduler-01")
+	running := newTestPipeline(pipeline.PIPELINE_STATUS_EXECUTING, "scheduler-01")
+	failed := newTestPipeline(pipeline.PIPELINE_STATUS_FAILED, "scheduler-01")
+
+	should.Equal([]string{events.PIPELINE_CREATED}, events.PipelineEvents(nil, waiting))
+	// 调度器重启后重新加载的pipeline不是新创建的
+	should.Empty(events.PipelineEvents(nil, running))
+	should.Empty(events.PipelineEvents(waiting, scheduled))
+	should.Equal([]string{events.PIPELINE_STARTED}, events.PipelineEvents(scheduled, running))
+	should.Empty(events.PipelineEvents(running, running))
+	should.Equal([]string{events.PIPELINE_COMPLETED}, events.PipelineEvents(running, failed))
+}
+
+func TestStepEvents(t *testing.T) {
+	should := assert.New(t)
+
+	pending := newTestStep(pipeline.STEP_STATUS_PENDDING, "")
+	scheduled := newTestStep(pipeline.STEP_STATUS_PENDDING, "node-01")
+	running := newTestStep(pipeline.STEP_STATUS_RUNNING, "node-01")
+	succeeded := newTestStep(pipeline.STEP_STATUS_SUCCEEDED, "node-01")
+	failed := newTestStep(pipeline.STEP_STATUS_FAILED, "node-01")
+	scheduleFailed := newTestStep(pipeline.STEP_STATUS_SCHEDULE_FAILED, "")
+	auditing := newTestStep(pipeline.STEP_STATUS_PENDDING, "")
+	auditing.MarkSendAuditNotify()
+
+	should.Equal([]string{events.STEP_SCHEDULED}, events.StepEvents(pending, scheduled))
+	should.Equal([]string{events.STEP_RUNNING}, events.StepEvents(scheduled, running))
+	should.Empty(events.StepEvents(running, running))
+	should.Equal([]string{events.STEP_SUCCEEDED}, events.StepEvents(running, succeeded))
+	should.Equal([]string{events.STEP_FAILED}, events.StepEvents(running, failed))
+	should.Equal([]string{events.STEP_FAILED}, events.StepEvents(pending, scheduleFailed))
+	should.Equal([]string{events.STEP_AUDIT_REQUESTED}, events.StepEvents(pending, auditing))
+	should.Empty(events.StepEvents(nil, running))
+}
+
+func TestPublishToMemoryBroker(t *testing.T) {
+	should := assert.New(t)
+
+	broker := events.NewMemoryBroker()
+	topics := []string{}
+	received := []*events.CloudEvent{}
+	err := broker.Sub("workflow.step.>", func(topic string, be *event.Event) error {
+		e, err := events.ParseBusEvent(be)
+		if err != nil {
+			return err
+		}
+		topics = append(topics, topic)
+		received = append(received, e)
+		return nil
+	})
+	should.NoError(err)
+
+	p := events.NewBusPublisher("scheduler-01")
+	p.SetBus(broker)
+	p.PublishPipeline(nil, newTestPipeline(pipeline.PIPELINE_STATUS_WAITTING, ""))
+	p.PublishStep(
+		newTestStep(pipeline.STEP_STATUS_PENDDING, ""),
+		newTestStep(pipeline.STEP_STATUS_RUNNING, "node-01"),
+	)
+
+	// pipeline事件不匹配订阅的topic
+	should.Equal([]string{events.STEP_SCHEDULED, events.STEP_RUNNING}, topics)
+	if should.Len(received, 2) {
+		e := received[1]
+		should.Equal(events.SPEC_VERSION, e.SpecVersion)
+		should.Equal(events.STEP_RUNNING, e.Type)
+		should.Equal("scheduler-01", e.Source)
+		should.Equal("p01.1.1", e.Subject)
+		should.Equal(events.STEP_DATA_SCHEMA, e.DataSchema)
+		should.Equal("ns01", e.Namespace)
+
+		data := &events.StepData{}
+		if should.NoError(e.ParseData(data)) {
+			should.Equal("p01", data.PipelineId)
+			should.Equal("RUNNING", data.Status)
+			should.Equal("node-01", data.ScheduledNode)
+			should.Equal(int32(1), data.Attempt)
+		}
+	}
+}
+
+func init() {
+	zap.DevelopmentSetup()
+}
@@ -0,0 +1,62 @@
+package events
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/infraboard/mcube/bus"
+	"github.com/infraboard/mcube/bus/event"
+)
+
+// NewMemoryBroker 进程内的总线, 用于测试或者单进程部署时订阅事件
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{
+		handlers: map[string][]bus.EventHandler{},
+	}
+}
+
+// MemoryBroker 同步调用订阅者, topic支持NATS风格的通配符, * 匹配一级, > 匹配剩余所有级
+type MemoryBroker struct {
+	lock     sync.RWMutex
+	handlers map[string][]bus.EventHandler
+}
+
+func (b *MemoryBroker) Pub(topic string, e *event.Event) error {
+	// 订阅者中可能继续订阅或者发布, 调用时不持有锁
+	matched := []bus.EventHandler{}
+	b.lock.RLock()
+	for pattern, handlers := range b.handlers {
+		if matchTopic(pattern, topic) {
+			matched = append(matched, handlers...)
+		}
+	}
+	b.lock.RUnlock()
+
+	for _, h := range matched {
+		if err := h(topic, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *MemoryBroker) Sub(topic string, h bus.EventHandler) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.handlers[topic] = append(b.handlers[topic], h)
+	return nil
+}
+
+func matchTopic(pattern, topic string) bool {
+	ps, ts := strings.Split(pattern, "."), strings.Split(topic, ".")
+	for i, p := range ps {
+		if p == ">" {
+			return len(ts) > i
+		}
+		if i >= len(ts) || (p != "*" && p != ts[i]) {
+			return false
+		}
+	}
+	return len(ps) == len(ts)
+}
@@ -0,0 +1,79 @@
+package events
+
+import (
+	"github.com/infraboard/mcube/bus"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+)
+
+// Publisher pipeline和step状态变化时, 发布生命周期事件, 发布失败不影响调度
+type Publisher interface {
+	PublishPipeline(old, new *pipeline.Pipeline)
+	PublishStep(old, new *pipeline.Step)
+}
+
+// NewBusPublisher 发布到消息总线, source为事件来源, 比如调度器的实例名称
+func NewBusPublisher(source string) *BusPublisher {
+	return &BusPublisher{
+		source: source,
+		log:    zap.L().Named("Events"),
+	}
+}
+
+// BusPublisher 未设置总线时使用全局总线, 全局总线也没有配置时不发布
+type BusPublisher struct {
+	source string
+	bus    bus.Publisher
+	log    logger.Logger
+}
+
+// SetBus 设置使用的总线, 比如测试时使用进程内的总线
+func (p *BusPublisher) SetBus(b bus.Publisher) {
+	p.bus = b
+}
+
+func (p *BusPublisher) PublishPipeline(old, new *pipeline.Pipeline) {
+	for _, t := range PipelineEvents(old, new) {
+		e, err := NewPipelineEvent(p.source, t, new)
+		if err != nil {
+			p.log.Errorf("new pipeline %s event error, %s", new.ShortDescribe(), err)
+			continue
+		}
+		p.publish(e)
+	}
+}
+
+func (p *BusPublisher) PublishStep(old, new *pipeline.Step) {
+	for _, t := range StepEvents(old, new) {
+		e, err := NewStepEvent(p.source, t, new)
+		if err != nil {
+			p.log.Errorf("new step %s event error, %s", new.Key, err)
+			continue
+		}
+		p.publish(e)
+	}
+}
+
+func (p *BusPublisher) publish(e *CloudEvent) {
+	b := p.bus
+	if b == nil {
+		b = bus.P()
+	}
+	if b == nil {
+		p.log.Debugf("bus not config, skip publish %s event %s", e.Type, e.Subject)
+		return
+	}
+
+	be, err := e.ToBusEvent()
+	if err != nil {
+		p.log.Errorf("convert %s event %s error, %s", e.Type, e.Subject, err)
+		return
+	}
+	if err := b.Pub(e.Type, be); err != nil {
+		p.log.Errorf("publish %s event %s error, %s", e.Type, e.Subject, err)
+		return
+	}
+	p.log.Debugf("publish %s event %s success", e.Type, e.Subject)
+}
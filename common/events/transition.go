@@ -0,0 +1,61 @@
+package events
+
+import (
+	"github.com/infraboard/workflow/api/apps/pipeline"
+)
+
+// PipelineEvents 根据pipeline变化前后的状态, 判断需要发布的事件, old为nil表示新增
+func PipelineEvents(old, new *pipeline.Pipeline) []string {
+	types := []string{}
+	if new == nil || new.Status == nil {
+		return types
+	}
+
+	// 新增时只有还没调度的才是新创建的, 其他的是调度器重启后重新加载的
+	if old == nil {
+		if !new.IsScheduled() && !new.IsRunning() && !new.IsComplete() {
+			types = append(types, PIPELINE_CREATED)
+		}
+		return types
+	}
+	if old.Status == nil {
+		old = &pipeline.Pipeline{Status: pipeline.NewDefaultPipelineStatus()}
+	}
+
+	if !old.IsRunning() && new.IsRunning() {
+		types = append(types, PIPELINE_STARTED)
+	}
+	if !old.IsComplete() && new.IsComplete() {
+		types = append(types, PIPELINE_COMPLETED)
+	}
+	return types
+}
+
+// StepEvents 根据step变化前后的状态, 判断需要发布的事件
+func StepEvents(old, new *pipeline.Step) []string {
+	types := []string{}
+	if old == nil || old.Status == nil || new == nil || new.Status == nil {
+		return types
+	}
+
+	if !old.IsScheduled() && new.IsScheduled() {
+		types = append(types, STEP_SCHEDULED)
+	}
+	if !old.HasSendAuditNotify() && new.HasSendAuditNotify() {
+		types = append(types, STEP_AUDIT_REQUESTED)
+	}
+
+	// 重试时状态会回到PENDDING, 每次执行都会有对应的事件
+	if old.Status.Status.Equal(new.Status.Status) && old.Attempt() == new.Attempt() {
+		return types
+	}
+	switch new.Status.Status {
+	case pipeline.STEP_STATUS_RUNNING:
+		types = append(types, STEP_RUNNING)
+	case pipeline.STEP_STATUS_SUCCEEDED:
+		types = append(types, STEP_SUCCEEDED)
+	case pipeline.STEP_STATUS_FAILED, pipeline.STEP_STATUS_SCHEDULE_FAILED:
+		types = append(types, STEP_FAILED)
+	}
+	return types
+}
@@ -0,0 +1,37 @@
+package etcd
+
+import (
+	"context"
+
+	"github.com/infraboard/mcube/logger"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/infraboard/workflow/api/apps/cronjob"
+)
+
+type lister struct {
+	log    logger.Logger
+	client clientv3.KV
+}
+
+func (l *lister) List(ctx context.Context) (*cronjob.CronJobSet, error) {
+	listKey := cronjob.EtcdCronJobPrefix()
+	l.log.Infof("list etcd cronjob resource key: %s", listKey)
+	resp, err := l.client.Get(ctx, listKey, clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	set := cronjob.NewCronJobSet()
+	for i := range resp.Kvs {
+		// 解析对象
+		ins, err := cronjob.LoadCronJobFromBytes(resp.Kvs[i].Value)
+		if err != nil {
+			l.log.Errorf("load cronjob [key: %s, value: %s] error, %s", resp.Kvs[i].Key, string(resp.Kvs[i].Value), err)
+			continue
+		}
+
+		ins.ResourceVersion = resp.Kvs[i].ModRevision
+		set.Add(ins)
+	}
+	return set, nil
+}
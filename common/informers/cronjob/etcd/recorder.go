@@ -0,0 +1,46 @@
+package etcd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/infraboard/mcube/logger"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/infraboard/workflow/api/apps/cronjob"
+)
+
+type recorder struct {
+	log    logger.Logger
+	client clientv3.KV
+}
+
+// Update 只有版本没有变化时才更新, 避免覆盖用户的修改或者把已经删除的cronjob重新写回
+func (l *recorder) Update(c *cronjob.CronJob) error {
+	if c == nil {
+		return fmt.Errorf("update nil cronjob")
+	}
+
+	if l.client == nil {
+		return fmt.Errorf("etcd client is nil")
+	}
+
+	objKey := c.MakeObjectKey()
+	objValue, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	resp, err := l.client.Txn(context.Background()).
+		If(clientv3.Compare(clientv3.ModRevision(objKey), "=", c.ResourceVersion)).
+		Then(clientv3.OpPut(objKey, string(objValue))).
+		Commit()
+	if err != nil {
+		return fmt.Errorf("update cronjob '%s' to etcd3 failed: %s", objKey, err.Error())
+	}
+	if !resp.Succeeded {
+		return fmt.Errorf("update cronjob '%s' conflict, resource version %d changed", objKey, c.ResourceVersion)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package etcd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/infraboard/mcube/logger"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/infraboard/workflow/api/apps/cronjob"
+	"github.com/infraboard/workflow/common/cache"
+	informer "github.com/infraboard/workflow/common/informers/cronjob"
+)
+
+type shared struct {
+	log       logger.Logger
+	client    clientv3.Watcher
+	kv        clientv3.KV
+	indexer   cache.Indexer
+	handler   informer.CronJobEventHandler
+	watchChan clientv3.WatchChan
+}
+
+// AddCronJobEventHandler 添加事件处理回调
+func (i *shared) AddCronJobEventHandler(h informer.CronJobEventHandler) {
+	i.handler = h
+}
+
+// Run 启动 Watch
+func (i *shared) Run(ctx context.Context) error {
+	// 是否准备完成
+	if err := i.isReady(); err != nil {
+		return err
+	}
+
+	// 先加载已有的cronjob, 再从该版本之后开始监听
+	rev, err := i.list(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 监听事件
+	i.watch(ctx, rev)
+
+	// 后台处理事件
+	go i.dealEvents()
+	return nil
+}
+
+func (i *shared) dealEvents() {
+	// 处理所有事件
+	for resp := range i.watchChan {
+		for _, event := range resp.Events {
+			switch event.Type {
+			case mvccpb.PUT:
+				if err := i.handlePut(event); err != nil {
+					i.log.Error(err)
+				}
+			case mvccpb.DELETE:
+				if err := i.handleDelete(event); err != nil {
+					i.log.Error(err)
+				}
+			default:
+			}
+		}
+	}
+}
+
+func (i *shared) isReady() error {
+	if i.handler == nil {
+		return errors.New("CronJobEventHandler not add")
+	}
+	return nil
+}
+
+func (i *shared) list(ctx context.Context) (int64, error) {
+	listKey := cronjob.EtcdCronJobPrefix()
+	resp, err := i.kv.Get(ctx, listKey, clientv3.WithPrefix())
+	if err != nil {
+		return 0, err
+	}
+
+	for index := range resp.Kvs {
+		ins, err := cronjob.LoadCronJobFromBytes(resp.Kvs[index].Value)
+		if err != nil {
+			i.log.Errorf("load cronjob [key: %s] error, %s", resp.Kvs[index].Key, err)
+			continue
+		}
+		ins.ResourceVersion = resp.Kvs[index].ModRevision
+		if err := i.indexer.Add(ins); err != nil {
+			i.log.Errorf("add indexer cache error, %s", err)
+			continue
+		}
+		i.handler.OnAdd(ins)
+	}
+	i.log.Infof("load %d cronjob from etcd", len(resp.Kvs))
+	return resp.Header.Revision, nil
+}
+
+func (i *shared) watch(ctx context.Context, rev int64) {
+	watchKey := cronjob.EtcdCronJobPrefix()
+	i.watchChan = i.client.Watch(ctx, watchKey, clientv3.WithPrefix(), clientv3.WithRev(rev+1))
+	i.log.Infof("watch etcd cronjob resource key: %s, from revision: %d", watchKey, rev+1)
+}
+
+func (i *shared) handlePut(event *clientv3.Event) error {
+	i.log.Debugf("receive cronjob put event, %s", event.Kv.Key)
+
+	// 解析对象
+	new, err := cronjob.LoadCronJobFromBytes(event.Kv.Value)
+	if err != nil {
+		return err
+	}
+	new.ResourceVersion = event.Kv.ModRevision
+
+	old, hasOld, err := i.indexer.GetByKey(new.MakeObjectKey())
+	if err != nil {
+		return err
+	}
+
+	// 区分Update
+	if hasOld {
+		// 更新缓存
+		i.log.Debugf("update cronjob: %s", new.ShortDescribe())
+		if err := i.indexer.Update(new); err != nil {
+			i.log.Errorf("update indexer cache error, %s", err)
+		}
+		i.handler.OnUpdate(old.(*cronjob.CronJob), new)
+	} else {
+		// 添加缓存
+		i.log.Debugf("add cronjob: %s", new.ShortDescribe())
+		if err := i.indexer.Add(new); err != nil {
+			i.log.Errorf("add indexer cache error, %s", err)
+		}
+		i.handler.OnAdd(new)
+	}
+
+	return nil
+}
+
+func (i *shared) handleDelete(event *clientv3.Event) error {
+	key := string(event.Kv.Key)
+	i.log.Debugf("receive cronjob delete event, %s", key)
+
+	obj, ok, err := i.indexer.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		i.log.Warnf("key %s not found in store", key)
+		return nil
+	}
+
+	// 清除缓存
+	if err := i.indexer.Delete(obj); err != nil {
+		i.log.Errorf("delete indexer cache error, %s", err)
+	}
+
+	if c, ok := obj.(*cronjob.CronJob); ok {
+		i.handler.OnDelete(c)
+	}
+	return nil
+}
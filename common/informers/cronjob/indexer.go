@@ -0,0 +1,36 @@
+package cronjob
+
+import (
+	"github.com/infraboard/workflow/api/apps/cronjob"
+	"github.com/infraboard/workflow/common/cache"
+)
+
+// DefaultStoreIndexFunc todo
+func DefaultStoreIndexFunc(obj interface{}) ([]string, error) {
+	return []string{obj.(*cronjob.CronJob).MakeObjectKey()}, nil
+}
+
+// DefaultStoreIndexers todo
+func DefaultStoreIndexers() cache.Indexers {
+	indexers := cache.Indexers{}
+	indexers["by_val"] = DefaultStoreIndexFunc
+	return indexers
+}
+
+// ExplicitKey can be passed to MetaNamespaceKeyFunc if you have the key for
+// the object but not the object itself.
+type ExplicitKey string
+
+// MetaNamespaceKeyFunc 使用etcd的key作为缓存的key
+func MetaNamespaceKeyFunc(obj interface{}) (string, error) {
+	if key, ok := obj.(ExplicitKey); ok {
+		return string(key), nil
+	}
+
+	c, ok := obj.(*cronjob.CronJob)
+	if ok {
+		return c.MakeObjectKey(), nil
+	}
+
+	return "", nil
+}
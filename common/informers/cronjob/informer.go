@@ -0,0 +1,78 @@
+package cronjob
+
+import (
+	"context"
+
+	"github.com/infraboard/workflow/api/apps/cronjob"
+	"github.com/infraboard/workflow/common/cache"
+)
+
+// Informer 负责事件通知
+type Informer interface {
+	Watcher() Watcher
+	Lister() Lister
+	Recorder() Recorder
+	GetStore() cache.Store
+}
+
+type Lister interface {
+	List(ctx context.Context) (*cronjob.CronJobSet, error)
+}
+
+type Recorder interface {
+	// Update 更新cronjob的状态
+	Update(*cronjob.CronJob) error
+}
+
+// Watcher 负责事件通知
+type Watcher interface {
+	// Run starts and runs the shared informer, returning after it stops.
+	// The informer will be stopped when stopCh is closed.
+	Run(ctx context.Context) error
+	// AddEventHandler adds an event handler to the shared informer using the shared informer's resync
+	// period.  Events to a single handler are delivered sequentially, but there is no coordination
+	// between different handlers.
+	AddCronJobEventHandler(handler CronJobEventHandler)
+}
+
+// CronJobEventHandler can handle notifications for events that happen to a
+// resource. The events are informational only, so you can't return an
+// error.
+//  * OnAdd is called when an object is added.
+//  * OnUpdate is called when an object is modified.
+//  * OnDelete will get the final state of the item if it is known.
+type CronJobEventHandler interface {
+	OnAdd(obj *cronjob.CronJob)
+	OnUpdate(old, new *cronjob.CronJob)
+	OnDelete(obj *cronjob.CronJob)
+}
+
+// CronJobEventHandlerFuncs is an adaptor to let you easily specify as many or
+// as few of the notification functions as you want while still implementing
+// ResourceEventHandler.
+type CronJobEventHandlerFuncs struct {
+	AddFunc    func(obj *cronjob.CronJob)
+	UpdateFunc func(oldObj, newObj *cronjob.CronJob)
+	DeleteFunc func(obj *cronjob.CronJob)
+}
+
+// OnAdd calls AddFunc if it's not nil.
+func (r CronJobEventHandlerFuncs) OnAdd(obj *cronjob.CronJob) {
+	if r.AddFunc != nil {
+		r.AddFunc(obj)
+	}
+}
+
+// OnUpdate calls UpdateFunc if it's not nil.
+func (r CronJobEventHandlerFuncs) OnUpdate(oldObj, newObj *cronjob.CronJob) {
+	if r.UpdateFunc != nil {
+		r.UpdateFunc(oldObj, newObj)
+	}
+}
+
+// OnDelete calls DeleteFunc if it's not nil.
+func (r CronJobEventHandlerFuncs) OnDelete(obj *cronjob.CronJob) {
+	if r.DeleteFunc != nil {
+		r.DeleteFunc(obj)
+	}
+}
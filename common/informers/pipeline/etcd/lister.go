@@ -30,7 +30,7 @@ func (l *lister) List(ctx context.Context, opts *pipeline.QueryPipelineOptions)
 			continue
 		}
 
-		pt.ResourceVersion = resp.Header.Revision
+		pt.ResourceVersion = resp.Kvs[i].ModRevision
 		ps.Add(pt)
 	}
 	return ps, nil
@@ -6,32 +6,134 @@ import (
 	"fmt"
 
 	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
 	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"github.com/infraboard/workflow/api/apps/pipeline"
+	informer "github.com/infraboard/workflow/common/informers/pipeline"
 )
 
+const (
+	// 版本冲突时最多重试的次数
+	MODIFY_MAX_RETRY = 5
+)
+
+// NewRecorder 直接使用etcd的KV接口, 方便单独使用或者测试
+func NewRecorder(client clientv3.KV) informer.Recorder {
+	return &recorder{
+		log:    zap.L().Named("Pipeline.Recorder"),
+		client: client,
+	}
+}
+
 type recorder struct {
 	log    logger.Logger
 	client clientv3.KV
 }
 
+// Update 只有版本没有变化时才更新, 避免把缓存中过期的pipeline写回, 覆盖API的取消或者重试
 func (l *recorder) Update(t *pipeline.Pipeline) error {
+	ok, err := l.put(t)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("update pipeline '%s' conflict, resource version %d changed", t.EtcdObjectKey(), t.ResourceVersion)
+	}
+	return nil
+}
+
+func (l *recorder) Modify(t *pipeline.Pipeline, fn informer.ModifyFunc) error {
 	if t == nil {
-		return fmt.Errorf("update nil pipeline")
+		return fmt.Errorf("modify nil pipeline")
+	}
+
+	objKey := t.EtcdObjectKey()
+	for i := 0; i < MODIFY_MAX_RETRY; i++ {
+		changed, err := fn(t)
+		if err != nil || !changed {
+			return err
+		}
+
+		ok, err := l.put(t)
+		if err != nil || ok {
+			return err
+		}
+
+		// 版本冲突, 读取最新的pipeline后重新修改
+		l.log.Debugf("modify pipeline '%s' conflict, resource version %d changed, retry", objKey, t.ResourceVersion)
+		if t, err = l.get(objKey); err != nil {
+			return err
+		}
+	}
+	return fmt.Errorf("modify pipeline '%s' conflict, retry %d times", objKey, MODIFY_MAX_RETRY)
+}
+
+// put 版本一致时写入, 成功后更新版本, 版本冲突时返回false
+func (l *recorder) put(t *pipeline.Pipeline) (bool, error) {
+	if t == nil {
+		return false, fmt.Errorf("update nil pipeline")
 	}
 
 	if l.client == nil {
-		return fmt.Errorf("etcd client is nil")
+		return false, fmt.Errorf("etcd client is nil")
 	}
 
 	objKey := t.EtcdObjectKey()
 	objValue, err := json.Marshal(t)
 	if err != nil {
-		return err
+		return false, err
 	}
-	if _, err := l.client.Put(context.Background(), objKey, string(objValue)); err != nil {
-		return fmt.Errorf("update pipeline task '%s' to etcd3 failed: %s", objKey, err.Error())
+
+	resp, err := l.client.Txn(context.Background()).
+		If(clientv3.Compare(clientv3.ModRevision(objKey), "=", t.ResourceVersion)).
+		Then(clientv3.OpPut(objKey, string(objValue))).
+		Commit()
+	if err != nil {
+		return false, fmt.Errorf("update pipeline task '%s' to etcd3 failed: %s", objKey, err.Error())
+	}
+	if !resp.Succeeded {
+		return false, nil
+	}
+	t.ResourceVersion = resp.Header.Revision
+	return true, nil
+}
+
+func (l *recorder) get(objKey string) (*pipeline.Pipeline, error) {
+	resp, err := l.client.Get(context.Background(), objKey)
+	if err != nil {
+		return nil, fmt.Errorf("get pipeline '%s' from etcd3 failed: %s", objKey, err.Error())
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, fmt.Errorf("pipeline '%s' not found, may be deleted", objKey)
+	}
+
+	t, err := pipeline.LoadPipelineFromBytes(resp.Kvs[0].Value)
+	if err != nil {
+		return nil, err
+	}
+	t.ResourceVersion = resp.Kvs[0].ModRevision
+	return t, nil
+}
+
+func (l *recorder) Delete(t *pipeline.Pipeline) error {
+	if t == nil {
+		return fmt.Errorf("delete nil pipeline")
+	}
+
+	if l.client == nil {
+		return fmt.Errorf("etcd client is nil")
+	}
+
+	// 先删除pipeline对应的step
+	stepKey := pipeline.StepObjectKey(t.StepPrefix())
+	if _, err := l.client.Delete(context.Background(), stepKey, clientv3.WithPrefix()); err != nil {
+		return fmt.Errorf("delete pipeline steps '%s' from etcd3 failed: %s", stepKey, err.Error())
+	}
+
+	objKey := t.EtcdObjectKey()
+	if _, err := l.client.Delete(context.Background(), objKey); err != nil {
+		return fmt.Errorf("delete pipeline '%s' from etcd3 failed: %s", objKey, err.Error())
 	}
 	return nil
 }
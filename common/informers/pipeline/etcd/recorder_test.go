@@ -0,0 +1,172 @@
+package etcd_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/infraboard/mcube/logger/zap"
+	"github.com/stretchr/testify/assert"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/common/informers/pipeline/etcd"
+	"github.com/infraboard/workflow/conf"
+)
+
+// memoryKV 进程内的KV, 只实现recorder使用的Get和Txn
+type memoryKV struct {
+	clientv3.KV
+	rev int64
+	kvs map[string]*mvccpb.KeyValue
+}
+
+func newMemoryKV() *memoryKV {
+	return &memoryKV{kvs: map[string]*mvccpb.KeyValue{}}
+}
+
+func (m *memoryKV) put(key, value string) {
+	m.rev++
+	m.kvs[key] = &mvccpb.KeyValue{Key: []byte(key), Value: []byte(value), ModRevision: m.rev}
+}
+
+func (m *memoryKV) Get(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	resp := &clientv3.GetResponse{Header: &pb.ResponseHeader{Revision: m.rev}}
+	if kv, ok := m.kvs[key]; ok {
+		resp.Kvs = append(resp.Kvs, kv)
+	}
+	return resp, nil
+}
+
+func (m *memoryKV) Txn(ctx context.Context) clientv3.Txn {
+	return &memoryTxn{kv: m}
+}
+
+type memoryTxn struct {
+	kv   *memoryKV
+	cmps []clientv3.Cmp
+	then []clientv3.Op
+}
+
+func (t *memoryTxn) If(cs ...clientv3.Cmp) clientv3.Txn {
+	t.cmps = append(t.cmps, cs...)
+	return t
+}
+
+func (t *memoryTxn) Then(ops ...clientv3.Op) clientv3.Txn {
+	t.then = append(t.then, ops...)
+	return t
+}
+
+func (t *memoryTxn) Else(ops ...clientv3.Op) clientv3.Txn {
+	return t
+}
+
+func (t *memoryTxn) Commit() (*clientv3.TxnResponse, error) {
+	succeeded := true
+	for _, c := range t.cmps {
+		var current int64
+		if kv, ok := t.kv.kvs[string(c.Key)]; ok {
+			current = kv.ModRevision
+		}
+		if current != c.TargetUnion.(*pb.Compare_ModRevision).ModRevision {
+			succeeded = false
+		}
+	}
+	if succeeded {
+		for _, op := range t.then {
+			t.kv.put(string(op.KeyBytes()), string(op.ValueBytes()))
+		}
+	}
+	return &clientv3.TxnResponse{Header: &pb.ResponseHeader{Revision: t.kv.rev}, Succeeded: succeeded}, nil
+}
+
+func newTestPipeline() *pipeline.Pipeline {
+	p := pipeline.NewDefaultPipeline()
+	p.Id = "p01"
+	p.Name = "test"
+	p.Domain = "default"
+	p.Namespace = "ns01"
+	p.CreateBy = "admin"
+
+	stage := pipeline.NewDefaultStage()
+	stage.Name = "build"
+	s := pipeline.NewDefaultStep()
+	s.Name = "build"
+	s.Action = "build@v1.0.0"
+	stage.AddStep(s)
+	p.AddStage(stage)
+
+	p.SetScheduleNode("scheduler-01")
+	p.Run()
+	return p
+}
+
+func load(t *testing.T, kv *memoryKV, key string) *pipeline.Pipeline {
+	resp, _ := kv.Get(context.Background(), key)
+	if len(resp.Kvs) == 0 {
+		t.Fatalf("pipeline %s not found", key)
+	}
+	p, err := pipeline.LoadPipelineFromBytes(resp.Kvs[0].Value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.ResourceVersion = resp.Kvs[0].ModRevision
+	return p
+}
+
+func TestRecorderModifyAfterCancel(t *testing.T) {
+	should := assert.New(t)
+
+	kv := newMemoryKV()
+	r := etcd.NewRecorder(kv)
+	should.NoError(r.Update(newTestPipeline()))
+
+	// 调度器缓存中的pipeline
+	key := newTestPipeline().EtcdObjectKey()
+	cached := load(t, kv, key)
+
+	// API在调度器更新之前取消了pipeline
+	canceled := load(t, kv, key)
+	should.NoError(canceled.Cancel("canceled by admin"))
+	value, err := json.Marshal(canceled)
+	should.NoError(err)
+	resp, err := kv.Txn(context.Background()).
+		If(clientv3.Compare(clientv3.ModRevision(key), "=", canceled.ResourceVersion)).
+		Then(clientv3.OpPut(key, string(value))).
+		Commit()
+	should.NoError(err)
+	should.True(resp.Succeeded)
+
+	// 直接写回过期的缓存时冲突
+	should.Error(r.Update(cached))
+
+	// 修改时重新读取最新的pipeline, 在最新的pipeline上重新同步step
+	applied := 0
+	err = r.Modify(cached, func(p *pipeline.Pipeline) (bool, error) {
+		applied++
+		p.Stages[0].Steps[0].Success("")
+		return true, nil
+	})
+	should.NoError(err)
+	should.Equal(2, applied)
+
+	stored := load(t, kv, key)
+	should.True(stored.IsCanceling())
+	should.Equal("canceled by admin", stored.Status.Message)
+	should.Equal(pipeline.STEP_STATUS_SUCCEEDED, stored.Stages[0].Steps[0].Status.Status)
+
+	// 没有变化时不更新
+	err = r.Modify(stored, func(p *pipeline.Pipeline) (bool, error) {
+		return false, nil
+	})
+	should.NoError(err)
+	should.Equal(stored.ResourceVersion, load(t, kv, key).ResourceVersion)
+}
+
+func init() {
+	zap.DevelopmentSetup()
+	conf.LoadDefaultConfig()
+}
@@ -50,7 +50,7 @@ func (i *shared) dealEvents() {
 			for _, event := range nodeResp.Events {
 				switch event.Type {
 				case mvccpb.PUT:
-					if err := i.handlePut(event, nodeResp.Header.GetRevision()); err != nil {
+					if err := i.handlePut(event); err != nil {
 						i.log.Error(err)
 					}
 				case mvccpb.DELETE:
@@ -77,7 +77,7 @@ func (i *shared) watch(ctx context.Context) {
 	i.log.Infof("watch etcd pipeline resource key: %s", ppWatchKey)
 }
 
-func (i *shared) handlePut(event *clientv3.Event, eventVersion int64) error {
+func (i *shared) handlePut(event *clientv3.Event) error {
 	i.log.Debugf("receive pipeline put event, %s", event.Kv.Key)
 
 	// 解析对象
@@ -85,7 +85,7 @@ func (i *shared) handlePut(event *clientv3.Event, eventVersion int64) error {
 	if err != nil {
 		return err
 	}
-	new.ResourceVersion = eventVersion
+	new.ResourceVersion = event.Kv.ModRevision
 
 	old, hasOld, err := i.indexer.GetByKey(new.MakeObjectKey())
 	if err != nil {
@@ -20,7 +20,12 @@ type Lister interface {
 }
 
 type Recorder interface {
+	// Update 只有版本没有变化时才更新, 版本变化时返回冲突错误, 版本为0时表示新建
 	Update(*pipeline.Pipeline) error
+	// Modify 修改pipeline后更新, 版本冲突时读取最新的pipeline重新修改后重试
+	Modify(*pipeline.Pipeline, ModifyFunc) error
+	// Delete 删除pipeline以及关联的step
+	Delete(*pipeline.Pipeline) error
 }
 
 // Watcher 负责事件通知
@@ -85,3 +90,7 @@ func (r PipelineTaskEventHandlerFuncs) OnDelete(obj *pipeline.Pipeline) {
 }
 
 type PipelineFilterHandler func(obj *pipeline.Pipeline) error
+
+// ModifyFunc 修改pipeline, 没有变化时返回false, 不需要更新, 返回错误时放弃更新
+// 版本冲突重试时会在最新的pipeline上再次调用, 因此需要根据传入的pipeline重新判断
+type ModifyFunc func(p *pipeline.Pipeline) (bool, error)
@@ -3,6 +3,7 @@ package conf
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	kc "github.com/infraboard/keyauth/client"
@@ -33,6 +34,10 @@ func newConfig() *Config {
 		Etcd:    newDefaultEtcd(),
 		Nats:    nats.NewDefaultConfig(),
 		Bus:     new(bus),
+		Secret:  newDefaultSecret(),
+		Archive: newDefaultArchive(),
+		StepLog: newDefaultStepLog(),
+		StepEnv: newDefaultStepEnv(),
 	}
 }
 
@@ -48,12 +53,149 @@ type Config struct {
 	Etcd    *Etcd        `toml:"etcd"`
 	Nats    *nats.Config `toml:"nats"`
 	Bus     *bus         `toml:"bus"`
+	Secret  *secret      `toml:"secret"`
+	Archive *archive     `toml:"archive"`
+	StepLog *stepLog     `toml:"step_log"`
+	StepEnv *stepEnv     `toml:"step_env"`
 }
 
 type bus struct {
 	Type string `toml:"type" env:"BUS_TYPE"`
 }
 
+type secret struct {
+	EncryptKey string `toml:"encrypt_key" env:"SECRET_ENCRYPT_KEY"`
+	// 允许调用DecryptSecret的内部服务凭证, 比如node和scheduler使用的client_id
+	DecryptClients []string `toml:"decrypt_clients" env:"SECRET_DECRYPT_CLIENTS" envSeparator:","`
+}
+
+func newDefaultSecret() *secret {
+	return &secret{}
+}
+
+// SecretKey Secret加密使用的key, 必须单独配置, 未配置时返回错误
+func (c *Config) SecretKey() (string, error) {
+	if c.Secret == nil || c.Secret.EncryptKey == "" {
+		return "", fmt.Errorf("secret encrypt_key not config")
+	}
+	return c.Secret.EncryptKey, nil
+}
+
+// IsDecryptClient 该服务凭证是否允许解密Secret
+func (c *Config) IsDecryptClient(clientID string) bool {
+	if c.Secret == nil || clientID == "" {
+		return false
+	}
+	for _, id := range c.Secret.DecryptClients {
+		if id == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+type archive struct {
+	Enabled        bool `toml:"enabled" env:"ARCHIVE_ENABLED"`
+	RetentionHours int  `toml:"retention_hours" env:"ARCHIVE_RETENTION_HOURS"`
+}
+
+func newDefaultArchive() *archive {
+	return &archive{
+		Enabled:        true,
+		RetentionHours: 72,
+	}
+}
+
+// Retention 结束的pipeline在etcd中保留的时间, 超过后归档到mongodb
+func (a *archive) Retention() time.Duration {
+	return time.Duration(a.RetentionHours) * time.Hour
+}
+
+// step可以使用的节点环境变量, 只有白名单中的变量会暴露给step, 避免泄露节点进程的敏感信息
+type stepEnv struct {
+	Allow []string `toml:"allow" env:"STEP_ENV_ALLOW" envSeparator:","`
+}
+
+func newDefaultStepEnv() *stepEnv {
+	return &stepEnv{
+		Allow: []string{"PATH", "HOME", "LANG"},
+	}
+}
+
+// Values 白名单中已经设置的环境变量
+func (e *stepEnv) Values() map[string]string {
+	m := make(map[string]string, len(e.Allow))
+	for _, k := range e.Allow {
+		if v, ok := os.LookupEnv(k); ok {
+			m[k] = v
+		}
+	}
+	return m
+}
+
+const (
+	// 日志保存在节点的本地文件中
+	STEP_LOG_DRIVER_FILE = "file"
+	// 日志保存在S3兼容的对象存储中
+	STEP_LOG_DRIVER_S3 = "s3"
+	// 日志保存在MongoDB GridFS中
+	STEP_LOG_DRIVER_GRIDFS = "gridfs"
+)
+
+// step运行日志的存储
+type stepLog struct {
+	Driver string         `toml:"driver" env:"STEP_LOG_DRIVER"`
+	File   *stepLogFile   `toml:"file"`
+	S3     *stepLogS3     `toml:"s3"`
+	GridFS *stepLogGridFS `toml:"gridfs"`
+}
+
+func newDefaultStepLog() *stepLog {
+	return &stepLog{
+		Driver: STEP_LOG_DRIVER_FILE,
+		File: &stepLogFile{
+			Root:       "runner_log",
+			MaxAgeDays: 30,
+		},
+		S3: &stepLogS3{
+			Region: "us-east-1",
+		},
+		GridFS: &stepLogGridFS{
+			Bucket: "step_logs",
+		},
+	}
+}
+
+// 本地文件存储, 按天分目录保存, 超过保留天数的目录会被删除
+type stepLogFile struct {
+	Root       string `toml:"root" env:"STEP_LOG_FILE_ROOT"`
+	MaxAgeDays int    `toml:"max_age_days" env:"STEP_LOG_FILE_MAX_AGE_DAYS"`
+}
+
+// MaxAge 日志保留的时间, 0表示一直保留
+func (f *stepLogFile) MaxAge() time.Duration {
+	return time.Duration(f.MaxAgeDays) * 24 * time.Hour
+}
+
+// S3兼容的对象存储, 比如MinIO
+type stepLogS3 struct {
+	Endpoint  string `toml:"endpoint" env:"STEP_LOG_S3_ENDPOINT"`
+	Region    string `toml:"region" env:"STEP_LOG_S3_REGION"`
+	Bucket    string `toml:"bucket" env:"STEP_LOG_S3_BUCKET"`
+	AccessKey string `toml:"access_key" env:"STEP_LOG_S3_ACCESS_KEY"`
+	SecretKey string `toml:"secret_key" env:"STEP_LOG_S3_SECRET_KEY"`
+}
+
+// IsEnabled 配置了endpoint和bucket时才可以使用
+func (s *stepLogS3) IsEnabled() bool {
+	return s != nil && s.Endpoint != "" && s.Bucket != ""
+}
+
+// MongoDB GridFS存储, 使用mongodb配置的数据库
+type stepLogGridFS struct {
+	Bucket string `toml:"bucket" env:"STEP_LOG_GRIDFS_BUCKET"`
+}
+
 type app struct {
 	Name     string `toml:"name" env:"APP_NAME"`
 	Key      string `toml:"key" env:"APP_KEY"`
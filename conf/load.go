@@ -63,3 +63,8 @@ func LoadConfigFromEnv() error {
 	global = cfg
 	return nil
 }
+
+// LoadDefaultConfig 使用默认配置初始化全局对象, 不创建etcd和mongodb的客户端, 用于测试
+func LoadDefaultConfig() {
+	global = newConfig()
+}
@@ -22,6 +22,7 @@ require (
 	go.mongodb.org/mongo-driver v1.7.1
 	google.golang.org/grpc v1.38.0
 	google.golang.org/protobuf v1.27.1
+	k8s.io/api v0.20.6
 	k8s.io/apimachinery v0.20.6
 	k8s.io/client-go v0.20.6
 )
@@ -24,6 +24,8 @@ import (
 	si_impl "github.com/infraboard/workflow/common/informers/step/etcd"
 	"github.com/infraboard/workflow/node/controller/step"
 	controller "github.com/infraboard/workflow/node/controller/step"
+	"github.com/infraboard/workflow/node/controller/step/store"
+	"github.com/infraboard/workflow/node/protocol"
 )
 
 var (
@@ -88,9 +90,15 @@ type service struct {
 	stop context.CancelFunc
 	node *node.Node
 	ctl  *step.Controller
+	http *protocol.HTTPService
 }
 
 func newService(cfg *conf.Config) (*service, error) {
+	// 初始化日志存储, runner创建时会使用
+	if err := store.Init(cfg); err != nil {
+		return nil, err
+	}
+
 	// Controller 实例
 	rn := MakeRegistryNode(cfg)
 
@@ -100,11 +108,18 @@ func newService(cfg *conf.Config) (*service, error) {
 	ctl := controller.NewController(rn.Name(), info, client.C())
 	ctl.Debug(zap.L().Named("Node"))
 
+	// 提供给API Server读取step日志
+	http, err := protocol.NewHTTPService()
+	if err != nil {
+		return nil, err
+	}
+
 	svr := &service{
 		info: info,
 		log:  zap.L().Named("CLI"),
 		node: rn,
 		ctl:  ctl,
+		http: http,
 	}
 	return svr, nil
 }
@@ -119,6 +134,12 @@ func (s *service) start() error {
 		s.log.Error(err)
 	}
 
+	go func() {
+		if err := s.http.Start(); err != nil {
+			s.log.Error(err)
+		}
+	}()
+
 	// 启动controller
 	if err := s.ctl.Run(ctx); err != nil {
 		return err
@@ -136,6 +157,7 @@ func (s *service) waitSign(sign chan os.Signal) {
 			default:
 				s.log.Infof("receive signal '%s', start graceful shutdown ...", v.String())
 				s.stop()
+				s.http.Stop()
 				// 停止 总线
 				s.log.Info("workflow node service stoped.")
 				return
@@ -160,7 +182,7 @@ func MakeRegistryNode(cfg *conf.Config) *node.Node {
 		InstanceName: hn,
 		ServiceName:  version.ServiceName,
 		Type:         node.NodeType,
-		Address:      cfg.HTTP.Host,
+		Address:      cfg.HTTP.Addr(),
 		GitBranch:    version.GIT_BRANCH,
 		GitCommit:    version.GIT_COMMIT,
 		BuildEnv:     version.GO_VERSION,
@@ -37,6 +37,8 @@ func (e *Engine) CancelStep(s *pipeline.Step) {
 		go e.k8s.Cancel(context.Background(), req)
 	case action.RUNNER_TYPE_LOCAL:
 		go e.local.Cancel(context.Background(), req)
+	case action.RUNNER_TYPE_HTTP:
+		go e.http.Cancel(context.Background(), req)
 	default:
 		s.Failed("unknown runner type: %s", actionIns.RunnerType)
 		return
@@ -0,0 +1,26 @@
+package engine
+
+import (
+	"context"
+	"errors"
+
+	"github.com/infraboard/workflow/node/controller/step/runner"
+)
+
+var (
+	// ErrStepNotRunning step不在该节点上运行, 或者已经运行结束
+	ErrStepNotRunning = errors.New("step not running on this node")
+)
+
+// Connect 连接到运行中的step的执行环境, 用于调试, 连接断开或者命令退出时返回
+func (e *Engine) Connect(ctx context.Context, req *runner.ConnectRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	r, ok := e.getRunning(req.Step.Key)
+	if !ok {
+		return ErrStepNotRunning
+	}
+	return r.Connect(ctx, req)
+}
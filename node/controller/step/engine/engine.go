@@ -3,6 +3,8 @@ package engine
 import (
 	"context"
 	"fmt"
+	"io"
+	"sync"
 
 	"github.com/infraboard/mcube/logger"
 	"github.com/infraboard/mcube/logger/zap"
@@ -12,12 +14,15 @@ import (
 	"github.com/infraboard/workflow/common/informers/step"
 	"github.com/infraboard/workflow/node/controller/step/runner"
 	"github.com/infraboard/workflow/node/controller/step/runner/docker"
+	http_runner "github.com/infraboard/workflow/node/controller/step/runner/http"
 	"github.com/infraboard/workflow/node/controller/step/runner/k8s"
 	"github.com/infraboard/workflow/node/controller/step/runner/local"
 )
 
 var (
-	engine = &Engine{}
+	engine = &Engine{
+		running: map[string]runner.Runner{},
+	}
 )
 
 func RunStep(ctx context.Context, s *pipeline.Step) {
@@ -33,6 +38,16 @@ func CancelStep(s *pipeline.Step) {
 	engine.CancelStep(s)
 }
 
+// Log 读取在该节点上运行的step的实时日志
+func Log(ctx context.Context, req *runner.LogRequest) (io.ReadCloser, error) {
+	return engine.Log(ctx, req)
+}
+
+// Connect 连接到在该节点上运行的step的执行环境
+func Connect(ctx context.Context, req *runner.ConnectRequest) error {
+	return engine.Connect(ctx, req)
+}
+
 func Init(wc *client.ClientSet, recorder step.Recorder) (err error) {
 	if wc == nil {
 		return fmt.Errorf("init runner error, workflow client is nil")
@@ -44,6 +59,7 @@ func Init(wc *client.ClientSet, recorder step.Recorder) (err error) {
 	engine.docker, err = docker.NewRunner()
 	engine.k8s = k8s.NewRunner()
 	engine.local = local.NewRunner()
+	engine.http = http_runner.NewRunner()
 
 	if err != nil {
 		return err
@@ -59,6 +75,30 @@ type Engine struct {
 	docker   runner.Runner
 	k8s      runner.Runner
 	local    runner.Runner
+	http     runner.Runner
 	init     bool
 	log      logger.Logger
+
+	// 正在运行的step和执行它的runner
+	running map[string]runner.Runner
+	l       sync.Mutex
+}
+
+func (e *Engine) addRunning(key string, r runner.Runner) {
+	e.l.Lock()
+	defer e.l.Unlock()
+	e.running[key] = r
+}
+
+func (e *Engine) removeRunning(key string) {
+	e.l.Lock()
+	defer e.l.Unlock()
+	delete(e.running, key)
+}
+
+func (e *Engine) getRunning(key string) (runner.Runner, bool) {
+	e.l.Lock()
+	defer e.l.Unlock()
+	r, ok := e.running[key]
+	return r, ok
 }
@@ -0,0 +1,21 @@
+package engine
+
+import (
+	"context"
+	"io"
+
+	"github.com/infraboard/workflow/node/controller/step/runner"
+)
+
+// Log step不在运行中时返回runner.ErrLogNotFound, 需要从store中读取
+func (e *Engine) Log(ctx context.Context, req *runner.LogRequest) (io.ReadCloser, error) {
+	if req.Step == nil {
+		return nil, runner.ErrLogNotFound
+	}
+
+	r, ok := e.getRunning(req.Step.Key)
+	if !ok {
+		return nil, runner.ErrLogNotFound
+	}
+	return r.Log(ctx, req)
+}
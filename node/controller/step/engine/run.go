@@ -2,9 +2,14 @@ package engine
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/infraboard/workflow/api/apps/action"
 	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/api/apps/pipeline/variable"
+	"github.com/infraboard/workflow/conf"
 	"github.com/infraboard/workflow/node/controller/step/runner"
 )
 
@@ -14,9 +19,12 @@ func (e *Engine) Run(ctx context.Context, s *pipeline.Step) {
 
 	e.run(ctx, req, resp)
 
-	if resp.HasError() {
+	switch {
+	case resp.IsCanceled():
+		s.Canceled(resp.CanceledMessage())
+	case resp.HasError():
 		s.Failed(resp.ErrorMessage())
-	} else {
+	default:
 		s.Success("")
 	}
 
@@ -51,20 +59,23 @@ func (e *Engine) run(ctx context.Context, req *runner.RunRequest, resp *runner.R
 	req.LoadRunParams(actionIns.DefaultRunParam())
 
 	// 3.查询Pipeline, 加载全局参数
+	var pl *pipeline.Pipeline
 	if s.IsCreateByPipeline() {
 		descP := pipeline.NewDescribePipelineRequestWithID(s.GetPipelineId())
 		descP.Namespace = s.GetNamespace()
-		pl, err := e.wc.Pipeline().DescribePipeline(ctx, descP)
+		pl, err = e.wc.Pipeline().DescribePipeline(ctx, descP)
 		if err != nil {
 			resp.Failed("describe step pipeline error, %s", err)
 			return
 		}
-		req.LoadRunParams(pl.With)
-		req.LoadMount(pl.Mount)
 	}
 
-	// 4. 加载step传递的参数
-	req.LoadRunParams(s.With)
+	// 4. 加载pipeline和step传递的参数
+	req.LoadEnv(conf.C().StepEnv.Values())
+	if err := resolveParams(req, newSecretGetter(ctx, e.wc, s.GetNamespace()), pl); err != nil {
+		resp.Failed(err.Error())
+		return
+	}
 
 	// 校验run参数合法性
 	if err := actionIns.ValidateRunParam(req.RunParams); err != nil {
@@ -75,19 +86,102 @@ func (e *Engine) run(ctx context.Context, req *runner.RunRequest, resp *runner.R
 	// 加载Runner运行需要的参数
 	req.LoadRunnerParams(actionIns.RunnerParam())
 
+	// 设置超时时间, 超时后runner会结束容器或者进程
+	ctx, cancel, reason := withDeadline(ctx, s, pl)
+	defer cancel()
+
 	e.log.Debugf("choice %s runner to run step", actionIns.RunnerType)
 	// 3.根据action定义的runner_type, 调用具体的runner
+	var r runner.Runner
 	switch actionIns.RunnerType {
 	case action.RUNNER_TYPE_DOCKER:
-		e.docker.Run(context.Background(), req, resp)
+		r = e.docker
 	case action.RUNNER_TYPE_K8s:
-		e.k8s.Run(context.Background(), req, resp)
+		r = e.k8s
 	case action.RUNNER_TYPE_LOCAL:
-		e.local.Run(context.Background(), req, resp)
+		r = e.local
+	case action.RUNNER_TYPE_HTTP:
+		r = e.http
 	default:
 		resp.Failed("unknown runner type: %s", actionIns.RunnerType)
 		return
 	}
+
+	// 记录step所在的runner, 用于读取实时日志
+	e.addRunning(s.Key, r)
+	defer e.removeRunning(s.Key)
+	r.Run(ctx, req, resp)
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		resp.Failed(reason)
+	}
+}
+
+// secretSource 解密secret, 并记录用到的值, 用于脱敏
+type secretSource interface {
+	variable.SecretGetter
+	Values() []string
+}
+
+// resolveParams 按优先级加载pipeline和step的参数, 参数中的变量引用在这里替换, 不修改step本身
+// pipeline的参数最先解析, 解析后的值再供step的参数引用
+// secret只在这里解密, 不会保存到step中, 用到的secret在日志和输出中脱敏
+func resolveParams(req *runner.RunRequest, secrets secretSource, pl *pipeline.Pipeline) error {
+	s := req.Step
+	resolver := variable.NewResolver().SetSecretGetter(secrets).SetEnv(req.Env)
+	defer func() { req.AddMask(secrets.Values()...) }()
+
+	if pl != nil {
+		with, err := resolver.RenderMap(pl.With)
+		if err != nil {
+			return fmt.Errorf("resolve pipeline with error, %s", err)
+		}
+		req.LoadRunParams(with)
+		req.LoadMount(pl.Mount)
+
+		// 加载pipeline运行中产生的参数, 即之前step的输出
+		req.LoadRunParams(pl.RuntimeContext(s))
+
+		resolver.SetPipelineWith(with)
+		for name, outputs := range pl.StepOutputs(s) {
+			resolver.SetStepOutputs(name, outputs)
+		}
+	}
+
+	with, err := resolver.RenderMap(s.With)
+	if err != nil {
+		return fmt.Errorf("resolve step with error, %s", err)
+	}
+	req.LoadRunParams(with)
+	return nil
+}
+
+// step和pipeline都设置了超时时, 以先到的为准
+func withDeadline(ctx context.Context, s *pipeline.Step, pl *pipeline.Pipeline) (
+	context.Context, context.CancelFunc, string) {
+	var (
+		deadline time.Time
+		reason   string
+	)
+
+	if d, ok := s.Deadline(); ok {
+		deadline = d
+		reason = fmt.Sprintf("step run timeout, timeout is %s", s.TimeoutDuration())
+	}
+	if pl != nil {
+		if d, ok := pl.Deadline(); ok && (deadline.IsZero() || d.Before(deadline)) {
+			deadline = d
+			reason = fmt.Sprintf("pipeline run timeout, timeout is %s", pl.TimeoutDuration())
+		}
+	}
+
+	if deadline.IsZero() {
+		ctx, cancel := context.WithCancel(ctx)
+		return ctx, cancel, reason
+	}
+
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	return ctx, cancel, reason
 }
 
 // 如果step执行完成
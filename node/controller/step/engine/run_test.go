@@ -0,0 +1,61 @@
+package engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/node/controller/step/runner"
+)
+
+type testSecrets struct {
+	items map[string]string
+	used  []string
+}
+
+func (s *testSecrets) GetSecret(name string) (string, error) {
+	v, ok := s.items[name]
+	if !ok {
+		return "", fmt.Errorf("secret %s not found", name)
+	}
+	s.used = append(s.used, v)
+	return v, nil
+}
+
+func (s *testSecrets) Values() []string {
+	return s.used
+}
+
+func TestResolveParamsPipelineSecret(t *testing.T) {
+	should := assert.New(t)
+
+	s := pipeline.NewDefaultStep()
+	s.Key = "ns01.p01.1.1"
+	s.Name = "deploy"
+	s.With = map[string]string{"AUTH": "Bearer ${{ pipeline.with.TOKEN }}"}
+
+	stage := pipeline.NewDefaultStage()
+	stage.Name = "deploy"
+	stage.AddStep(s)
+	pl := pipeline.NewDefaultPipeline()
+	pl.With = map[string]string{"TOKEN": "${{ secrets.deploy_token }}", "ENV": "prod"}
+	pl.AddStage(stage)
+
+	req := runner.NewRunRequest(s)
+	secrets := &testSecrets{items: map[string]string{"deploy_token": "s3cr3t"}}
+	if should.NoError(resolveParams(req, secrets, pl)) {
+		// pipeline参数中的secret解析后再传给runner, 不会传递原始的引用
+		should.Equal("s3cr3t", req.RunParams["TOKEN"])
+		should.Equal("Bearer s3cr3t", req.RunParams["AUTH"])
+		should.Equal("prod", req.RunParams["ENV"])
+		should.Contains(req.Masks, "s3cr3t")
+		should.Equal("token=***", runner.MaskValue("token=s3cr3t", req.Masks))
+	}
+	should.Equal("Bearer ${{ pipeline.with.TOKEN }}", s.With["AUTH"])
+
+	// 解析失败时不运行
+	pl.With["TOKEN"] = "${{ secrets.not_exist }}"
+	should.Error(resolveParams(runner.NewRunRequest(s), secrets, pl))
+}
@@ -0,0 +1,56 @@
+package engine
+
+import (
+	"context"
+	"sync"
+
+	"github.com/infraboard/workflow/api/apps/secret"
+	"github.com/infraboard/workflow/api/client"
+)
+
+func newSecretGetter(ctx context.Context, wc *client.ClientSet, namespace string) *secretGetter {
+	return &secretGetter{
+		ctx:       ctx,
+		wc:        wc,
+		namespace: namespace,
+		values:    map[string]string{},
+	}
+}
+
+// secretGetter 运行时通过API解密step所在空间的secret, 并记录用到的值, 用于日志脱敏
+type secretGetter struct {
+	ctx       context.Context
+	wc        *client.ClientSet
+	namespace string
+	values    map[string]string
+	l         sync.Mutex
+}
+
+func (g *secretGetter) GetSecret(name string) (string, error) {
+	g.l.Lock()
+	defer g.l.Unlock()
+
+	if v, ok := g.values[name]; ok {
+		return v, nil
+	}
+
+	ins, err := g.wc.Secret().DecryptSecret(g.ctx, secret.NewDecryptSecretRequest(g.namespace, name))
+	if err != nil {
+		return "", err
+	}
+
+	g.values[name] = ins.Value
+	return ins.Value, nil
+}
+
+// Values 已经解密的secret的值
+func (g *secretGetter) Values() []string {
+	g.l.Lock()
+	defer g.l.Unlock()
+
+	values := make([]string, 0, len(g.values))
+	for _, v := range g.values {
+		values = append(values, v)
+	}
+	return values
+}
@@ -0,0 +1,98 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/stdcopy"
+
+	"github.com/infraboard/workflow/node/controller/step/runner"
+)
+
+// Connect 在step运行的容器中执行命令, 和docker exec -it一样
+// 命令退出或者连接断开时返回, 命令的退出码不作为错误返回
+func (r *Runner) Connect(ctx context.Context, in *runner.ConnectRequest) error {
+	id, ok := r.getRunningContainer(in.Step.Key)
+	if !ok {
+		return fmt.Errorf("step %s container not found", in.Step.Key)
+	}
+
+	exec, err := r.cli.ContainerExecCreate(ctx, id, types.ExecConfig{
+		Tty:          in.Tty,
+		AttachStdin:  in.Stdin != nil,
+		AttachStdout: true,
+		AttachStderr: true,
+		Cmd:          in.Command,
+	})
+	if err != nil {
+		return fmt.Errorf("create exec error, %s", err)
+	}
+
+	r.log.Debugf("connect to step %s container %s, command: %s, tty: %t", in.Step.Key, id, in.Command, in.Tty)
+	hr, err := r.cli.ContainerExecAttach(ctx, exec.ID, types.ExecStartCheck{Tty: in.Tty})
+	if err != nil {
+		return fmt.Errorf("attach exec error, %s", err)
+	}
+	defer hr.Close()
+
+	if in.Tty && in.TerminalSizeQueue != nil {
+		go func() {
+			for size := in.TerminalSizeQueue.Next(); size != nil; size = in.TerminalSizeQueue.Next() {
+				opt := types.ResizeOptions{Height: uint(size.Height), Width: uint(size.Width)}
+				if err := r.cli.ContainerExecResize(ctx, exec.ID, opt); err != nil {
+					r.log.Debugf("resize exec %s error, %s", exec.ID, err)
+				}
+			}
+		}()
+	}
+	if in.Stdin != nil {
+		go func() {
+			io.Copy(hr.Conn, in.Stdin)
+			hr.CloseWrite()
+		}()
+	}
+
+	// 没有tty时, stdout和stderr复用同一个连接
+	outputDone := make(chan error, 1)
+	go func() {
+		var err error
+		if in.Tty {
+			_, err = io.Copy(in.Stdout, hr.Reader)
+		} else {
+			stderr := in.Stderr
+			if stderr == nil {
+				stderr = in.Stdout
+			}
+			_, err = stdcopy.StdCopy(in.Stdout, stderr, hr.Reader)
+		}
+		outputDone <- err
+	}()
+
+	select {
+	case err := <-outputDone:
+		return err
+	case <-ctx.Done():
+		return nil
+	}
+}
+
+func (r *Runner) addRunningContainer(key, id string) {
+	r.l.Lock()
+	defer r.l.Unlock()
+	r.containers[key] = id
+}
+
+func (r *Runner) removeRunningContainer(key string) {
+	r.l.Lock()
+	defer r.l.Unlock()
+	delete(r.containers, key)
+}
+
+func (r *Runner) getRunningContainer(key string) (string, bool) {
+	r.l.Lock()
+	defer r.l.Unlock()
+	id, ok := r.containers[key]
+	return id, ok
+}
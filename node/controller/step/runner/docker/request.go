@@ -2,6 +2,9 @@ package docker
 
 import (
 	"fmt"
+	"os"
+	"path"
+	"path/filepath"
 	"strings"
 
 	"github.com/infraboard/workflow/api/apps/pipeline"
@@ -34,28 +37,39 @@ func (r *dockerRunRequest) ImageVersion() string {
 	return r.RunnerParams[IMAGE_VERSION_KEY]
 }
 
+// ContainerName 重试时每次执行使用不同的容器名称
 func (r *dockerRunRequest) ContainerName() string {
-	return r.Step.Key
+	return r.Step.AttemptKey()
 }
 
 func (r *dockerRunRequest) ContainerEnv() []string {
 	envs := []string{}
-	for k, v := range r.mergeParams() {
+	for k, v := range r.RunParams {
 		envs = append(envs, fmt.Sprintf("%s=%s", k, v))
 	}
+	envs = append(envs, fmt.Sprintf("%s=%s", runner.OUTPUT_FILE_ENV, r.ContainerOutputFile()))
 	return envs
 }
 
-func (r *dockerRunRequest) ContainerCMD() []string {
-	return strings.Split(r.RunnerParams[IMAGE_CMD_KEY], ",")
+// 宿主机上用于收集容器输出的目录, 以卷的方式挂入容器
+func (r *dockerRunRequest) OutputDir() string {
+	return filepath.Join(os.TempDir(), "workflow", "output", r.Step.Key)
 }
 
-func (r *dockerRunRequest) mergeParams() map[string]string {
-	m := r.RunParams
-	for k, v := range r.Step.With {
-		m[k] = v
-	}
-	return m
+func (r *dockerRunRequest) OutputFile() string {
+	return filepath.Join(r.OutputDir(), OUTPUT_FILE_NAME)
+}
+
+func (r *dockerRunRequest) ContainerOutputFile() string {
+	return path.Join(CONTAINER_OUTPUT_DIR, OUTPUT_FILE_NAME)
+}
+
+func (r *dockerRunRequest) OutputBind() string {
+	return fmt.Sprintf("%s:%s", r.OutputDir(), CONTAINER_OUTPUT_DIR)
+}
+
+func (r *dockerRunRequest) ContainerCMD() []string {
+	return strings.Split(r.RunnerParams[IMAGE_CMD_KEY], ",")
 }
 
 func (r *dockerRunRequest) Validate() error {
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/docker/docker/api/types"
@@ -58,6 +60,12 @@ const (
 	CONTAINER_WARN_KEY = "container_warn"
 )
 
+const (
+	// 容器内收集输出的目录, 容器通过 $WORKFLOW_OUTPUT 文件输出变量
+	CONTAINER_OUTPUT_DIR = "/workflow/output"
+	OUTPUT_FILE_NAME     = "output"
+)
+
 func NewRunner() (*Runner, error) {
 	log := zap.L().Named("Runner.Docker")
 
@@ -73,7 +81,9 @@ func NewRunner() (*Runner, error) {
 		log:           log,
 		cli:           cli,
 		store:         store.NewStore(),
+		logs:          runner.NewLogHub(),
 		cancelTimeout: &ctm,
+		containers:    map[string]string{},
 	}, nil
 }
 
@@ -83,7 +93,12 @@ type Runner struct {
 	cli           *client.Client
 	log           logger.Logger
 	store         store.StoreFactory
+	logs          *runner.LogHub
 	cancelTimeout *time.Duration
+
+	// 运行中的step和它的容器, 用于连接到容器
+	containers map[string]string
+	l          sync.Mutex
 }
 
 // ContainerCreate参数说明:  https://docs.docker.com/engine/api/v1.41/#operation/ContainerCreate
@@ -104,12 +119,25 @@ func (r *Runner) Run(ctx context.Context, in *runner.RunRequest, out *runner.Run
 		return
 	}
 
+	// 准备输出目录, 容器内的用户不一定是root, 需要放开写权限
+	if err := os.MkdirAll(req.OutputDir(), 0777); err != nil {
+		out.Failed("create output dir error, %s", err)
+		return
+	}
+	defer os.RemoveAll(req.OutputDir())
+	if err := os.Chmod(req.OutputDir(), 0777); err != nil {
+		out.Failed("chmod output dir error, %s", err)
+		return
+	}
+
 	// 创建容器
 	resp, err := r.cli.ContainerCreate(ctx, &container.Config{
 		Image: req.Image(),
 		Env:   req.ContainerEnv(),
 		Cmd:   req.ContainerCMD(),
-	}, nil, nil, nil, req.ContainerName())
+	}, &container.HostConfig{
+		Binds: []string{req.OutputBind()},
+	}, nil, nil, req.ContainerName())
 	if err != nil {
 		out.Failed("create container error, %s", err)
 		return
@@ -117,6 +145,8 @@ func (r *Runner) Run(ctx context.Context, in *runner.RunRequest, out *runner.Run
 
 	// 退出时销毁容器
 	defer r.removeContainer(resp.ID)
+	r.addRunningContainer(req.Step.Key, resp.ID)
+	defer r.removeRunningContainer(req.Step.Key)
 
 	// 更新状态, 中间状态保持
 	up := r.store.NewFileUploader(req.Step.Key)
@@ -142,8 +172,9 @@ func (r *Runner) Run(ctx context.Context, in *runner.RunRequest, out *runner.Run
 		return
 	}
 
-	// 上传容器日志
-	if err := up.Upload(ctx, logStream); err != nil {
+	// 上传容器日志, 同时收集日志中的输出
+	oc := in.NewOutputCollector()
+	if err := up.Upload(ctx, oc.Tee(r.logs.Tee(req.Step.Key, in.MaskLog(logStream)))); err != nil {
 		out.Failed(err.Error())
 		return
 	}
@@ -164,12 +195,19 @@ func (r *Runner) Run(ctx context.Context, in *runner.RunRequest, out *runner.Run
 		out.Failed(err.Error())
 		return
 	}
+
+	// 通过挂入的卷 收集容器的返回
+	if err := oc.ParseFile(req.OutputFile()); err != nil {
+		out.Failed("collect container output error, %s", err)
+		return
+	}
+	out.LoadOutputs(oc)
+	out.UpdateResponse(in.Step)
 }
 
 // 容器退出时, 需要
 // 1. 判断容器执行成功还是失败
 // 2. 收集容器运行时产生的日志
-// 3. 收集容器执行时的输出结果
 func (r *Runner) containerExit(id string) error {
 	r.log.Debugf("container %s strart exit ...", id)
 
@@ -188,7 +226,6 @@ func (r *Runner) containerExit(id string) error {
 		return fmt.Errorf(msg)
 	}
 
-	// 通过挂入的卷 收集容器的返回
 	return nil
 }
 
@@ -197,9 +234,9 @@ func (r *Runner) inspectContainer(id string) (*types.ContainerJSON, error) {
 	return &resp, err
 }
 
-// 删除容器
+// 删除容器, 超时或者取消时容器可能还在运行, 需要强制删除
 func (r *Runner) removeContainer(id string) {
-	err := r.cli.ContainerRemove(context.Background(), id, types.ContainerRemoveOptions{})
+	err := r.cli.ContainerRemove(context.Background(), id, types.ContainerRemoveOptions{Force: true})
 	if err != nil {
 		r.log.Errorf("remove contain %s failed", err)
 	}
@@ -218,10 +255,8 @@ func (r *Runner) Cancel(ctx context.Context, in *runner.CancelRequest) {
 	}
 }
 
-func (r *Runner) Log(context.Context, *runner.LogRequest) (io.ReadCloser, error) {
-	return nil, nil
+// Log 读取容器运行中的日志, 结束后的日志从store中读取
+func (r *Runner) Log(ctx context.Context, in *runner.LogRequest) (io.ReadCloser, error) {
+	return r.logs.Open(ctx, in)
 }
 
-func (r *Runner) Connect(context.Context, *runner.ConnectRequest) error {
-	return nil
-}
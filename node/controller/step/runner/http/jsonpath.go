@@ -0,0 +1,61 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// extract 通过JSONPath提取字段, 同时支持 $.data.id 和 {.data.id} 两种写法
+// 字符串类型直接返回原值, 其他类型返回JSON格式
+func extract(data interface{}, path string) (string, error) {
+	if data == nil {
+		return "", fmt.Errorf("response is not json")
+	}
+
+	jp := jsonpath.New("output")
+	if err := jp.Parse(normalizePath(path)); err != nil {
+		return "", fmt.Errorf("parse json path %s error, %s", path, err)
+	}
+
+	results, err := jp.FindResults(data)
+	if err != nil {
+		return "", fmt.Errorf("find json path %s error, %s", path, err)
+	}
+
+	values := []interface{}{}
+	for i := range results {
+		for j := range results[i] {
+			values = append(values, results[i][j].Interface())
+		}
+	}
+
+	switch len(values) {
+	case 0:
+		return "", fmt.Errorf("json path %s not found", path)
+	case 1:
+		if s, ok := values[0].(string); ok {
+			return s, nil
+		}
+		b, err := json.Marshal(values[0])
+		return string(b), err
+	default:
+		b, err := json.Marshal(values)
+		return string(b), err
+	}
+}
+
+func normalizePath(path string) string {
+	path = strings.TrimSpace(path)
+	if strings.HasPrefix(path, "{") {
+		return path
+	}
+
+	path = strings.TrimPrefix(path, "$")
+	if !strings.HasPrefix(path, ".") && !strings.HasPrefix(path, "[") {
+		path = "." + path
+	}
+	return "{" + path + "}"
+}
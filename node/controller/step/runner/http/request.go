@@ -0,0 +1,224 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/node/controller/step/runner"
+)
+
+func newHTTPRunRequest(r *runner.RunRequest) *httpRunRequest {
+	if r.Step != nil && r.Step.Status == nil {
+		r.Step.Status = pipeline.NewDefaultStepStatus()
+	}
+	return &httpRunRequest{r}
+}
+
+type httpRunRequest struct {
+	*runner.RunRequest
+}
+
+func (r *httpRunRequest) Method() string {
+	if m := r.RunnerParams[METHOD_KEY]; m != "" {
+		return strings.ToUpper(m)
+	}
+	return http.MethodGet
+}
+
+func (r *httpRunRequest) Headers() (map[string]string, error) {
+	return r.jsonMap(HEADERS_KEY)
+}
+
+// Outputs 需要提取到context_map中的字段, key为输出名称, value为JSONPath
+func (r *httpRunRequest) Outputs() (map[string]string, error) {
+	return r.jsonMap(OUTPUTS_KEY)
+}
+
+func (r *httpRunRequest) jsonMap(key string) (map[string]string, error) {
+	m := map[string]string{}
+	v := r.RunnerParams[key]
+	if v == "" {
+		return m, nil
+	}
+
+	if err := json.Unmarshal([]byte(v), &m); err != nil {
+		return nil, fmt.Errorf("%s must be json object, %s", key, err)
+	}
+	return m, nil
+}
+
+// SuccessCodes 支持单个状态码和区间, 比如: 200,201,300-399
+func (r *httpRunRequest) IsSuccessCode(code int) (bool, error) {
+	v := r.RunnerParams[SUCCESS_CODES_KEY]
+	if v == "" {
+		v = DEFAULT_SUCCESS_CODES
+	}
+
+	for _, item := range strings.Split(v, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		lower, upper := item, item
+		if i := strings.Index(item, "-"); i > 0 {
+			lower, upper = item[:i], item[i+1:]
+		}
+		l, err := strconv.Atoi(strings.TrimSpace(lower))
+		if err != nil {
+			return false, fmt.Errorf("parse %s error, %s", SUCCESS_CODES_KEY, err)
+		}
+		u, err := strconv.Atoi(strings.TrimSpace(upper))
+		if err != nil {
+			return false, fmt.Errorf("parse %s error, %s", SUCCESS_CODES_KEY, err)
+		}
+		if code >= l && code <= u {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *httpRunRequest) Timeout() (time.Duration, error) {
+	return r.duration(TIMEOUT_KEY, DEFAULT_TIMEOUT)
+}
+
+func (r *httpRunRequest) PollInterval() (time.Duration, error) {
+	return r.duration(POLL_INTERVAL_KEY, DEFAULT_POLL_INTERVAL)
+}
+
+func (r *httpRunRequest) PollTimeout() (time.Duration, error) {
+	return r.duration(POLL_TIMEOUT_KEY, DEFAULT_POLL_TIMEOUT)
+}
+
+func (r *httpRunRequest) duration(key string, defaultValue time.Duration) (time.Duration, error) {
+	v := r.RunnerParams[key]
+	if v == "" {
+		return defaultValue, nil
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("parse %s error, %s", key, err)
+	}
+	return d, nil
+}
+
+func (r *httpRunRequest) IsPoll() bool {
+	return r.RunnerParams[POLL_URL_KEY] != ""
+}
+
+func (r *httpRunRequest) PollStatusPath() string {
+	return r.RunnerParams[POLL_STATUS_PATH_KEY]
+}
+
+func (r *httpRunRequest) PollSuccessValues() []string {
+	return splitValues(r.RunnerParams[POLL_SUCCESS_KEY])
+}
+
+func (r *httpRunRequest) PollFailedValues() []string {
+	return splitValues(r.RunnerParams[POLL_FAILED_KEY])
+}
+
+// templateData 模版渲染时可以使用的变量, 运行参数和已经提取的输出
+func (r *httpRunRequest) templateData(outputs map[string]string) map[string]string {
+	m := map[string]string{}
+	for k, v := range r.RunParams {
+		m[k] = v
+	}
+	for k, v := range outputs {
+		m[k] = v
+	}
+	return m
+}
+
+// render 渲染Runner参数中的模版
+func (r *httpRunRequest) render(key string, outputs map[string]string) (string, error) {
+	return r.renderText(key, r.RunnerParams[key], outputs)
+}
+
+// renderText 使用text/template渲染, 比如: {{ .BRANCH }}
+func (r *httpRunRequest) renderText(name, text string, outputs map[string]string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	tpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("parse %s template error, %s", name, err)
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if err := tpl.Execute(buf, r.templateData(outputs)); err != nil {
+		return "", fmt.Errorf("render %s template error, %s", name, err)
+	}
+	return buf.String(), nil
+}
+
+func (r *httpRunRequest) Validate() error {
+	if r.Step == nil || r.Step.Key == "" {
+		return fmt.Errorf("step is nil or step key is \"\"")
+	}
+
+	if r.RunnerParams[URL_KEY] == "" {
+		return fmt.Errorf("%s missed", URL_KEY)
+	}
+
+	if _, err := r.Headers(); err != nil {
+		return err
+	}
+	if _, err := r.Outputs(); err != nil {
+		return err
+	}
+	if _, err := r.IsSuccessCode(0); err != nil {
+		return err
+	}
+	if _, err := r.Timeout(); err != nil {
+		return err
+	}
+	if _, err := r.PollInterval(); err != nil {
+		return err
+	}
+	if _, err := r.PollTimeout(); err != nil {
+		return err
+	}
+
+	if r.IsPoll() {
+		if r.PollStatusPath() == "" {
+			return fmt.Errorf("%s missed", POLL_STATUS_PATH_KEY)
+		}
+		if len(r.PollSuccessValues()) == 0 {
+			return fmt.Errorf("%s missed", POLL_SUCCESS_KEY)
+		}
+	}
+
+	return nil
+}
+
+func splitValues(v string) []string {
+	values := []string{}
+	for _, item := range strings.Split(v, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			values = append(values, item)
+		}
+	}
+	return values
+}
+
+func isIn(v string, targets []string) bool {
+	for i := range targets {
+		if targets[i] == v {
+			return true
+		}
+	}
+	return false
+}
@@ -1 +1,481 @@
 package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+
+	"github.com/infraboard/workflow/api/apps/action"
+	"github.com/infraboard/workflow/node/controller/step/runner"
+	"github.com/infraboard/workflow/node/controller/step/store"
+)
+
+const (
+	METHOD_KEY           = "HTTP_METHOD"
+	URL_KEY              = "HTTP_URL"
+	HEADERS_KEY          = "HTTP_HEADERS"
+	BODY_KEY             = "HTTP_BODY"
+	SUCCESS_CODES_KEY    = "HTTP_SUCCESS_CODES"
+	TIMEOUT_KEY          = "HTTP_TIMEOUT"
+	OUTPUTS_KEY          = "HTTP_OUTPUTS"
+	POLL_URL_KEY         = "POLL_URL"
+	POLL_STATUS_PATH_KEY = "POLL_STATUS_PATH"
+	POLL_SUCCESS_KEY     = "POLL_SUCCESS_VALUES"
+	POLL_FAILED_KEY      = "POLL_FAILED_VALUES"
+	POLL_INTERVAL_KEY    = "POLL_INTERVAL"
+	POLL_TIMEOUT_KEY     = "POLL_TIMEOUT"
+)
+
+const (
+	DEFAULT_SUCCESS_CODES = "200-299"
+	DEFAULT_TIMEOUT       = 30 * time.Second
+	DEFAULT_POLL_INTERVAL = 5 * time.Second
+	DEFAULT_POLL_TIMEOUT  = time.Hour
+	// 上传请求记录的超时时间, 不使用step的ctx, step超时或者取消后也要上传
+	LOG_UPLOAD_TIMEOUT = 30 * time.Second
+	// 请求还没有开始时收到的取消请求保留的时间
+	PENDING_CANCEL_TTL = 10 * time.Minute
+)
+
+var (
+	METHOD_KEY_DESC = &action.RunParamDesc{
+		KeyName:      METHOD_KEY,
+		KeyDesc:      "请求方法",
+		Required:     false,
+		DefaultValue: http.MethodGet,
+		ValueDesc:    "HTTP请求方法, 比如 GET, POST, PUT",
+		ValueType:    action.PARAM_VALUE_TYPE_ENUM,
+		AllowedValues: []string{
+			http.MethodGet, http.MethodPost, http.MethodPut,
+			http.MethodPatch, http.MethodDelete, http.MethodHead,
+		},
+	}
+	URL_KEY_DESC = &action.RunParamDesc{
+		KeyName:   URL_KEY,
+		KeyDesc:   "请求地址",
+		Required:  true,
+		ValueDesc: "支持模版渲染, 比如 http://api.example.com/deploy/{{ .APP_NAME }}",
+	}
+	HEADERS_KEY_DESC = &action.RunParamDesc{
+		KeyName:   HEADERS_KEY,
+		KeyDesc:   "请求头",
+		Required:  false,
+		ValueDesc: "JSON对象格式, 值支持模版渲染, 比如 {\"Authorization\": \"Bearer {{ .TOKEN }}\"}",
+		ValueType: action.PARAM_VALUE_TYPE_JSON,
+	}
+	BODY_KEY_DESC = &action.RunParamDesc{
+		KeyName:   BODY_KEY,
+		KeyDesc:   "请求体",
+		Required:  false,
+		ValueDesc: "支持模版渲染",
+	}
+	SUCCESS_CODES_KEY_DESC = &action.RunParamDesc{
+		KeyName:      SUCCESS_CODES_KEY,
+		KeyDesc:      "成功状态码",
+		Required:     false,
+		DefaultValue: DEFAULT_SUCCESS_CODES,
+		ValueDesc:    "多个使用逗号分隔, 支持区间, 比如 200,201,300-399",
+	}
+	TIMEOUT_KEY_DESC = &action.RunParamDesc{
+		KeyName:      TIMEOUT_KEY,
+		KeyDesc:      "请求超时时间",
+		Required:     false,
+		DefaultValue: DEFAULT_TIMEOUT.String(),
+		ValueDesc:    "单次请求的超时时间, 比如 10s",
+	}
+	OUTPUTS_KEY_DESC = &action.RunParamDesc{
+		KeyName:   OUTPUTS_KEY,
+		KeyDesc:   "输出",
+		Required:  false,
+		ValueDesc: "JSON对象格式, 值为JSONPath, 提取后写入context_map, 比如 {\"DEPLOY_ID\": \"$.data.id\"}",
+		ValueType: action.PARAM_VALUE_TYPE_JSON,
+	}
+	POLL_URL_KEY_DESC = &action.RunParamDesc{
+		KeyName:   POLL_URL_KEY,
+		KeyDesc:   "轮询地址",
+		Required:  false,
+		ValueDesc: "配置后会以GET方式轮询该地址直到任务结束, 可以使用输出变量, 比如 http://api.example.com/deploy/{{ .DEPLOY_ID }}",
+	}
+	POLL_STATUS_PATH_KEY_DESC = &action.RunParamDesc{
+		KeyName:   POLL_STATUS_PATH_KEY,
+		KeyDesc:   "轮询状态字段",
+		Required:  false,
+		ValueDesc: "轮询结果中状态字段的JSONPath, 比如 $.data.status",
+	}
+	POLL_SUCCESS_KEY_DESC = &action.RunParamDesc{
+		KeyName:   POLL_SUCCESS_KEY,
+		KeyDesc:   "轮询成功状态",
+		Required:  false,
+		ValueDesc: "多个使用逗号分隔, 比如 SUCCESS,DONE",
+	}
+	POLL_FAILED_KEY_DESC = &action.RunParamDesc{
+		KeyName:   POLL_FAILED_KEY,
+		KeyDesc:   "轮询失败状态",
+		Required:  false,
+		ValueDesc: "多个使用逗号分隔, 比如 FAILED,ERROR",
+	}
+	POLL_INTERVAL_KEY_DESC = &action.RunParamDesc{
+		KeyName:      POLL_INTERVAL_KEY,
+		KeyDesc:      "轮询间隔",
+		Required:     false,
+		DefaultValue: DEFAULT_POLL_INTERVAL.String(),
+		ValueDesc:    "比如 5s",
+	}
+	POLL_TIMEOUT_KEY_DESC = &action.RunParamDesc{
+		KeyName:      POLL_TIMEOUT_KEY,
+		KeyDesc:      "轮询超时时间",
+		Required:     false,
+		DefaultValue: DEFAULT_POLL_TIMEOUT.String(),
+		ValueDesc:    "超过该时间状态仍未结束时失败, step设置了超时时间时以先到的为准, 比如 30m",
+	}
+)
+
+func ParamsDesc() []*action.RunParamDesc {
+	return []*action.RunParamDesc{
+		METHOD_KEY_DESC,
+		URL_KEY_DESC,
+		HEADERS_KEY_DESC,
+		BODY_KEY_DESC,
+		SUCCESS_CODES_KEY_DESC,
+		TIMEOUT_KEY_DESC,
+		OUTPUTS_KEY_DESC,
+		POLL_URL_KEY_DESC,
+		POLL_STATUS_PATH_KEY_DESC,
+		POLL_SUCCESS_KEY_DESC,
+		POLL_FAILED_KEY_DESC,
+		POLL_INTERVAL_KEY_DESC,
+		POLL_TIMEOUT_KEY_DESC,
+	}
+}
+
+const (
+	STATUS_CODE_KEY = "status_code"
+	POLL_STATUS_KEY = "poll_status"
+)
+
+func NewRunner() *Runner {
+	return &Runner{
+		log:      zap.L().Named("Runner.HTTP"),
+		store:    store.NewStore(),
+		requests: map[string]*request{},
+		canceled: map[string]time.Time{},
+	}
+}
+
+// Runner 调用外部HTTP接口, 用于对接外部系统
+type Runner struct {
+	log   logger.Logger
+	store store.StoreFactory
+
+	requests map[string]*request
+	// 请求还没有开始时收到的取消, Run开始时检查
+	canceled map[string]time.Time
+	l        sync.Mutex
+}
+
+type request struct {
+	cancel   context.CancelFunc
+	canceled bool
+}
+
+// Runner Params:
+//   HTTP_URL: 请求地址
+//   HTTP_METHOD/HTTP_HEADERS/HTTP_BODY: 请求方法, 请求头, 请求体
+//   HTTP_OUTPUTS: 需要提取到context_map中的字段
+//   POLL_*: 长时间运行的任务, 轮询直到任务结束或者超过POLL_TIMEOUT
+// Run Params:
+//   模版渲染时使用, 比如 {{ .APP_NAME }}
+func (r *Runner) Run(ctx context.Context, in *runner.RunRequest, out *runner.RunResponse) {
+	req := newHTTPRunRequest(in)
+	if err := req.Validate(); err != nil {
+		out.Failed("validate http run request error, %s", err)
+		return
+	}
+	r.log.Debugf("http start run step: %s", in.Step.Key)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	p := r.addRequest(in.Step.Key, cancel)
+	defer r.removeRequest(in.Step.Key)
+	if r.isCanceled(p) {
+		out.Canceled("http request canceled before start")
+		return
+	}
+
+	up := r.store.NewFileUploader(in.Step.Key)
+	out.UpdateReponseMap("log_driver", up.DriverName())
+	out.UpdateReponseMap("log_path", up.ObjectID())
+	out.UpdateResponse(in.Step)
+
+	// 请求和响应的记录作为step日志上传
+	e := &executor{
+		req:     req,
+		out:     out,
+		log:     bytes.NewBuffer(nil),
+		outputs: map[string]string{},
+	}
+	e.run(runCtx)
+
+	uploadCtx, uploadCancel := context.WithTimeout(context.Background(), LOG_UPLOAD_TIMEOUT)
+	defer uploadCancel()
+	if err := up.Upload(uploadCtx, in.MaskLog(ioutil.NopCloser(e.log))); err != nil {
+		out.Failed("upload http log error, %s", err)
+	}
+
+	if r.isCanceled(p) {
+		out.Canceled("http request canceled")
+	}
+
+	// 更新响应和提取的输出
+	out.UpdateResponse(in.Step)
+}
+
+func (r *Runner) addRequest(key string, cancel context.CancelFunc) *request {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	p := &request{cancel: cancel}
+	if _, ok := r.canceled[key]; ok {
+		delete(r.canceled, key)
+		p.canceled = true
+	}
+	r.requests[key] = p
+	return p
+}
+
+func (r *Runner) removeRequest(key string) {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	delete(r.requests, key)
+}
+
+func (r *Runner) isCanceled(p *request) bool {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	return p.canceled
+}
+
+func (r *Runner) Cancel(ctx context.Context, in *runner.CancelRequest) {
+	if in.Step == nil || in.Step.Key == "" {
+		return
+	}
+
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	// 请求还没有开始, 记录下来, 开始时直接取消
+	p, ok := r.requests[in.Step.Key]
+	if !ok {
+		r.prunePendingCancel()
+		r.canceled[in.Step.Key] = time.Now()
+		return
+	}
+
+	p.canceled = true
+	p.cancel()
+}
+
+func (r *Runner) prunePendingCancel() {
+	for k, t := range r.canceled {
+		if time.Since(t) > PENDING_CANCEL_TTL {
+			delete(r.canceled, k)
+		}
+	}
+}
+
+// Log 请求记录在请求结束后才上传, 没有实时日志
+func (r *Runner) Log(context.Context, *runner.LogRequest) (io.ReadCloser, error) {
+	return nil, runner.ErrLogNotFound
+}
+
+// Connect HTTP请求没有可以连接的执行环境
+func (r *Runner) Connect(context.Context, *runner.ConnectRequest) error {
+	return runner.ErrConnectNotSupport
+}
+
+// executor 一次step执行过程, 包含首次请求和后续的轮询
+type executor struct {
+	req     *httpRunRequest
+	out     *runner.RunResponse
+	log     *bytes.Buffer
+	outputs map[string]string
+}
+
+func (e *executor) run(ctx context.Context) {
+	timeout, _ := e.req.Timeout()
+	client := &http.Client{Timeout: timeout}
+
+	data, err := e.call(ctx, client, e.req.Method(), URL_KEY, BODY_KEY)
+	if err != nil {
+		e.out.Failed("%s", err)
+		return
+	}
+	e.extractOutputs(data, e.req.IsPoll())
+
+	if e.req.IsPoll() {
+		if err := e.poll(ctx, client); err != nil {
+			e.out.Failed("%s", err)
+			return
+		}
+	}
+
+	for k, v := range e.outputs {
+		e.out.UpdateCtxMap(k, runner.MaskValue(v, e.req.Masks))
+	}
+}
+
+// poll 轮询直到状态字段为成功或者失败, 超过轮询超时时间或者step超时后结束
+func (e *executor) poll(ctx context.Context, client *http.Client) error {
+	interval, _ := e.req.PollInterval()
+	timeout, _ := e.req.PollTimeout()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		select {
+		case <-pollCtx.Done():
+			return pollDoneError(ctx, timeout)
+		case <-ticker.C:
+		}
+
+		data, err := e.call(pollCtx, client, http.MethodGet, POLL_URL_KEY, "")
+		if err != nil {
+			if pollCtx.Err() != nil {
+				return pollDoneError(ctx, timeout)
+			}
+			return err
+		}
+
+		status, err := extract(data, e.req.PollStatusPath())
+		if err != nil {
+			e.logf("# poll status not ready, %s\n", err)
+			continue
+		}
+		e.out.UpdateReponseMap(POLL_STATUS_KEY, status)
+		e.logf("# poll status: %s\n", status)
+
+		switch {
+		case isIn(status, e.req.PollSuccessValues()):
+			e.extractOutputs(data, false)
+			return nil
+		case isIn(status, e.req.PollFailedValues()):
+			e.extractOutputs(data, true)
+			return fmt.Errorf("poll status is %s", status)
+		}
+	}
+}
+
+// 轮询超时和step超时或者取消时的错误
+func pollDoneError(ctx context.Context, timeout time.Duration) error {
+	if ctx.Err() == nil {
+		return fmt.Errorf("poll timeout, status not finished after %s", timeout)
+	}
+	return fmt.Errorf("poll canceled, %s", ctx.Err())
+}
+
+// call 发起请求并校验状态码, 响应为JSON时返回解析后的数据
+func (e *executor) call(ctx context.Context, client *http.Client, method, urlKey, bodyKey string) (interface{}, error) {
+	url, err := e.req.render(urlKey, e.outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	var body string
+	if bodyKey != "" {
+		body, err = e.req.render(bodyKey, e.outputs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hreq, err := http.NewRequestWithContext(ctx, method, url, strings.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("new http request error, %s", err)
+	}
+
+	headers, _ := e.req.Headers()
+	for k, v := range headers {
+		hv, err := e.req.renderText(k, v, e.outputs)
+		if err != nil {
+			return nil, err
+		}
+		hreq.Header.Set(k, hv)
+	}
+
+	e.logf("> %s %s\n", method, url)
+	if body != "" {
+		e.logf("%s\n", body)
+	}
+
+	resp, err := client.Do(hreq)
+	if err != nil {
+		return nil, fmt.Errorf("do http request error, %s", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read http response error, %s", err)
+	}
+
+	e.logf("< %s\n", resp.Status)
+	if len(respBody) > 0 {
+		e.logf("%s\n", respBody)
+	}
+	e.out.UpdateReponseMap(STATUS_CODE_KEY, strconv.Itoa(resp.StatusCode))
+
+	ok, err := e.req.IsSuccessCode(resp.StatusCode)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("http request failed, status code is %d", resp.StatusCode)
+	}
+
+	var data interface{}
+	if len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, &data); err != nil {
+			data = nil
+		}
+	}
+	return data, nil
+}
+
+// extractOutputs 提取输出, 轮询时允许字段在后续的响应中才出现
+func (e *executor) extractOutputs(data interface{}, allowMissing bool) {
+	outputs, _ := e.req.Outputs()
+	for k, path := range outputs {
+		v, err := extract(data, path)
+		if err != nil {
+			if allowMissing {
+				continue
+			}
+			if _, ok := e.outputs[k]; ok {
+				continue
+			}
+			e.out.Failed("extract output %s error, %s", k, err)
+			continue
+		}
+		e.outputs[k] = v
+	}
+}
+
+func (e *executor) logf(format string, a ...interface{}) {
+	fmt.Fprintf(e.log, format, a...)
+}
@@ -0,0 +1,213 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/node/controller/step/runner"
+	http_runner "github.com/infraboard/workflow/node/controller/step/runner/http"
+)
+
+var (
+	hr = http_runner.NewRunner()
+)
+
+func testUpdater(s *pipeline.Step) {}
+
+func newTestRequest(key string, params map[string]string) *runner.RunRequest {
+	req := runner.NewRunRequest(&pipeline.Step{Key: key})
+	req.LoadRunnerParams(params)
+	return req
+}
+
+func TestRunNULLStep(t *testing.T) {
+	should := assert.New(t)
+
+	resp := runner.NewRunReponse(testUpdater)
+	hr.Run(context.Background(), runner.NewRunRequest(&pipeline.Step{}), resp)
+	should.True(resp.HasError())
+}
+
+func TestRunWithOutputs(t *testing.T) {
+	should := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		should.Equal(http.MethodPost, r.Method)
+		should.Equal("/deploy/app01", r.URL.Path)
+		should.Equal("Bearer token01", r.Header.Get("Authorization"))
+		should.JSONEq(`{"version": "v1"}`, string(body))
+		w.Write([]byte(`{"data": {"id": "d-01", "hosts": ["h1", "h2"]}}`))
+	}))
+	defer srv.Close()
+
+	req := newTestRequest("http.run.1.1", map[string]string{
+		http_runner.METHOD_KEY:  "post",
+		http_runner.URL_KEY:     srv.URL + "/deploy/{{ .APP_NAME }}",
+		http_runner.HEADERS_KEY: `{"Authorization": "Bearer {{ .TOKEN }}"}`,
+		http_runner.BODY_KEY:    `{"version": "{{ .VERSION }}"}`,
+		http_runner.OUTPUTS_KEY: `{"DEPLOY_ID": "$.data.id", "HOSTS": "{.data.hosts}"}`,
+	})
+	req.LoadRunParams(map[string]string{"APP_NAME": "app01", "TOKEN": "token01", "VERSION": "v1"})
+	resp := runner.NewRunReponse(testUpdater)
+	hr.Run(context.Background(), req, resp)
+	should.False(resp.HasError(), resp.ErrorMessage())
+
+	should.Equal("200", req.Step.Status.Response[http_runner.STATUS_CODE_KEY])
+	should.Equal("d-01", req.Step.Status.ContextMap["DEPLOY_ID"])
+	should.Equal(`["h1","h2"]`, req.Step.Status.ContextMap["HOSTS"])
+}
+
+func TestRunStatusCodeFailed(t *testing.T) {
+	should := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	req := newTestRequest("http.run.1.2", map[string]string{
+		http_runner.URL_KEY: srv.URL,
+	})
+	resp := runner.NewRunReponse(testUpdater)
+	hr.Run(context.Background(), req, resp)
+	should.True(resp.HasError())
+	should.Contains(resp.ErrorMessage(), "status code is 500")
+}
+
+func TestRunMissingTemplateParam(t *testing.T) {
+	should := assert.New(t)
+
+	req := newTestRequest("http.run.1.3", map[string]string{
+		http_runner.URL_KEY: "http://127.0.0.1/{{ .NOT_EXIST }}",
+	})
+	resp := runner.NewRunReponse(testUpdater)
+	hr.Run(context.Background(), req, resp)
+	should.True(resp.HasError())
+	should.Contains(resp.ErrorMessage(), "NOT_EXIST")
+}
+
+func TestRunPoll(t *testing.T) {
+	should := assert.New(t)
+
+	var polls int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/jobs", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": "j-01"}`))
+	})
+	mux.HandleFunc("/jobs/j-01", func(w http.ResponseWriter, r *http.Request) {
+		status := "RUNNING"
+		if atomic.AddInt32(&polls, 1) >= 3 {
+			status = "SUCCESS"
+		}
+		json.NewEncoder(w).Encode(map[string]string{"status": status, "result": "ok"})
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	req := newTestRequest("http.run.1.4", map[string]string{
+		http_runner.METHOD_KEY:           http.MethodPost,
+		http_runner.URL_KEY:              srv.URL + "/jobs",
+		http_runner.OUTPUTS_KEY:          `{"JOB_ID": "$.id", "RESULT": "$.result"}`,
+		http_runner.POLL_URL_KEY:         srv.URL + "/jobs/{{ .JOB_ID }}",
+		http_runner.POLL_STATUS_PATH_KEY: "$.status",
+		http_runner.POLL_SUCCESS_KEY:     "SUCCESS",
+		http_runner.POLL_FAILED_KEY:      "FAILED",
+		http_runner.POLL_INTERVAL_KEY:    "10ms",
+	})
+	resp := runner.NewRunReponse(testUpdater)
+	hr.Run(context.Background(), req, resp)
+	should.False(resp.HasError(), resp.ErrorMessage())
+
+	should.Equal(int32(3), atomic.LoadInt32(&polls))
+	should.Equal("SUCCESS", req.Step.Status.Response[http_runner.POLL_STATUS_KEY])
+	should.Equal("j-01", req.Step.Status.ContextMap["JOB_ID"])
+	should.Equal("ok", req.Step.Status.ContextMap["RESULT"])
+}
+
+func TestCancelStep(t *testing.T) {
+	should := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status": "RUNNING"}`))
+	}))
+	defer srv.Close()
+
+	req := newTestRequest("http.run.1.5", map[string]string{
+		http_runner.URL_KEY:              srv.URL,
+		http_runner.POLL_URL_KEY:         srv.URL,
+		http_runner.POLL_STATUS_PATH_KEY: "$.status",
+		http_runner.POLL_SUCCESS_KEY:     "SUCCESS",
+		http_runner.POLL_INTERVAL_KEY:    "10ms",
+	})
+	resp := runner.NewRunReponse(testUpdater)
+
+	done := make(chan struct{})
+	go func() {
+		hr.Run(context.Background(), req, resp)
+		close(done)
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	hr.Cancel(context.Background(), runner.NewCancelRequest(&pipeline.Step{Key: "http.run.1.5"}))
+
+	select {
+	case <-done:
+		should.True(resp.IsCanceled())
+		should.Contains(resp.CanceledMessage(), "canceled")
+	case <-time.After(5 * time.Second):
+		t.Fatal("cancel http step timeout")
+	}
+}
+
+func TestCancelStepBeforeStart(t *testing.T) {
+	should := assert.New(t)
+
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+	}))
+	defer srv.Close()
+
+	// 请求还没有开始时取消, 不会标记step失败, 开始后直接结束
+	s := &pipeline.Step{Key: "http.run.1.6"}
+	hr.Cancel(context.Background(), runner.NewCancelRequest(s))
+	should.Nil(s.Status)
+
+	req := newTestRequest("http.run.1.6", map[string]string{http_runner.URL_KEY: srv.URL})
+	resp := runner.NewRunReponse(testUpdater)
+	hr.Run(context.Background(), req, resp)
+	should.True(resp.IsCanceled())
+	should.Equal(int32(0), atomic.LoadInt32(&calls))
+}
+
+func TestRunPollTimeout(t *testing.T) {
+	should := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status": "RUNNING"}`))
+	}))
+	defer srv.Close()
+
+	req := newTestRequest("http.run.1.7", map[string]string{
+		http_runner.URL_KEY:              srv.URL,
+		http_runner.POLL_URL_KEY:         srv.URL,
+		http_runner.POLL_STATUS_PATH_KEY: "$.status",
+		http_runner.POLL_SUCCESS_KEY:     "SUCCESS",
+		http_runner.POLL_INTERVAL_KEY:    "10ms",
+		http_runner.POLL_TIMEOUT_KEY:     "100ms",
+	})
+	resp := runner.NewRunReponse(testUpdater)
+	hr.Run(context.Background(), req, resp)
+	should.False(resp.IsCanceled())
+	should.Contains(resp.ErrorMessage(), "poll timeout")
+}
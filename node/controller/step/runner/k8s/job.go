@@ -0,0 +1,82 @@
+package k8s
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	STEP_LABEL_KEY      = "workflow.infraboard.io/step"
+	STEP_CONTAINER      = "step"
+	STEP_KEY_ANNOTATION = "workflow.infraboard.io/step-key"
+	DEFAULT_NAMESPACE   = "default"
+)
+
+// newJob 将RunRequest 转换为一个只运行一次的Job, 失败后不重试
+func newJob(req *k8sRunRequest) (*batchv1.Job, error) {
+	res, err := req.Resources()
+	if err != nil {
+		return nil, err
+	}
+
+	var backoff int32 = 0
+	name := req.JobName()
+	labels := map[string]string{
+		STEP_LABEL_KEY: name,
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   req.Namespace(),
+			Labels:      labels,
+			Annotations: map[string]string{STEP_KEY_ANNOTATION: req.Step.Key},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoff,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Name:      STEP_CONTAINER,
+							Image:     req.Image(),
+							Command:   req.ContainerCMD(),
+							Env:       req.ContainerEnv(),
+							Resources: res,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return job, nil
+}
+
+// jobCondition 返回Job是否结束, 以及失败时的原因
+func jobCondition(job *batchv1.Job) (complete bool, failedMessage string) {
+	for _, c := range job.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			return true, ""
+		case batchv1.JobFailed:
+			return true, c.Reason + ": " + c.Message
+		}
+	}
+
+	if job.Status.Succeeded > 0 {
+		return true, ""
+	}
+	if job.Status.Failed > 0 {
+		return true, "job pod failed"
+	}
+	return false, ""
+}
@@ -0,0 +1,194 @@
+package k8s
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/node/controller/step/runner"
+)
+
+const (
+	// k8s资源名称的最大长度
+	JOB_NAME_MAX_LEN = 63
+	// 截断时追加的hash长度
+	JOB_NAME_HASH_LEN = 8
+)
+
+var (
+	invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+)
+
+func newK8sRunRequest(r *runner.RunRequest) *k8sRunRequest {
+	if r.Step != nil && r.Step.Status == nil {
+		r.Step.Status = pipeline.NewDefaultStepStatus()
+	}
+	return &k8sRunRequest{r}
+}
+
+type k8sRunRequest struct {
+	*runner.RunRequest
+}
+
+func (r *k8sRunRequest) Image() string {
+	if r.ImageVersion() == "" {
+		return r.ImageURL()
+	}
+	return fmt.Sprintf("%s:%s", r.ImageURL(), r.ImageVersion())
+}
+
+func (r *k8sRunRequest) ImageURL() string {
+	return r.RunnerParams[IMAGE_URL_KEY]
+}
+
+func (r *k8sRunRequest) ImageVersion() string {
+	return r.RunnerParams[IMAGE_VERSION_KEY]
+}
+
+func (r *k8sRunRequest) Namespace() string {
+	if ns := r.RunnerParams[NAMESPACE_KEY]; ns != "" {
+		return ns
+	}
+	return DEFAULT_NAMESPACE
+}
+
+// JobName step key转换成符合k8s命名规范的名称(小写字母,数字和-, 最长63位)
+// 重试时之前的Job可能还在删除中, 因此每次执行使用不同的名称
+func (r *k8sRunRequest) JobName() string {
+	return JobNameForStep(r.Step.AttemptKey())
+}
+
+func (r *k8sRunRequest) ContainerCMD() []string {
+	cmd := r.RunnerParams[IMAGE_CMD_KEY]
+	if cmd == "" {
+		return nil
+	}
+	return strings.Split(cmd, ",")
+}
+
+// ContainerEnv 按key排序, 保证生成的Job是稳定的
+func (r *k8sRunRequest) ContainerEnv() []corev1.EnvVar {
+	keys := make([]string, 0, len(r.RunParams))
+	for k := range r.RunParams {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	envs := make([]corev1.EnvVar, 0, len(keys))
+	for _, k := range keys {
+		envs = append(envs, corev1.EnvVar{Name: k, Value: r.RunParams[k]})
+	}
+	return envs
+}
+
+func (r *k8sRunRequest) Resources() (corev1.ResourceRequirements, error) {
+	res := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{},
+		Limits:   corev1.ResourceList{},
+	}
+
+	items := []struct {
+		key  string
+		name corev1.ResourceName
+		list corev1.ResourceList
+	}{
+		{CPU_REQUEST_KEY, corev1.ResourceCPU, res.Requests},
+		{MEMORY_REQUEST_KEY, corev1.ResourceMemory, res.Requests},
+		{CPU_LIMIT_KEY, corev1.ResourceCPU, res.Limits},
+		{MEMORY_LIMIT_KEY, corev1.ResourceMemory, res.Limits},
+	}
+	for _, item := range items {
+		v := r.RunnerParams[item.key]
+		if v == "" {
+			continue
+		}
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			return res, fmt.Errorf("parse %s error, %s", item.key, err)
+		}
+		item.list[item.name] = q
+	}
+
+	return res, nil
+}
+
+func (r *k8sRunRequest) Validate() error {
+	if r.Step == nil || r.Step.Key == "" {
+		return fmt.Errorf("step is nil or step key is \"\"")
+	}
+
+	if r.ImageURL() == "" {
+		return fmt.Errorf("%s missed", IMAGE_URL_KEY)
+	}
+
+	if _, err := r.Resources(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func newK8sCancelRequest(r *runner.CancelRequest) *k8sCancelRequest {
+	if r.Step != nil && r.Step.Status == nil {
+		r.Step.Status = pipeline.NewDefaultStepStatus()
+	}
+	return &k8sCancelRequest{r}
+}
+
+type k8sCancelRequest struct {
+	*runner.CancelRequest
+}
+
+func (r *k8sCancelRequest) JobName() string {
+	return r.response(JOB_NAME_KEY)
+}
+
+func (r *k8sCancelRequest) Namespace() string {
+	return r.response(JOB_NAMESPACE_KEY)
+}
+
+func (r *k8sCancelRequest) response(key string) string {
+	if r.Step == nil || r.Step.Status == nil || r.Step.Status.Response == nil {
+		return ""
+	}
+
+	return r.Step.Status.Response[key]
+}
+
+func (r *k8sCancelRequest) Validate() error {
+	if r.Step == nil || r.Step.Key == "" {
+		return fmt.Errorf("step is nil or step key is \"\"")
+	}
+
+	if r.JobName() == "" {
+		return fmt.Errorf("%s missed", JOB_NAME_KEY)
+	}
+
+	if r.Namespace() == "" {
+		return fmt.Errorf("%s missed", JOB_NAMESPACE_KEY)
+	}
+
+	return nil
+}
+
+// JobNameForStep 根据step key生成job名称, 超过63个字符时截断,
+// 并追加完整key的短hash, 避免前缀相同的key截断后名称冲突
+func JobNameForStep(key string) string {
+	name := invalidNameChars.ReplaceAllString(strings.ToLower(key), "-")
+	name = "step-" + strings.Trim(name, "-")
+	if len(name) <= JOB_NAME_MAX_LEN {
+		return name
+	}
+
+	sum := sha1.Sum([]byte(key))
+	suffix := hex.EncodeToString(sum[:])[:JOB_NAME_HASH_LEN]
+	name = strings.TrimRight(name[:JOB_NAME_MAX_LEN-JOB_NAME_HASH_LEN-1], "-")
+	return name + "-" + suffix
+}
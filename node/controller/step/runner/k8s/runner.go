@@ -2,34 +2,442 @@ package k8s
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/infraboard/workflow/api/apps/action"
 	"github.com/infraboard/workflow/node/controller/step/runner"
+	"github.com/infraboard/workflow/node/controller/step/store"
+)
+
+const (
+	IMAGE_URL_KEY      = "IMAGE_URL"
+	IMAGE_CMD_KEY      = "IMAGE_CMD"
+	IMAGE_VERSION_KEY  = "IMAGE_VERSION"
+	NAMESPACE_KEY      = "K8S_NAMESPACE"
+	CPU_REQUEST_KEY    = "CPU_REQUEST"
+	CPU_LIMIT_KEY      = "CPU_LIMIT"
+	MEMORY_REQUEST_KEY = "MEMORY_REQUEST"
+	MEMORY_LIMIT_KEY   = "MEMORY_LIMIT"
+)
+
+var (
+	IMAGE_URL_KEY_DESC = &action.RunParamDesc{
+		KeyName:   IMAGE_URL_KEY,
+		KeyDesc:   "镜像地址",
+		Required:  true,
+		ValueDesc: "镜像仓库地址, 比如busybox, 需要保证集群能拉取该镜像",
+	}
+	IMAGE_VERSION_KEY_DESC = &action.RunParamDesc{
+		KeyName:   IMAGE_VERSION_KEY,
+		KeyDesc:   "镜像版本",
+		Required:  false,
+		ValueDesc: "镜像对应的Tag, 比如latest",
+	}
+	IMAGE_CMD_KEY_DESC = &action.RunParamDesc{
+		KeyName:   IMAGE_CMD_KEY,
+		KeyDesc:   "执行命令",
+		Required:  false,
+		ValueDesc: "如果是多部分请用逗号分隔, 比如 sleep,10, 不填使用镜像默认命令",
+	}
+	NAMESPACE_KEY_DESC = &action.RunParamDesc{
+		KeyName:      NAMESPACE_KEY,
+		KeyDesc:      "K8s命名空间",
+		Required:     false,
+		DefaultValue: DEFAULT_NAMESPACE,
+		ValueDesc:    "Job创建在哪个命名空间",
+		Regex:        `^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`,
+		Max:          "63",
+	}
+	CPU_REQUEST_KEY_DESC = &action.RunParamDesc{
+		KeyName:   CPU_REQUEST_KEY,
+		KeyDesc:   "CPU请求",
+		Required:  false,
+		ValueDesc: "容器的cpu requests, 比如 100m",
+	}
+	CPU_LIMIT_KEY_DESC = &action.RunParamDesc{
+		KeyName:   CPU_LIMIT_KEY,
+		KeyDesc:   "CPU限制",
+		Required:  false,
+		ValueDesc: "容器的cpu limits, 比如 1",
+	}
+	MEMORY_REQUEST_KEY_DESC = &action.RunParamDesc{
+		KeyName:   MEMORY_REQUEST_KEY,
+		KeyDesc:   "内存请求",
+		Required:  false,
+		ValueDesc: "容器的memory requests, 比如 128Mi",
+	}
+	MEMORY_LIMIT_KEY_DESC = &action.RunParamDesc{
+		KeyName:   MEMORY_LIMIT_KEY,
+		KeyDesc:   "内存限制",
+		Required:  false,
+		ValueDesc: "容器的memory limits, 比如 1Gi",
+	}
 )
 
 func ParamsDesc() []*action.RunParamDesc {
-	return []*action.RunParamDesc{}
+	return []*action.RunParamDesc{
+		IMAGE_URL_KEY_DESC,
+		IMAGE_VERSION_KEY_DESC,
+		IMAGE_CMD_KEY_DESC,
+		NAMESPACE_KEY_DESC,
+		CPU_REQUEST_KEY_DESC,
+		CPU_LIMIT_KEY_DESC,
+		MEMORY_REQUEST_KEY_DESC,
+		MEMORY_LIMIT_KEY_DESC,
+	}
 }
 
+const (
+	JOB_NAME_KEY      = "job_name"
+	JOB_NAMESPACE_KEY = "job_namespace"
+	POD_NAME_KEY      = "pod_name"
+)
+
+const (
+	// Job还没有创建时收到的取消请求保留的时间, 超时后清理, 避免Run没有执行时一直占用
+	PENDING_CANCEL_TTL = 10 * time.Minute
+)
+
+// NewRunner 优先使用集群内的配置, 不在集群内时使用KUBECONFIG 或者 ~/.kube/config
 func NewRunner() *Runner {
-	return &Runner{}
+	r := NewRunnerWithClient(nil)
+
+	cfg, err := loadKubeConfig()
+	if err != nil {
+		r.err = fmt.Errorf("load kube config error, %s", err)
+		return r
+	}
+
+	cli, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		r.err = fmt.Errorf("new kube client error, %s", err)
+		return r
+	}
+
+	r.cli = cli
+	return r
+}
+
+func NewRunnerWithClient(cli kubernetes.Interface) *Runner {
+	return &Runner{
+		cli:          cli,
+		log:          zap.L().Named("Runner.K8s"),
+		store:        store.NewStore(),
+		logs:         runner.NewLogHub(),
+		pollInterval: 2 * time.Second,
+		canceled:     map[string]time.Time{},
+	}
+}
+
+func loadKubeConfig() (*rest.Config, error) {
+	if cfg, err := rest.InClusterConfig(); err == nil {
+		return cfg, nil
+	}
+
+	kubeconfig := os.Getenv("KUBECONFIG")
+	if kubeconfig == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		kubeconfig = filepath.Join(home, ".kube", "config")
+	}
+
+	return clientcmd.BuildConfigFromFlags("", kubeconfig)
 }
 
+// K8s Job 使用说明: https://kubernetes.io/docs/concepts/workloads/controllers/job/
 type Runner struct {
+	cli          kubernetes.Interface
+	log          logger.Logger
+	store        store.StoreFactory
+	logs         *runner.LogHub
+	pollInterval time.Duration
+	err          error
+
+	// 已经取消的step, 按AttemptKey索引, Job被删除后Run据此上报取消而不是失败
+	canceled map[string]time.Time
+	l        sync.Mutex
 }
 
+// SetPollInterval 设置查询Job/Pod状态的间隔
+func (r *Runner) SetPollInterval(d time.Duration) {
+	r.pollInterval = d
+}
+
+// Runner Params:
+//   IMAGE_URL: 镜像URL, 比如: busybox
+//   IMAGE_VERSION: 镜像版本 比如: v1
+//   IMAGE_CMD: 执行命令, 多部分以逗号分隔
+//   K8S_NAMESPACE: Job所在的命名空间
+//   CPU_REQUEST/CPU_LIMIT/MEMORY_REQUEST/MEMORY_LIMIT: 容器资源
+// Run Params:
+//   以环境变量的方式注入到容器中
+// Outputs:
+//   容器日志中打印 ::set-output name=KEY::VALUE
 func (r *Runner) Run(ctx context.Context, in *runner.RunRequest, out *runner.RunResponse) {
+	if r.err != nil {
+		out.Failed("k8s runner not ready, %s", r.err)
+		return
+	}
+
+	req := newK8sRunRequest(in)
+	if err := req.Validate(); err != nil {
+		out.Failed("validate k8s run request error, %s", err)
+		return
+	}
+	r.log.Debugf("k8s start run step: %s", in.Step.Key)
+
+	// Job被取消删除后, 后续的错误都上报为取消
+	key := in.Step.AttemptKey()
+	defer r.clearCanceled(key)
+	failed := func(format string, a ...interface{}) {
+		if r.isCanceled(key) {
+			out.Canceled("job canceled")
+			return
+		}
+		out.Failed(format, a...)
+	}
+	if r.isCanceled(key) {
+		out.Canceled("job canceled before start")
+		return
+	}
+
+	// 创建Job
+	job, err := newJob(req)
+	if err != nil {
+		out.Failed("new job error, %s", err)
+		return
+	}
+	job, err = r.cli.BatchV1().Jobs(job.Namespace).Create(ctx, job, metav1.CreateOptions{})
+	if err != nil {
+		out.Failed("create job error, %s", err)
+		return
+	}
+
+	// 退出时销毁Job
+	defer r.removeJob(job.Namespace, job.Name)
+
+	// 更新状态, 中间状态保持
+	up := r.store.NewFileUploader(in.Step.Key)
+	out.UpdateReponseMap("log_driver", up.DriverName())
+	out.UpdateReponseMap("log_path", up.ObjectID())
+	out.UpdateReponseMap(JOB_NAME_KEY, job.Name)
+	out.UpdateReponseMap(JOB_NAMESPACE_KEY, job.Namespace)
+	out.UpdateResponse(in.Step)
+
+	// 上报Job名称之前收到的取消请求无法删除Job, 由这里处理
+	if r.isCanceled(key) {
+		out.Canceled("job canceled")
+		return
+	}
+
+	// 等待Pod启动
+	pod, err := r.waitPodStarted(ctx, job.Namespace, job.Name)
+	if err != nil {
+		failed(err.Error())
+		return
+	}
+	out.UpdateReponseMap(POD_NAME_KEY, pod.Name)
+	out.UpdateResponse(in.Step)
+
+	// 上传Pod日志, 日志流在容器退出后结束
+	logStream, err := r.cli.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container: STEP_CONTAINER,
+		Follow:    true,
+	}).Stream(ctx)
+	if err != nil {
+		failed("get pod log error, %s", err)
+		return
+	}
+	// Pod运行在集群中, 无法挂载Node本地目录, 只能通过日志中的标记收集输出
+	oc := in.NewOutputCollector()
+	if err := up.Upload(ctx, oc.Tee(r.logs.Tee(in.Step.Key, in.MaskLog(logStream)))); err != nil {
+		failed(err.Error())
+		return
+	}
+
+	// 等待Job结束
+	if err := r.waitJobComplete(ctx, job.Namespace, job.Name); err != nil {
+		failed(err.Error())
+		return
+	}
+
+	out.LoadOutputs(oc)
+	out.UpdateResponse(in.Step)
 }
 
-func (r *Runner) Log(context.Context, *runner.LogRequest) (io.ReadCloser, error) {
-	return nil, nil
+func (r *Runner) getJob(ctx context.Context, namespace, name string) (*batchv1.Job, error) {
+	job, err := r.cli.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, fmt.Errorf("job %s/%s has been deleted", namespace, name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get job error, %s", err)
+	}
+	return job, nil
 }
 
-func (r *Runner) Connect(context.Context, *runner.ConnectRequest) error {
+// 等待Job对应的Pod脱离Pending状态, 镜像拉取失败这类不会自愈的错误直接返回
+func (r *Runner) waitPodStarted(ctx context.Context, namespace, jobName string) (*corev1.Pod, error) {
+	var pod *corev1.Pod
+	err := wait.PollImmediateUntil(r.pollInterval, func() (bool, error) {
+		job, err := r.getJob(ctx, namespace, jobName)
+		if err != nil {
+			return false, err
+		}
+		if _, msg := jobCondition(job); msg != "" {
+			return false, fmt.Errorf("job run failed, %s", msg)
+		}
+
+		pods, err := r.cli.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", STEP_LABEL_KEY, jobName),
+		})
+		if err != nil {
+			return false, fmt.Errorf("list job pods error, %s", err)
+		}
+
+		for i := range pods.Items {
+			p := &pods.Items[i]
+			if p.Status.Phase != corev1.PodPending {
+				pod = p
+				return true, nil
+			}
+			if reason := podWaitingError(p); reason != "" {
+				return false, fmt.Errorf("pod %s start failed, %s", p.Name, reason)
+			}
+		}
+		return false, nil
+	}, ctx.Done())
+	if err != nil {
+		return nil, fmt.Errorf("wait job pod started error, %s", err)
+	}
+
+	return pod, nil
+}
+
+func podWaitingError(p *corev1.Pod) string {
+	for _, cs := range p.Status.ContainerStatuses {
+		if cs.State.Waiting == nil {
+			continue
+		}
+		switch cs.State.Waiting.Reason {
+		case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "CreateContainerConfigError":
+			return cs.State.Waiting.Reason + ": " + cs.State.Waiting.Message
+		}
+	}
+	return ""
+}
+
+func (r *Runner) waitJobComplete(ctx context.Context, namespace, name string) error {
+	var failedMessage string
+	err := wait.PollImmediateUntil(r.pollInterval, func() (bool, error) {
+		job, err := r.getJob(ctx, namespace, name)
+		if err != nil {
+			return false, err
+		}
+
+		complete, msg := jobCondition(job)
+		failedMessage = msg
+		return complete, nil
+	}, ctx.Done())
+	if err != nil {
+		return fmt.Errorf("wait job complete error, %s", err)
+	}
+
+	if failedMessage != "" {
+		return fmt.Errorf("job run failed, %s", failedMessage)
+	}
 	return nil
 }
 
-func (r *Runner) Cancel(context.Context, *runner.CancelRequest) {
-	return
+// 删除Job, 同时删除Job创建的Pod
+func (r *Runner) deleteJob(ctx context.Context, namespace, name string) error {
+	policy := metav1.DeletePropagationBackground
+	return r.cli.BatchV1().Jobs(namespace).Delete(ctx, name, metav1.DeleteOptions{
+		PropagationPolicy: &policy,
+	})
+}
+
+func (r *Runner) removeJob(namespace, name string) {
+	err := r.deleteJob(context.Background(), namespace, name)
+	if err != nil && !errors.IsNotFound(err) {
+		r.log.Errorf("remove job %s/%s failed, %s", namespace, name, err)
+	}
+}
+
+// Cancel 记录取消后删除Job, Run发现Job被删除后上报取消
+// Job还没有创建或者还没有上报Job名称时, 只记录取消, 由Run处理
+func (r *Runner) Cancel(ctx context.Context, in *runner.CancelRequest) {
+	if in.Step == nil || in.Step.Key == "" {
+		return
+	}
+	r.markCanceled(in.Step.AttemptKey())
+
+	if r.err != nil {
+		in.Step.Failed("k8s runner not ready, %s", r.err)
+		return
+	}
+
+	req := newK8sCancelRequest(in)
+	if err := req.Validate(); err != nil {
+		r.log.Debugf("job of step %s not created, %s, cancel when it starts", in.Step.Key, err)
+		return
+	}
+
+	if err := r.deleteJob(ctx, req.Namespace(), req.JobName()); err != nil && !errors.IsNotFound(err) {
+		in.Step.Failed("cancel job error, %s", err)
+		return
+	}
+}
+
+func (r *Runner) markCanceled(key string) {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	for k, t := range r.canceled {
+		if time.Since(t) > PENDING_CANCEL_TTL {
+			delete(r.canceled, k)
+		}
+	}
+	r.canceled[key] = time.Now()
+}
+
+func (r *Runner) isCanceled(key string) bool {
+	r.l.Lock()
+	defer r.l.Unlock()
+	_, ok := r.canceled[key]
+	return ok
+}
+
+func (r *Runner) clearCanceled(key string) {
+	r.l.Lock()
+	defer r.l.Unlock()
+	delete(r.canceled, key)
+}
+
+// Log 读取Pod运行中的日志, 结束后的日志从store中读取
+func (r *Runner) Log(ctx context.Context, in *runner.LogRequest) (io.ReadCloser, error) {
+	return r.logs.Open(ctx, in)
+}
+
+// Connect 暂不支持连接到Pod
+func (r *Runner) Connect(context.Context, *runner.ConnectRequest) error {
+	return runner.ErrConnectNotSupport
 }
@@ -0,0 +1,232 @@
+package k8s_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/infraboard/mcube/logger/zap"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/node/controller/step/runner"
+	"github.com/infraboard/workflow/node/controller/step/runner/k8s"
+)
+
+func testUpdater(s *pipeline.Step) {}
+
+func newTestRunner() (*k8s.Runner, *fake.Clientset) {
+	cs := fake.NewSimpleClientset()
+	r := k8s.NewRunnerWithClient(cs)
+	r.SetPollInterval(10 * time.Millisecond)
+	return r, cs
+}
+
+func newTestRequest(key string) *runner.RunRequest {
+	req := runner.NewRunRequest(&pipeline.Step{Key: key})
+	req.LoadRunnerParams(map[string]string{
+		k8s.IMAGE_URL_KEY:     "busybox",
+		k8s.IMAGE_VERSION_KEY: "1.34",
+		k8s.IMAGE_CMD_KEY:     "sh,-c,echo $ENV1",
+		k8s.NAMESPACE_KEY:     "ci",
+		k8s.CPU_LIMIT_KEY:     "500m",
+		k8s.MEMORY_LIMIT_KEY:  "256Mi",
+	})
+	req.LoadRunParams(map[string]string{"ENV1": "env1", "ENV2": "env2"})
+	return req
+}
+
+// 模拟Job Controller: 等待Job创建后 创建对应的Pod, 然后更新Job状态
+func simulateJob(t *testing.T, cs *fake.Clientset, namespace, name string, status batchv1.JobStatus) {
+	ctx := context.Background()
+	var job *batchv1.Job
+	for i := 0; i < 200; i++ {
+		j, err := cs.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			job = j
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if job == nil {
+		t.Errorf("job %s/%s not created", namespace, name)
+		return
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name + "-abcde",
+			Namespace: namespace,
+			Labels:    job.Spec.Template.Labels,
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	if _, err := cs.CoreV1().Pods(namespace).Create(ctx, pod, metav1.CreateOptions{}); err != nil {
+		t.Error(err)
+		return
+	}
+
+	job.Status = status
+	if _, err := cs.BatchV1().Jobs(namespace).UpdateStatus(ctx, job, metav1.UpdateOptions{}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestJobNameForStep(t *testing.T) {
+	should := assert.New(t)
+	should.Equal("step-c16mhsddrei91m4ri0jg-c3iqcama0brimaq08e40-2-1",
+		k8s.JobNameForStep("c16mhsddrei91m4ri0jg.c3iqcama0brimaq08e40.2.1"))
+	should.LessOrEqual(len(k8s.JobNameForStep("A_very.long.KEY.that.exceeds.the.kubernetes.name.limit.of.63.chars")), 63)
+
+	// 截断后前缀相同的key不能冲突
+	a := k8s.JobNameForStep("c16mhsddrei91m4ri0jg.c3iqcama0brimaq08e40.c3iqcama0brimaq08e4g.2.1")
+	b := k8s.JobNameForStep("c16mhsddrei91m4ri0jg.c3iqcama0brimaq08e40.c3iqcama0brimaq08e4g.2.2")
+	should.LessOrEqual(len(a), 63)
+	should.LessOrEqual(len(b), 63)
+	should.NotEqual(a, b)
+	should.Regexp("^[a-z0-9]([a-z0-9-]*[a-z0-9])?$", a)
+}
+
+func TestRunSucceeded(t *testing.T) {
+	should := assert.New(t)
+	r, cs := newTestRunner()
+	req := newTestRequest("ns.pipeline.1.1")
+	resp := runner.NewRunReponse(testUpdater)
+
+	name := k8s.JobNameForStep(req.Step.Key)
+	go simulateJob(t, cs, "ci", name, batchv1.JobStatus{Succeeded: 1})
+	r.Run(context.Background(), req, resp)
+	should.False(resp.HasError(), resp.ErrorMessage())
+
+	should.Equal(name, req.Step.Status.Response[k8s.JOB_NAME_KEY])
+	should.Equal("ci", req.Step.Status.Response[k8s.JOB_NAMESPACE_KEY])
+	should.Equal(name+"-abcde", req.Step.Status.Response[k8s.POD_NAME_KEY])
+
+	// 执行完成后Job会被清理
+	_, err := cs.BatchV1().Jobs("ci").Get(context.Background(), name, metav1.GetOptions{})
+	should.Error(err)
+}
+
+func TestRunJobSpec(t *testing.T) {
+	should := assert.New(t)
+	r, cs := newTestRunner()
+	req := newTestRequest("ns.pipeline.1.2")
+	resp := runner.NewRunReponse(testUpdater)
+
+	name := k8s.JobNameForStep(req.Step.Key)
+	jobCh := make(chan *batchv1.Job, 1)
+	go func() {
+		for i := 0; i < 200; i++ {
+			if j, err := cs.BatchV1().Jobs("ci").Get(context.Background(), name, metav1.GetOptions{}); err == nil {
+				jobCh <- j
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		simulateJob(t, cs, "ci", name, batchv1.JobStatus{Succeeded: 1})
+	}()
+	r.Run(context.Background(), req, resp)
+
+	job := <-jobCh
+	c := job.Spec.Template.Spec.Containers[0]
+	should.Equal("busybox:1.34", c.Image)
+	should.Equal([]string{"sh", "-c", "echo $ENV1"}, c.Command)
+	should.Equal([]corev1.EnvVar{{Name: "ENV1", Value: "env1"}, {Name: "ENV2", Value: "env2"}}, c.Env)
+	should.Equal("500m", c.Resources.Limits.Cpu().String())
+	should.Equal("256Mi", c.Resources.Limits.Memory().String())
+	should.Equal(corev1.RestartPolicyNever, job.Spec.Template.Spec.RestartPolicy)
+}
+
+func TestRunFailed(t *testing.T) {
+	should := assert.New(t)
+	r, cs := newTestRunner()
+	req := newTestRequest("ns.pipeline.1.3")
+	resp := runner.NewRunReponse(testUpdater)
+
+	go simulateJob(t, cs, "ci", k8s.JobNameForStep(req.Step.Key), batchv1.JobStatus{
+		Failed: 1,
+		Conditions: []batchv1.JobCondition{
+			{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded", Message: "Job has reached the specified backoff limit"},
+		},
+	})
+	r.Run(context.Background(), req, resp)
+	should.True(resp.HasError())
+	should.Contains(resp.ErrorMessage(), "BackoffLimitExceeded")
+}
+
+func TestRunValidateFailed(t *testing.T) {
+	should := assert.New(t)
+	r, _ := newTestRunner()
+	req := newTestRequest("ns.pipeline.1.4")
+	req.RunnerParams[k8s.MEMORY_LIMIT_KEY] = "xxx"
+	resp := runner.NewRunReponse(testUpdater)
+
+	r.Run(context.Background(), req, resp)
+	should.Contains(resp.ErrorMessage(), k8s.MEMORY_LIMIT_KEY)
+}
+
+func TestCancelStep(t *testing.T) {
+	should := assert.New(t)
+	r, cs := newTestRunner()
+	req := newTestRequest("ns.pipeline.1.5")
+	resp := runner.NewRunReponse(testUpdater)
+
+	done := make(chan struct{})
+	go func() {
+		r.Run(context.Background(), req, resp)
+		close(done)
+	}()
+
+	// Job一直处于Pending, 取消后Run退出
+	name := k8s.JobNameForStep(req.Step.Key)
+	for i := 0; i < 200; i++ {
+		if _, err := cs.BatchV1().Jobs("ci").Get(context.Background(), name, metav1.GetOptions{}); err == nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	s := &pipeline.Step{Key: req.Step.Key, Status: pipeline.NewDefaultStepStatus()}
+	s.Status.Response[k8s.JOB_NAME_KEY] = name
+	s.Status.Response[k8s.JOB_NAMESPACE_KEY] = "ci"
+	r.Cancel(context.Background(), runner.NewCancelRequest(s))
+	should.Equal(pipeline.STEP_STATUS_PENDDING, s.Status.Status)
+
+	select {
+	case <-done:
+		should.True(resp.IsCanceled())
+		should.False(resp.HasError(), resp.ErrorMessage())
+	case <-time.After(5 * time.Second):
+		t.Fatal("job not canceled")
+	}
+}
+
+func TestCancelStepBeforeStart(t *testing.T) {
+	should := assert.New(t)
+	r, cs := newTestRunner()
+	req := newTestRequest("ns.pipeline.1.6")
+	resp := runner.NewRunReponse(testUpdater)
+
+	// 还没有上报Job名称时取消, 只记录取消
+	s := &pipeline.Step{Key: req.Step.Key}
+	r.Cancel(context.Background(), runner.NewCancelRequest(s))
+	should.Equal(pipeline.STEP_STATUS_PENDDING, s.Status.Status)
+
+	r.Run(context.Background(), req, resp)
+	should.True(resp.IsCanceled())
+	should.False(resp.HasError(), resp.ErrorMessage())
+
+	// 不会创建Job
+	_, err := cs.BatchV1().Jobs("ci").Get(context.Background(), k8s.JobNameForStep(req.Step.Key), metav1.GetOptions{})
+	should.Error(err)
+}
+
+func init() {
+	if err := zap.DevelopmentSetup(); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,138 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"time"
+
+	"github.com/infraboard/workflow/node/controller/step/runner"
+)
+
+const (
+	// 进程退出后等待剩余输出的时间
+	OUTPUT_DRAIN_TIMEOUT = time.Second
+)
+
+// Connect 在step的工作目录中启动新的进程, 环境变量和step的进程一致
+// 进程退出或者连接断开时返回, 进程的退出码不作为错误返回
+func (r *Runner) Connect(ctx context.Context, in *runner.ConnectRequest) error {
+	r.l.Lock()
+	p, ok := r.processes[in.Step.Key]
+	var workspace string
+	var env []string
+	if ok {
+		workspace, env = p.workspace, p.env
+	}
+	r.l.Unlock()
+	if !ok || workspace == "" {
+		return fmt.Errorf("step %s process not found", in.Step.Key)
+	}
+
+	cmd := exec.Command(in.Command[0], in.Command[1:]...)
+	cmd.Dir = workspace
+	cmd.Env = env
+
+	r.log.Debugf("connect to step %s, command: %s, tty: %t", in.Step.Key, in.Command, in.Tty)
+	if in.Tty {
+		return r.connectTty(ctx, cmd, in)
+	}
+	return r.connect(ctx, cmd, in)
+}
+
+func (r *Runner) connect(ctx context.Context, cmd *exec.Cmd, in *runner.ConnectRequest) error {
+	setProcessGroup(cmd)
+	cmd.Stdout = in.Stdout
+	cmd.Stderr = in.Stderr
+	if cmd.Stderr == nil {
+		cmd.Stderr = in.Stdout
+	}
+
+	// 自己拷贝输入, 避免Wait等待输入结束
+	var stdin io.WriteCloser
+	if in.Stdin != nil {
+		var err error
+		if stdin, err = cmd.StdinPipe(); err != nil {
+			return err
+		}
+	}
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("start process error, %s", err)
+	}
+	if stdin != nil {
+		go func() {
+			io.Copy(stdin, in.Stdin)
+			stdin.Close()
+		}()
+	}
+
+	return r.wait(ctx, cmd)
+}
+
+func (r *Runner) connectTty(ctx context.Context, cmd *exec.Cmd, in *runner.ConnectRequest) error {
+	ptmx, tty, err := openPty()
+	if err != nil {
+		return fmt.Errorf("open pty error, %s", err)
+	}
+	defer ptmx.Close()
+
+	setControllingTerminal(cmd)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = tty, tty, tty
+	err = cmd.Start()
+	tty.Close()
+	if err != nil {
+		return fmt.Errorf("start process error, %s", err)
+	}
+
+	if in.TerminalSizeQueue != nil {
+		go func() {
+			for size := in.TerminalSizeQueue.Next(); size != nil; size = in.TerminalSizeQueue.Next() {
+				if err := setPtySize(ptmx, size); err != nil {
+					r.log.Debugf("resize pty error, %s", err)
+				}
+			}
+		}()
+	}
+	if in.Stdin != nil {
+		go io.Copy(ptmx, in.Stdin)
+	}
+	outputDone := make(chan struct{})
+	go func() {
+		io.Copy(in.Stdout, ptmx)
+		close(outputDone)
+	}()
+
+	err = r.wait(ctx, cmd)
+
+	// 进程退出后, 把还没有读取的输出发送完
+	select {
+	case <-outputDone:
+	case <-time.After(OUTPUT_DRAIN_TIMEOUT):
+	}
+	return err
+}
+
+// 等待进程退出, 连接断开时结束整个进程组
+func (r *Runner) wait(ctx context.Context, cmd *exec.Cmd) error {
+	waitErr := make(chan error, 1)
+	go func() {
+		waitErr <- cmd.Wait()
+	}()
+
+	var err error
+	select {
+	case err = <-waitErr:
+	case <-ctx.Done():
+		if err := killProcessGroup(cmd); err != nil {
+			r.log.Errorf("kill process %d error, %s", cmd.Process.Pid, err)
+		}
+		err = <-waitErr
+	}
+
+	if _, ok := err.(*exec.ExitError); ok {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,18 @@
+//go:build !windows
+// +build !windows
+
+package local
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// 子进程单独成组, 方便结束时连同其派生的进程一起结束
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+func killProcessGroup(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
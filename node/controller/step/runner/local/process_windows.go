@@ -0,0 +1,14 @@
+//go:build windows
+// +build windows
+
+package local
+
+import (
+	"os/exec"
+)
+
+func setProcessGroup(cmd *exec.Cmd) {}
+
+func killProcessGroup(cmd *exec.Cmd) error {
+	return cmd.Process.Kill()
+}
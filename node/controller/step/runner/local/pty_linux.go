@@ -0,0 +1,64 @@
+//go:build linux
+// +build linux
+
+package local
+
+import (
+	"os"
+	"os/exec"
+	"strconv"
+	"syscall"
+	"unsafe"
+
+	"github.com/infraboard/workflow/node/controller/step/runner"
+)
+
+// 打开一对伪终端, ptmx由当前进程读写, tty作为子进程的标准输入输出
+func openPty() (ptmx, tty *os.File, err error) {
+	ptmx, err = os.OpenFile("/dev/ptmx", os.O_RDWR|syscall.O_NOCTTY|syscall.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if err != nil {
+			ptmx.Close()
+		}
+	}()
+
+	// 解锁并获取对应的tty编号
+	var unlock int32
+	if err = ioctl(ptmx.Fd(), syscall.TIOCSPTLCK, uintptr(unsafe.Pointer(&unlock))); err != nil {
+		return nil, nil, err
+	}
+	var n uint32
+	if err = ioctl(ptmx.Fd(), syscall.TIOCGPTN, uintptr(unsafe.Pointer(&n))); err != nil {
+		return nil, nil, err
+	}
+
+	tty, err = os.OpenFile("/dev/pts/"+strconv.Itoa(int(n)), os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ptmx, tty, nil
+}
+
+// 修改终端的大小
+func setPtySize(ptmx *os.File, size *runner.TerminalSize) error {
+	ws := struct {
+		Row, Col, X, Y uint16
+	}{Row: size.Height, Col: size.Width}
+	return ioctl(ptmx.Fd(), syscall.TIOCSWINSZ, uintptr(unsafe.Pointer(&ws)))
+}
+
+// 子进程使用新的会话, 并把tty作为控制终端
+func setControllingTerminal(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true, Setctty: true}
+}
+
+func ioctl(fd, cmd, arg uintptr) error {
+	_, _, e := syscall.Syscall(syscall.SYS_IOCTL, fd, cmd, arg)
+	if e != 0 {
+		return e
+	}
+	return nil
+}
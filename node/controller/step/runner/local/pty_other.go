@@ -0,0 +1,22 @@
+//go:build !linux
+// +build !linux
+
+package local
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+
+	"github.com/infraboard/workflow/node/controller/step/runner"
+)
+
+func openPty() (ptmx, tty *os.File, err error) {
+	return nil, nil, errors.New("tty only supported on linux")
+}
+
+func setPtySize(ptmx *os.File, size *runner.TerminalSize) error {
+	return nil
+}
+
+func setControllingTerminal(cmd *exec.Cmd) {}
@@ -0,0 +1,97 @@
+package local
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/node/controller/step/runner"
+)
+
+func newLocalRunRequest(r *runner.RunRequest) *localRunRequest {
+	if r.Step != nil && r.Step.Status == nil {
+		r.Step.Status = pipeline.NewDefaultStepStatus()
+	}
+	return &localRunRequest{r}
+}
+
+type localRunRequest struct {
+	*runner.RunRequest
+}
+
+func (r *localRunRequest) Command() string {
+	return r.RunnerParams[CMD_KEY]
+}
+
+func (r *localRunRequest) Shell() string {
+	if sh := r.RunnerParams[SHELL_KEY]; sh != "" {
+		return sh
+	}
+	return DEFAULT_SHELL
+}
+
+// 每个step都有自己独立的工作目录: <WORKDIR>/<step key>, 执行结束后删除
+func (r *localRunRequest) Workspace() string {
+	root := r.RunnerParams[WORKDIR_KEY]
+	if root == "" {
+		root = DEFAULT_WORKDIR
+	}
+	return filepath.Join(root, r.Step.Key)
+}
+
+// 输出文件放在工作目录中, 使用绝对路径, 避免进程切换目录后找不到
+func (r *localRunRequest) OutputFile() string {
+	path := filepath.Join(r.Workspace(), OUTPUT_FILE_NAME)
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return path
+}
+
+// 超时时间, 支持 30s, 5m 这种格式, 也支持直接填写秒数
+func (r *localRunRequest) Timeout() (time.Duration, error) {
+	v := r.RunnerParams[TIMEOUT_KEY]
+	if v == "" {
+		return 0, nil
+	}
+
+	if d, err := time.ParseDuration(v); err == nil {
+		return d, nil
+	}
+
+	d, err := time.ParseDuration(v + "s")
+	if err != nil {
+		return 0, fmt.Errorf("parse %s error, %s", TIMEOUT_KEY, err)
+	}
+	return d, nil
+}
+
+// 进程只使用节点配置的环境变量白名单(step_env.allow), 并注入step的运行参数
+func (r *localRunRequest) ProcessEnv() []string {
+	envs := make([]string, 0, len(r.Env)+len(r.RunParams)+1)
+	for k, v := range r.Env {
+		envs = append(envs, fmt.Sprintf("%s=%s", k, v))
+	}
+	for k, v := range r.RunParams {
+		envs = append(envs, fmt.Sprintf("%s=%s", k, v))
+	}
+	envs = append(envs, fmt.Sprintf("%s=%s", runner.OUTPUT_FILE_ENV, r.OutputFile()))
+	return envs
+}
+
+func (r *localRunRequest) Validate() error {
+	if r.Step == nil || r.Step.Key == "" {
+		return fmt.Errorf("step is nil or step key is \"\"")
+	}
+
+	if r.Command() == "" {
+		return fmt.Errorf("%s missed", CMD_KEY)
+	}
+
+	if _, err := r.Timeout(); err != nil {
+		return err
+	}
+
+	return nil
+}
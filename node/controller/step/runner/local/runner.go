@@ -2,34 +2,315 @@ package local
 
 import (
 	"context"
+	"errors"
 	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
 
 	"github.com/infraboard/workflow/api/apps/action"
 	"github.com/infraboard/workflow/node/controller/step/runner"
+	"github.com/infraboard/workflow/node/controller/step/store"
+)
+
+const (
+	CMD_KEY     = "CMD"
+	SHELL_KEY   = "SHELL"
+	WORKDIR_KEY = "WORKDIR"
+	TIMEOUT_KEY = "TIMEOUT"
+)
+
+const (
+	DEFAULT_SHELL   = "/bin/sh"
+	DEFAULT_WORKDIR = "workspace"
+	// 进程通过该文件输出变量, 每行一个 KEY=VALUE
+	OUTPUT_FILE_NAME = ".workflow_output"
+)
+
+var (
+	CMD_KEY_DESC = &action.RunParamDesc{
+		KeyName:   CMD_KEY,
+		KeyDesc:   "执行命令",
+		Required:  true,
+		ValueDesc: "交给shell执行的脚本, 比如 make build, 运行参数会以环境变量的方式注入, 输出变量可以写入 $WORKFLOW_OUTPUT 文件或者打印 ::set-output name=KEY::VALUE",
+	}
+	SHELL_KEY_DESC = &action.RunParamDesc{
+		KeyName:      SHELL_KEY,
+		KeyDesc:      "执行shell",
+		Required:     false,
+		DefaultValue: DEFAULT_SHELL,
+		ValueDesc:    "执行命令使用的shell, 以 -c 的方式调用",
+	}
+	WORKDIR_KEY_DESC = &action.RunParamDesc{
+		KeyName:      WORKDIR_KEY,
+		KeyDesc:      "工作目录",
+		Required:     false,
+		DefaultValue: DEFAULT_WORKDIR,
+		ValueDesc:    "工作目录的根目录, 每个step会在该目录下创建以step key命名的独立目录, 执行结束后删除",
+		ValueType:    action.PARAM_VALUE_TYPE_FILE,
+	}
+	TIMEOUT_KEY_DESC = &action.RunParamDesc{
+		KeyName:   TIMEOUT_KEY,
+		KeyDesc:   "超时时间",
+		Required:  false,
+		ValueDesc: "超时后进程会被强制结束, 比如 30s, 10m, 不填表示不超时",
+	}
 )
 
 func ParamsDesc() []*action.RunParamDesc {
-	return []*action.RunParamDesc{}
+	return []*action.RunParamDesc{
+		CMD_KEY_DESC,
+		SHELL_KEY_DESC,
+		WORKDIR_KEY_DESC,
+		TIMEOUT_KEY_DESC,
+	}
 }
 
+const (
+	PROCESS_ID_KEY = "process_id"
+	WORKSPACE_KEY  = "workspace"
+)
+
+const (
+	// 进程启动前收到的取消请求保留的时间, 超过后认为step不会再运行
+	PENDING_CANCEL_TTL = 10 * time.Minute
+	// 进程退出后等待日志管道关闭的时间, 后台子进程持有管道时不会一直阻塞Wait
+	PROCESS_WAIT_DELAY = 5 * time.Second
+)
+
 func NewRunner() *Runner {
-	return &Runner{}
+	return &Runner{
+		log:       zap.L().Named("Runner.Local"),
+		store:     store.NewStore(),
+		logs:      runner.NewLogHub(),
+		processes: map[string]*process{},
+		canceled:  map[string]time.Time{},
+	}
 }
 
+// Runner 在Node节点上以子进程的方式执行命令
 type Runner struct {
+	log   logger.Logger
+	store store.StoreFactory
+	logs  *runner.LogHub
+
+	processes map[string]*process
+	// 进程启动前收到的取消请求, key为step key, value为收到的时间
+	canceled map[string]time.Time
+	l        sync.Mutex
+}
+
+type process struct {
+	cancel   context.CancelFunc
+	canceled bool
+	// 连接时使用和step进程一样的工作目录和环境变量
+	workspace string
+	env       []string
 }
 
+// Runner Params:
+//   CMD: 需要执行的命令
+//   SHELL: 执行命令的shell, 默认/bin/sh
+//   WORKDIR: 工作目录的根目录, 默认workspace
+//   TIMEOUT: 超时时间
+// Run Params:
+//   以环境变量的方式注入到进程中
 func (r *Runner) Run(ctx context.Context, in *runner.RunRequest, out *runner.RunResponse) {
+	req := newLocalRunRequest(in)
+	if err := req.Validate(); err != nil {
+		out.Failed("validate local run request error, %s", err)
+		return
+	}
+	r.log.Debugf("local start run step: %s", in.Step.Key)
+
+	timeout, _ := req.Timeout()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// 进程启动前先登记, 启动前收到的取消请求也能生效
+	p := r.addProcess(in.Step.Key, &process{cancel: cancel})
+	defer r.removeProcess(in.Step.Key)
+	if r.isCanceled(p) {
+		out.Canceled("process canceled before start")
+		return
+	}
+
+	// 准备工作目录, 执行结束后删除
+	ws := req.Workspace()
+	if err := os.MkdirAll(ws, os.ModePerm); err != nil {
+		out.Failed("create workspace error, %s", err)
+		return
+	}
+	defer func() {
+		if err := os.RemoveAll(ws); err != nil {
+			r.log.Errorf("remove step %s workspace %s error, %s", in.Step.Key, ws, err)
+		}
+	}()
+	// 清除上次运行残留的输出
+	if err := os.Remove(req.OutputFile()); err != nil && !os.IsNotExist(err) {
+		out.Failed("clean output file error, %s", err)
+		return
+	}
+
+	cmd := exec.Command(req.Shell(), "-c", req.Command())
+	cmd.Dir = ws
+	cmd.Env = req.ProcessEnv()
+	cmd.WaitDelay = PROCESS_WAIT_DELAY
+	setProcessGroup(cmd)
+
+	// stdout和stderr合并后一起上传
+	pr, pw := io.Pipe()
+	cmd.Stdout = pw
+	cmd.Stderr = pw
+
+	if err := cmd.Start(); err != nil {
+		pw.Close()
+		out.Failed("start process error, %s", err)
+		return
+	}
+	r.startProcess(p, ws, cmd.Env)
+
+	// 更新状态, 中间状态保持
+	up := r.store.NewFileUploader(in.Step.Key)
+	out.UpdateReponseMap("log_driver", up.DriverName())
+	out.UpdateReponseMap("log_path", up.ObjectID())
+	out.UpdateReponseMap(PROCESS_ID_KEY, strconv.Itoa(cmd.Process.Pid))
+	out.UpdateReponseMap(WORKSPACE_KEY, ws)
+	out.UpdateResponse(in.Step)
+
+	// 上传进程日志, 同时收集日志中的输出
+	// 日志在进程退出后结束, 取消或者超时时也需要上传完整的日志, 不使用step的ctx
+	oc := in.NewOutputCollector()
+	uploadErr := make(chan error, 1)
+	go func() {
+		uploadErr <- up.Upload(context.Background(), oc.Tee(r.logs.Tee(in.Step.Key, in.MaskLog(pr))))
+	}()
+
+	// 等待进程退出, 超时或者取消时 结束整个进程组
+	waitErr := make(chan error, 1)
+	go func() {
+		waitErr <- cmd.Wait()
+	}()
+
+	var err error
+	select {
+	case err = <-waitErr:
+	case <-ctx.Done():
+		r.log.Debugf("step %s context done, kill process %d", in.Step.Key, cmd.Process.Pid)
+		if err := killProcessGroup(cmd); err != nil {
+			r.log.Errorf("kill process %d error, %s", cmd.Process.Pid, err)
+		}
+		err = <-waitErr
+	}
+	pw.Close()
+	// 进程已经正常退出, 只是后台子进程还持有日志管道
+	if errors.Is(err, exec.ErrWaitDelay) {
+		r.log.Warnf("step %s process %d exited, but log pipe still held by child process", in.Step.Key, cmd.Process.Pid)
+		err = nil
+	}
+
+	if upErr := <-uploadErr; upErr != nil {
+		out.Failed("upload process log error, %s", upErr)
+	}
+
+	// 收集进程的输出
+	if err := oc.ParseFile(req.OutputFile()); err != nil {
+		out.Failed("collect process output error, %s", err)
+	}
+	out.LoadOutputs(oc)
+	out.UpdateResponse(in.Step)
+
+	switch {
+	case r.isCanceled(p):
+		out.Canceled("process canceled")
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		out.Failed("process run timeout, timeout is %s", timeout)
+	case err != nil:
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			out.Failed("process run failed, exit code is %d", exitErr.ExitCode())
+			return
+		}
+		out.Failed("wait process error, %s", err)
+	}
 }
 
-func (r *Runner) Log(context.Context, *runner.LogRequest) (io.ReadCloser, error) {
-	return nil, nil
+func (r *Runner) addProcess(key string, p *process) *process {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	if _, ok := r.canceled[key]; ok {
+		delete(r.canceled, key)
+		p.canceled = true
+	}
+	r.processes[key] = p
+	return p
 }
 
-func (r *Runner) Connect(context.Context, *runner.ConnectRequest) error {
-	return nil
+// 进程启动后, 记录连接时需要的工作目录和环境变量
+func (r *Runner) startProcess(p *process, workspace string, env []string) {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	p.workspace = workspace
+	p.env = env
 }
 
-func (r *Runner) Cancel(context.Context, *runner.CancelRequest) {
-	return
+func (r *Runner) removeProcess(key string) {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	delete(r.processes, key)
+}
+
+func (r *Runner) isCanceled(p *process) bool {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	return p.canceled
 }
+
+func (r *Runner) Cancel(ctx context.Context, in *runner.CancelRequest) {
+	if in.Step == nil || in.Step.Key == "" {
+		return
+	}
+
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	// 进程还没有登记时, 记录取消请求, 由Run在启动进程前检查
+	p, ok := r.processes[in.Step.Key]
+	if !ok {
+		r.log.Debugf("step %s process not found, cancel it when process start", in.Step.Key)
+		r.prunePendingCancel()
+		r.canceled[in.Step.Key] = time.Now()
+		return
+	}
+
+	p.canceled = true
+	p.cancel()
+}
+
+// 清理过期的取消请求, 比如step在收到取消前已经结束
+func (r *Runner) prunePendingCancel() {
+	for k, t := range r.canceled {
+		if time.Since(t) > PENDING_CANCEL_TTL {
+			delete(r.canceled, k)
+		}
+	}
+}
+
+// Log 读取进程运行中的日志, 结束后的日志从store中读取
+func (r *Runner) Log(ctx context.Context, in *runner.LogRequest) (io.ReadCloser, error) {
+	return r.logs.Open(ctx, in)
+}
+
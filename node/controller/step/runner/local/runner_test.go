@@ -0,0 +1,188 @@
+package local_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/infraboard/mcube/logger/zap"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/node/controller/step/runner"
+	"github.com/infraboard/workflow/node/controller/step/runner/local"
+)
+
+var (
+	lr = local.NewRunner()
+)
+
+func testUpdater(s *pipeline.Step) {}
+
+func newTestRequest(t *testing.T, key, cmd string) *runner.RunRequest {
+	req := runner.NewRunRequest(&pipeline.Step{Key: key})
+	req.LoadRunnerParams(map[string]string{
+		local.CMD_KEY:     cmd,
+		local.WORKDIR_KEY: t.TempDir(),
+	})
+	req.LoadEnv(map[string]string{"PATH": os.Getenv("PATH")})
+	return req
+}
+
+func TestRunNULLStep(t *testing.T) {
+	should := assert.New(t)
+
+	resp := runner.NewRunReponse(testUpdater)
+	lr.Run(context.Background(), runner.NewRunRequest(&pipeline.Step{}), resp)
+	should.True(resp.HasError())
+}
+
+func TestRunWithParams(t *testing.T) {
+	should := assert.New(t)
+
+	// 节点进程的环境变量不会传递给step
+	os.Setenv("WORKFLOW_TEST_NODE_ENV", "node")
+	defer os.Unsetenv("WORKFLOW_TEST_NODE_ENV")
+
+	req := newTestRequest(t, "local.run.1.1", "echo $ENV1 > out.txt; echo ENV1=$ENV1 >> $WORKFLOW_OUTPUT; echo NODE_ENV=$WORKFLOW_TEST_NODE_ENV >> $WORKFLOW_OUTPUT")
+	req.LoadRunParams(map[string]string{"ENV1": "env1"})
+	resp := runner.NewRunReponse(testUpdater)
+	lr.Run(context.Background(), req, resp)
+	should.False(resp.HasError(), resp.ErrorMessage())
+	should.Equal("env1", req.Step.Status.ContextMap["ENV1"])
+	should.Equal("", req.Step.Status.ContextMap["NODE_ENV"])
+
+	// 执行结束后删除工作目录
+	ws := req.Step.Status.Response[local.WORKSPACE_KEY]
+	_, err := os.Stat(filepath.Join(ws, "out.txt"))
+	should.True(os.IsNotExist(err))
+}
+
+func TestRunExitFailed(t *testing.T) {
+	should := assert.New(t)
+
+	req := newTestRequest(t, "local.run.1.2", "exit 3")
+	resp := runner.NewRunReponse(testUpdater)
+	lr.Run(context.Background(), req, resp)
+	should.True(resp.HasError())
+	should.Contains(resp.ErrorMessage(), "exit code is 3")
+}
+
+func TestRunTimeout(t *testing.T) {
+	should := assert.New(t)
+
+	req := newTestRequest(t, "local.run.1.3", "sleep 10")
+	req.RunnerParams[local.TIMEOUT_KEY] = "1s"
+	resp := runner.NewRunReponse(testUpdater)
+
+	start := time.Now()
+	lr.Run(context.Background(), req, resp)
+	should.Less(time.Since(start), 5*time.Second)
+	should.Contains(resp.ErrorMessage(), "timeout")
+}
+
+func TestRunBackgroundChild(t *testing.T) {
+	should := assert.New(t)
+
+	// 后台子进程持有日志管道, 主进程退出后不会一直等待
+	req := newTestRequest(t, "local.run.1.9", "sleep 30 & echo done")
+	resp := runner.NewRunReponse(testUpdater)
+
+	start := time.Now()
+	lr.Run(context.Background(), req, resp)
+	should.Less(time.Since(start), local.PROCESS_WAIT_DELAY+5*time.Second)
+	should.False(resp.HasError(), resp.ErrorMessage())
+}
+
+func TestRunWithOutputs(t *testing.T) {
+	should := assert.New(t)
+
+	req := newTestRequest(t, "local.run.1.5", "echo IMAGE_TAG=v1 >> $WORKFLOW_OUTPUT; echo '::set-output name=COMMIT::a1b2c3'")
+	resp := runner.NewRunReponse(testUpdater)
+	lr.Run(context.Background(), req, resp)
+	should.False(resp.HasError(), resp.ErrorMessage())
+
+	should.Equal("v1", req.Step.Status.ContextMap["IMAGE_TAG"])
+	should.Equal("a1b2c3", req.Step.Status.ContextMap["COMMIT"])
+}
+
+func TestCancelStep(t *testing.T) {
+	should := assert.New(t)
+
+	req := newTestRequest(t, "local.run.1.4", "sleep 10")
+	resp := runner.NewRunReponse(testUpdater)
+
+	done := make(chan struct{})
+	go func() {
+		lr.Run(context.Background(), req, resp)
+		close(done)
+	}()
+
+	time.Sleep(500 * time.Millisecond)
+	lr.Cancel(context.Background(), runner.NewCancelRequest(&pipeline.Step{Key: req.Step.Key}))
+
+	select {
+	case <-done:
+		should.True(resp.IsCanceled())
+	case <-time.After(5 * time.Second):
+		t.Fatal("process not canceled")
+	}
+}
+
+func TestCancelStepBeforeStart(t *testing.T) {
+	should := assert.New(t)
+
+	req := newTestRequest(t, "local.run.1.8", "sleep 10")
+	lr.Cancel(context.Background(), runner.NewCancelRequest(&pipeline.Step{Key: req.Step.Key}))
+
+	resp := runner.NewRunReponse(testUpdater)
+	start := time.Now()
+	lr.Run(context.Background(), req, resp)
+	should.Less(time.Since(start), time.Second)
+	should.True(resp.IsCanceled())
+	should.Equal("process canceled before start", resp.CanceledMessage())
+}
+
+func TestConnect(t *testing.T) {
+	should := assert.New(t)
+
+	req := newTestRequest(t, "local.run.1.6", "sleep 10")
+	req.LoadRunParams(map[string]string{"ENV1": "env1"})
+	resp := runner.NewRunReponse(testUpdater)
+	go lr.Run(context.Background(), req, resp)
+	defer lr.Cancel(context.Background(), runner.NewCancelRequest(&pipeline.Step{Key: req.Step.Key}))
+	time.Sleep(500 * time.Millisecond)
+
+	// 和step进程使用一样的环境变量
+	out := bytes.NewBuffer(nil)
+	creq := runner.NewConnectRequest(&pipeline.Step{Key: req.Step.Key})
+	creq.Command = []string{"/bin/sh"}
+	creq.Stdin = strings.NewReader("echo $ENV1\nexit\n")
+	creq.Stdout = out
+	should.NoError(lr.Connect(context.Background(), creq))
+	should.Equal("env1\n", out.String())
+
+	// 分配终端
+	out.Reset()
+	creq.Command = []string{"/bin/sh", "-c", "test -t 0 && echo tty"}
+	creq.Stdin = nil
+	creq.Tty = true
+	if err := lr.Connect(context.Background(), creq); err != nil {
+		t.Skipf("pty not available, %s", err)
+	}
+	should.Contains(out.String(), "tty")
+
+	// step不在运行中
+	creq.Step.Key = "local.run.1.7"
+	should.Error(lr.Connect(context.Background(), creq))
+}
+
+func init() {
+	if err := zap.DevelopmentSetup(); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,228 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+)
+
+const (
+	// 每个step在内存中保留的实时日志大小, 超过后丢弃最早的日志
+	LIVE_LOG_MAX_BYTES = 8 * 1024 * 1024
+)
+
+var (
+	// ErrLogNotFound step不在该runner上运行, 或者已经运行结束
+	ErrLogNotFound = errors.New("step live log not found")
+)
+
+// NewLogHub 保存正在运行的step的实时日志, 用于Runner.Log
+func NewLogHub() *LogHub {
+	return &LogHub{
+		buffers: map[string]*logBuffer{},
+	}
+}
+
+type LogHub struct {
+	buffers map[string]*logBuffer
+	l       sync.Mutex
+}
+
+// Tee 从rc读取的日志会同时写入step的实时日志, rc读取结束或者关闭后实时日志结束
+func (h *LogHub) Tee(key string, rc io.ReadCloser) io.ReadCloser {
+	b := newLogBuffer()
+
+	h.l.Lock()
+	h.buffers[key] = b
+	h.l.Unlock()
+
+	return &teeReader{
+		rc: rc,
+		b:  b,
+		done: func() {
+			h.l.Lock()
+			defer h.l.Unlock()
+			if h.buffers[key] == b {
+				delete(h.buffers, key)
+			}
+		},
+	}
+}
+
+// Open 读取step的实时日志, 日志结束后已经打开的reader依然可以读完
+func (h *LogHub) Open(ctx context.Context, req *LogRequest) (io.ReadCloser, error) {
+	if req.Step == nil {
+		return nil, ErrLogNotFound
+	}
+
+	h.l.Lock()
+	b, ok := h.buffers[req.Step.Key]
+	h.l.Unlock()
+	if !ok {
+		return nil, ErrLogNotFound
+	}
+	return b.newReader(ctx, req), nil
+}
+
+type teeReader struct {
+	rc   io.ReadCloser
+	b    *logBuffer
+	done func()
+	once sync.Once
+}
+
+func (t *teeReader) Read(p []byte) (int, error) {
+	n, err := t.rc.Read(p)
+	if n > 0 {
+		t.b.write(p[:n])
+	}
+	if err != nil {
+		t.finish()
+	}
+	return n, err
+}
+
+func (t *teeReader) Close() error {
+	t.finish()
+	return t.rc.Close()
+}
+
+func (t *teeReader) finish() {
+	t.once.Do(func() {
+		t.b.finish()
+		t.done()
+	})
+}
+
+func newLogBuffer() *logBuffer {
+	return &logBuffer{
+		notify: make(chan struct{}),
+	}
+}
+
+// logBuffer 日志只追加, base为data[0]在整个日志中的偏移
+type logBuffer struct {
+	data   []byte
+	base   int64
+	done   bool
+	notify chan struct{}
+	l      sync.Mutex
+}
+
+func (b *logBuffer) write(p []byte) {
+	b.l.Lock()
+	defer b.l.Unlock()
+
+	b.data = append(b.data, p...)
+	if over := len(b.data) - LIVE_LOG_MAX_BYTES; over > 0 {
+		b.data = append([]byte(nil), b.data[over:]...)
+		b.base += int64(over)
+	}
+	b.broadcast()
+}
+
+func (b *logBuffer) finish() {
+	b.l.Lock()
+	defer b.l.Unlock()
+
+	b.done = true
+	b.broadcast()
+}
+
+// 唤醒所有等待新日志的reader
+func (b *logBuffer) broadcast() {
+	close(b.notify)
+	b.notify = make(chan struct{})
+}
+
+func (b *logBuffer) newReader(ctx context.Context, req *LogRequest) *logReader {
+	b.l.Lock()
+	defer b.l.Unlock()
+
+	offset := req.Offset
+	if req.Tail > 0 {
+		offset = b.base + int64(TailIndex(b.data, req.Tail))
+	}
+	if offset < b.base {
+		offset = b.base
+	}
+	return &logReader{
+		ctx:    ctx,
+		b:      b,
+		offset: offset,
+		follow: req.Follow,
+	}
+}
+
+// 从offset开始读取, 返回读取的数据, 没有新数据时返回等待的channel
+func (b *logBuffer) readAt(p []byte, offset int64) (int, int64, bool, <-chan struct{}) {
+	b.l.Lock()
+	defer b.l.Unlock()
+
+	// 已经被丢弃的日志从保留的第一个字节开始读
+	if offset < b.base {
+		offset = b.base
+	}
+	start := offset - b.base
+	if start < int64(len(b.data)) {
+		n := copy(p, b.data[start:])
+		return n, offset + int64(n), false, nil
+	}
+	return 0, offset, b.done, b.notify
+}
+
+type logReader struct {
+	ctx    context.Context
+	b      *logBuffer
+	offset int64
+	follow bool
+}
+
+// Offset 下一次读取的位置, 读取前为日志开始的位置
+func (r *logReader) Offset() int64 {
+	return r.offset
+}
+
+func (r *logReader) Read(p []byte) (int, error) {
+	for {
+		n, next, done, wait := r.b.readAt(p, r.offset)
+		r.offset = next
+		if n > 0 {
+			return n, nil
+		}
+		if done || !r.follow {
+			return 0, io.EOF
+		}
+
+		select {
+		case <-wait:
+		case <-r.ctx.Done():
+			return 0, r.ctx.Err()
+		}
+	}
+}
+
+func (r *logReader) Close() error {
+	return nil
+}
+
+// TailIndex 返回最后n行在data中开始的位置, 最后一行没有换行符时也算一行
+func TailIndex(data []byte, n int) int {
+	end := len(data)
+	if end > 0 && data[end-1] == '\n' {
+		end--
+	}
+	for i := 0; i < n; i++ {
+		idx := bytes.LastIndexByte(data[:end], '\n')
+		if idx < 0 {
+			return 0
+		}
+		if i == n-1 {
+			return idx + 1
+		}
+		end = idx
+	}
+	return 0
+}
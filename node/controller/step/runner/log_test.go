@@ -0,0 +1,90 @@
+package runner_test
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/node/controller/step/runner"
+)
+
+func TestLogHubFollow(t *testing.T) {
+	should := assert.New(t)
+
+	s := pipeline.NewDefaultStep()
+	s.Key = "default.pipeline.1.1"
+	hub := runner.NewLogHub()
+
+	pr, pw := io.Pipe()
+	tee := hub.Tee(s.Key, pr)
+	go func() {
+		pw.Write([]byte("line1\nline2\n"))
+		pw.Write([]byte("line3\n"))
+		pw.Close()
+	}()
+
+	req := runner.NewLogRequest(s)
+	req.Follow = true
+	rc, err := hub.Open(context.Background(), req)
+	should.NoError(err)
+
+	// 上传的内容和读取到的实时日志一致
+	uploaded, err := ioutil.ReadAll(tee)
+	should.NoError(err)
+	tee.Close()
+	live, err := ioutil.ReadAll(rc)
+	should.NoError(err)
+	should.Equal("line1\nline2\nline3\n", string(uploaded))
+	should.Equal(string(uploaded), string(live))
+
+	// 结束后不再保留
+	_, err = hub.Open(context.Background(), req)
+	should.Equal(runner.ErrLogNotFound, err)
+}
+
+func TestLogHubOffsetAndTail(t *testing.T) {
+	should := assert.New(t)
+
+	s := pipeline.NewDefaultStep()
+	s.Key = "default.pipeline.1.1"
+	hub := runner.NewLogHub()
+
+	pr, pw := io.Pipe()
+	tee := hub.Tee(s.Key, pr)
+	defer tee.Close()
+	go pw.Write([]byte("line1\nline2\nline3"))
+	buf := make([]byte, 64)
+	n, err := io.ReadAtLeast(tee, buf, 17)
+	should.NoError(err)
+	should.Equal(17, n)
+
+	req := runner.NewLogRequest(s)
+	req.Offset = 6
+	rc, err := hub.Open(context.Background(), req)
+	should.NoError(err)
+	data, _ := ioutil.ReadAll(rc)
+	should.Equal("line2\nline3", string(data))
+
+	req.Tail = 2
+	rc, err = hub.Open(context.Background(), req)
+	should.NoError(err)
+	should.Equal(int64(6), rc.(interface{ Offset() int64 }).Offset())
+	data, _ = ioutil.ReadAll(rc)
+	should.Equal("line2\nline3", string(data))
+}
+
+func TestTailIndex(t *testing.T) {
+	should := assert.New(t)
+
+	data := []byte("a\nb\nc\n")
+	should.Equal(4, runner.TailIndex(data, 1))
+	should.Equal(2, runner.TailIndex(data, 2))
+	should.Equal(0, runner.TailIndex(data, 3))
+	should.Equal(0, runner.TailIndex(data, 10))
+	should.Equal(0, runner.TailIndex([]byte(strings.Repeat("x", 3)), 1))
+}
@@ -0,0 +1,102 @@
+package runner
+
+import (
+	"bytes"
+	"io"
+	"sort"
+)
+
+const (
+	// 日志中敏感信息替换后的掩码
+	LOG_MASK = "***"
+)
+
+// NewMaskReader 将日志中的敏感信息替换为掩码, 敏感信息可能跨越多次读取, 会保留尾部数据等待后续数据
+func NewMaskReader(rc io.ReadCloser, secrets []string) io.ReadCloser {
+	values := maskValues(secrets)
+	if len(values) == 0 {
+		return rc
+	}
+
+	return &maskReader{
+		rc:     rc,
+		values: values,
+		hold:   len(values[0]) - 1,
+		buf:    make([]byte, 32*1024),
+	}
+}
+
+// MaskValue 将单个值中的敏感信息替换为掩码, 比如输出文件中的变量
+func MaskValue(value string, secrets []string) string {
+	return string(maskBytes([]byte(value), maskValues(secrets)))
+}
+
+// 先替换长的, 避免短的值是长的值的一部分时替换不完整
+func maskValues(secrets []string) [][]byte {
+	values := [][]byte{}
+	for _, s := range secrets {
+		if s == "" {
+			continue
+		}
+		values = append(values, []byte(s))
+	}
+	sort.Slice(values, func(i, j int) bool { return len(values[i]) > len(values[j]) })
+	return values
+}
+
+func maskBytes(data []byte, values [][]byte) []byte {
+	for _, v := range values {
+		if bytes.Contains(data, v) {
+			data = bytes.ReplaceAll(data, v, []byte(LOG_MASK))
+		}
+	}
+	return data
+}
+
+type maskReader struct {
+	rc     io.ReadCloser
+	values [][]byte
+	hold   int
+	buf    []byte
+
+	pending []byte
+	ready   []byte
+	eof     bool
+}
+
+func (m *maskReader) Read(p []byte) (int, error) {
+	for len(m.ready) == 0 {
+		if m.eof {
+			return 0, io.EOF
+		}
+
+		n, err := m.rc.Read(m.buf)
+		m.pending = append(m.pending, m.buf[:n]...)
+		m.pending = m.mask(m.pending)
+
+		switch {
+		case err == io.EOF:
+			m.eof = true
+			m.ready, m.pending = m.pending, nil
+		case err != nil:
+			return 0, err
+		case len(m.pending) > m.hold:
+			// 尾部可能是未读取完整的敏感信息, 暂不输出
+			cut := len(m.pending) - m.hold
+			m.ready = append([]byte{}, m.pending[:cut]...)
+			m.pending = append(m.pending[:0], m.pending[cut:]...)
+		}
+	}
+
+	n := copy(p, m.ready)
+	m.ready = m.ready[n:]
+	return n, nil
+}
+
+func (m *maskReader) mask(data []byte) []byte {
+	return maskBytes(data, m.values)
+}
+
+func (m *maskReader) Close() error {
+	return m.rc.Close()
+}
@@ -0,0 +1,35 @@
+package runner_test
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/workflow/node/controller/step/runner"
+)
+
+func TestMaskReader(t *testing.T) {
+	should := assert.New(t)
+
+	log := "login with token 123456, password abc\nretry token 123456"
+	// 每次只读取一个字节, 验证跨越多次读取的敏感信息
+	rc := runner.NewMaskReader(ioutil.NopCloser(iotest.OneByteReader(strings.NewReader(log))), []string{"123456", "abc", ""})
+	data, err := ioutil.ReadAll(rc)
+	should.NoError(err)
+	should.Equal("login with token ***, password ***\nretry token ***", string(data))
+}
+
+func TestMaskReaderNoSecrets(t *testing.T) {
+	should := assert.New(t)
+
+	rc := ioutil.NopCloser(strings.NewReader("log"))
+	should.Equal(rc, runner.NewMaskReader(rc, nil))
+
+	data, err := io.ReadAll(runner.NewMaskReader(rc, []string{"123"}))
+	should.NoError(err)
+	should.Equal("log", string(data))
+}
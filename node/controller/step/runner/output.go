@@ -0,0 +1,159 @@
+package runner
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+const (
+	// 日志中输出变量的标记, 格式: ::set-output name=KEY::VALUE
+	OUTPUT_MARKER = "::set-output name="
+	// 输出文件路径的环境变量, 文件中每行一个 KEY=VALUE
+	OUTPUT_FILE_ENV = "WORKFLOW_OUTPUT"
+	// 单行日志的最大长度, 超过后丢弃该行
+	maxOutputLineSize = 64 * 1024
+)
+
+// NewOutputCollector 收集step运行时产生的输出, 输出中的敏感信息会被替换为掩码, 避免写入context_map
+func NewOutputCollector(masks ...string) *OutputCollector {
+	return &OutputCollector{
+		outputs: map[string]string{},
+		masks:   masks,
+	}
+}
+
+// OutputCollector 从日志和输出文件中收集step的输出, 最终写入context_map
+type OutputCollector struct {
+	line    []byte
+	skip    bool
+	outputs map[string]string
+	masks   []string
+	l       sync.Mutex
+}
+
+// Tee 读取日志的同时解析日志中的输出标记
+func (c *OutputCollector) Tee(rc io.ReadCloser) io.ReadCloser {
+	return &teeReadCloser{Reader: io.TeeReader(rc, c), Closer: rc}
+}
+
+// Write 按行解析日志
+func (c *OutputCollector) Write(p []byte) (int, error) {
+	c.l.Lock()
+	defer c.l.Unlock()
+
+	data := p
+	for len(data) > 0 {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			c.appendLine(data)
+			break
+		}
+		c.appendLine(data[:i])
+		c.flushLine()
+		data = data[i+1:]
+	}
+	return len(p), nil
+}
+
+func (c *OutputCollector) appendLine(data []byte) {
+	if c.skip {
+		return
+	}
+	if len(c.line)+len(data) > maxOutputLineSize {
+		c.line = c.line[:0]
+		c.skip = true
+		return
+	}
+	c.line = append(c.line, data...)
+}
+
+func (c *OutputCollector) flushLine() {
+	if !c.skip {
+		c.parseMarker(string(c.line))
+	}
+	c.line = c.line[:0]
+	c.skip = false
+}
+
+// 标记可能出现在行中的任意位置, 比如docker日志流的头部
+func (c *OutputCollector) parseMarker(line string) {
+	i := strings.Index(line, OUTPUT_MARKER)
+	if i < 0 {
+		return
+	}
+
+	kv := strings.SplitN(line[i+len(OUTPUT_MARKER):], "::", 2)
+	if len(kv) != 2 {
+		return
+	}
+	c.set(kv[0], strings.TrimRight(kv[1], "\r"))
+}
+
+func (c *OutputCollector) set(key, value string) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return
+	}
+	c.outputs[key] = MaskValue(value, c.masks)
+}
+
+// ParseFile 解析输出文件, 文件不存在时忽略
+func (c *OutputCollector) ParseFile(path string) error {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("open output file error, %s", err)
+	}
+	defer f.Close()
+
+	c.l.Lock()
+	defer c.l.Unlock()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 4096), maxOutputLineSize)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("invalid output line: %s, format must be KEY=VALUE", line)
+		}
+		c.set(kv[0], kv[1])
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read output file error, %s", err)
+	}
+
+	return nil
+}
+
+// Outputs 返回收集到的输出, 未换行的最后一行也会被解析
+func (c *OutputCollector) Outputs() map[string]string {
+	c.l.Lock()
+	defer c.l.Unlock()
+
+	if len(c.line) > 0 {
+		c.flushLine()
+	}
+
+	m := make(map[string]string, len(c.outputs))
+	for k, v := range c.outputs {
+		m[k] = v
+	}
+	return m
+}
+
+type teeReadCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -2,6 +2,7 @@ package runner
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -12,6 +13,8 @@ import (
 type Runner interface {
 	// 执行Step, 执行过后的关联信息保存在Status的Response里面
 	Run(context.Context, *RunRequest, *RunResponse)
+	// 读取Step运行中的实时日志, step不在该runner上运行时返回ErrLogNotFound
+	Log(context.Context, *LogRequest) (io.ReadCloser, error)
 	// 连接到该执行环境
 	Connect(context.Context, *ConnectRequest) error
 	// 取消Step的执行
@@ -23,6 +26,7 @@ func NewRunRequest(s *pipeline.Step) *RunRequest {
 		Step:         s,
 		RunnerParams: map[string]string{},
 		RunParams:    map[string]string{},
+		Env:          map[string]string{},
 	}
 }
 
@@ -31,6 +35,8 @@ type RunRequest struct {
 	RunParams    map[string]string   // step 运行需要的参数
 	Mount        *pipeline.MountData // 挂载文件
 	Step         *pipeline.Step      // 具体step
+	Masks        []string            // 日志中需要脱敏的值, 比如secret
+	Env          map[string]string   // 节点允许step使用的环境变量
 }
 
 func (r *RunRequest) LoadMount(m *pipeline.MountData) {
@@ -49,6 +55,26 @@ func (r *RunRequest) LoadRunnerParams(params map[string]string) {
 	}
 }
 
+func (r *RunRequest) LoadEnv(env map[string]string) {
+	for k, v := range env {
+		r.Env[k] = v
+	}
+}
+
+func (r *RunRequest) AddMask(values ...string) {
+	r.Masks = append(r.Masks, values...)
+}
+
+// MaskLog 上传日志前替换其中的敏感信息
+func (r *RunRequest) MaskLog(rc io.ReadCloser) io.ReadCloser {
+	return NewMaskReader(rc, r.Masks)
+}
+
+// NewOutputCollector 收集输出, 使用和日志相同的脱敏规则
+func (r *RunRequest) NewOutputCollector() *OutputCollector {
+	return NewOutputCollector(r.Masks...)
+}
+
 func NewRunReponse(updater UpdateStepCallback) *RunResponse {
 	return &RunResponse{
 		updater: updater,
@@ -60,10 +86,11 @@ func NewRunReponse(updater UpdateStepCallback) *RunResponse {
 type UpdateStepCallback func(*pipeline.Step)
 
 type RunResponse struct {
-	updater UpdateStepCallback // 更新状态的回调
-	errs    []string
-	resp    map[string]string
-	ctx     map[string]string
+	updater  UpdateStepCallback // 更新状态的回调
+	errs     []string
+	canceled string
+	resp     map[string]string
+	ctx      map[string]string
 }
 
 func (r *RunResponse) UpdateReponseMap(k, v string) {
@@ -74,6 +101,13 @@ func (r *RunResponse) UpdateCtxMap(k, v string) {
 	r.ctx[k] = v
 }
 
+// LoadOutputs 将runner收集到的输出写入context_map
+func (r *RunResponse) LoadOutputs(c *OutputCollector) {
+	for k, v := range c.Outputs() {
+		r.ctx[k] = v
+	}
+}
+
 func (r *RunResponse) UpdateResponse(s *pipeline.Step) {
 	s.UpdateResponse(r.resp)
 	s.UpdateCtx(r.ctx)
@@ -84,6 +118,19 @@ func (r *RunResponse) Failed(format string, a ...interface{}) {
 	r.errs = append(r.errs, fmt.Sprintf(format, a...))
 }
 
+// Canceled 执行被取消, 取消优先于执行过程中的错误
+func (r *RunResponse) Canceled(format string, a ...interface{}) {
+	r.canceled = fmt.Sprintf(format, a...)
+}
+
+func (r *RunResponse) IsCanceled() bool {
+	return r.canceled != ""
+}
+
+func (r *RunResponse) CanceledMessage() string {
+	return r.canceled
+}
+
 func (r *RunResponse) HasError() bool {
 	return len(r.errs) > 0
 }
@@ -92,8 +139,17 @@ func (r *RunResponse) ErrorMessage() string {
 	return strings.Join(r.errs, ",")
 }
 
+func NewLogRequest(s *pipeline.Step) *LogRequest {
+	return &LogRequest{
+		Step: s,
+	}
+}
+
 type LogRequest struct {
-	Step *pipeline.Step
+	Step   *pipeline.Step
+	Offset int64 // 从日志的该位置开始读取
+	Tail   int   // 大于0时只读取最后Tail行, 忽略Offset
+	Follow bool  // 读完已有日志后继续等待新的日志, 直到step结束
 }
 
 func NewCancelRequest(s *pipeline.Step) *CancelRequest {
@@ -106,11 +162,29 @@ type CancelRequest struct {
 	Step *pipeline.Step
 }
 
+const (
+	// 连接到执行环境时默认运行的命令
+	DEFAULT_CONNECT_SHELL = "/bin/sh"
+)
+
+var (
+	// ErrConnectNotSupport runner不支持连接到执行环境
+	ErrConnectNotSupport = errors.New("runner not support connect")
+)
+
+func NewConnectRequest(s *pipeline.Step) *ConnectRequest {
+	return &ConnectRequest{
+		Step:    s,
+		Command: []string{DEFAULT_CONNECT_SHELL},
+	}
+}
+
 // // ConnectRequest holds information pertaining to the current streaming session:
 // // input/output streams, if the client is requesting a TTY, and a terminal size queue to
 // // support terminal resizing.
 type ConnectRequest struct {
 	Step              *pipeline.Step
+	Command           []string // 在执行环境中运行的命令, 默认为/bin/sh
 	Stdin             io.Reader
 	Stdout            io.Writer
 	Stderr            io.Writer
@@ -118,6 +192,19 @@ type ConnectRequest struct {
 	TerminalSizeQueue TerminalSizeQueue
 }
 
+func (r *ConnectRequest) Validate() error {
+	if r.Step == nil || r.Step.Key == "" {
+		return fmt.Errorf("step key required")
+	}
+	if len(r.Command) == 0 {
+		return fmt.Errorf("command required")
+	}
+	if r.Stdout == nil {
+		return fmt.Errorf("stdout required")
+	}
+	return nil
+}
+
 // TerminalSize and TerminalSizeQueue was a part of k8s.io/kubernetes/pkg/util/term
 // and were moved in order to decouple client from other term dependencies
 
@@ -7,17 +7,139 @@ import (
 	"io"
 	"os"
 	"path"
+	"path/filepath"
+	"strconv"
+	"sync"
 	"time"
 )
 
-func NewUploader(id string) *Uploader {
+const (
+	DRIVER_NAME = "local_file"
+	// 没有配置时, 日志保存在启动时的工作目录下
+	DEFAULT_ROOT = "runner_log"
+	// 清理过期日志的间隔
+	ROTATE_INTERVAL = time.Hour
+)
+
+// NewStore 日志按天分目录保存在root下, 超过maxAge的目录会被删除, maxAge为0表示一直保留
+func NewStore(root string, maxAge time.Duration) *Store {
+	if root == "" {
+		root = DEFAULT_ROOT
+	}
+	// 相对路径在创建时解析, 避免切换工作目录后读写不同的目录
+	if abs, err := filepath.Abs(root); err == nil {
+		root = abs
+	}
+	return &Store{
+		root:   root,
+		maxAge: maxAge,
+	}
+}
+
+// Store 本地文件存储
+type Store struct {
+	root   string
+	maxAge time.Duration
+
+	lastRotate time.Time
+	l          sync.Mutex
+}
+
+func (s *Store) DriverName() string {
+	return DRIVER_NAME
+}
+
+// NewUploader 创建上传器, 同时会定期清理过期的日志
+func (s *Store) NewUploader(id string) *Uploader {
+	s.rotateIfNeed(time.Now())
 	return &Uploader{
 		id:     id,
-		root:   "runner_log",
-		parent: dateDir(),
+		root:   s.root,
+		parent: dateDir(time.Now()),
 	}
 }
 
+// Open 读取日志, objectID为Uploader返回的ObjectID
+func (s *Store) Open(ctx context.Context, objectID string) (io.ReadCloser, error) {
+	return os.Open(s.fullPath(objectID))
+}
+
+// 只允许读取根目录下的文件
+func (s *Store) fullPath(objectID string) string {
+	return filepath.Join(s.root, filepath.FromSlash(path.Clean("/"+objectID)))
+}
+
+func (s *Store) rotateIfNeed(now time.Time) {
+	if s.maxAge <= 0 {
+		return
+	}
+
+	s.l.Lock()
+	if now.Sub(s.lastRotate) < ROTATE_INTERVAL {
+		s.l.Unlock()
+		return
+	}
+	s.lastRotate = now
+	s.l.Unlock()
+
+	go s.Rotate(now)
+}
+
+// Rotate 删除日期早于now-maxAge的日志目录
+func (s *Store) Rotate(now time.Time) error {
+	if s.maxAge <= 0 {
+		return nil
+	}
+
+	expired := now.Add(-s.maxAge)
+	years, err := os.ReadDir(s.root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, y := range years {
+		year, err := strconv.Atoi(y.Name())
+		if err != nil || !y.IsDir() {
+			continue
+		}
+		yearDir := filepath.Join(s.root, y.Name())
+		months, err := os.ReadDir(yearDir)
+		if err != nil {
+			return err
+		}
+		for _, m := range months {
+			month, err := strconv.Atoi(m.Name())
+			if err != nil || !m.IsDir() {
+				continue
+			}
+			monthDir := filepath.Join(yearDir, m.Name())
+			days, err := os.ReadDir(monthDir)
+			if err != nil {
+				return err
+			}
+			for _, d := range days {
+				day, err := strconv.Atoi(d.Name())
+				if err != nil || !d.IsDir() {
+					continue
+				}
+				// 当天的日志全部过期后才删除
+				end := time.Date(year, time.Month(month), day+1, 0, 0, 0, 0, now.Location())
+				if end.Before(expired) {
+					if err := os.RemoveAll(filepath.Join(monthDir, d.Name())); err != nil {
+						return err
+					}
+				}
+			}
+			removeIfEmpty(monthDir)
+		}
+		removeIfEmpty(yearDir)
+	}
+	return nil
+}
+
 type Uploader struct {
 	id     string
 	root   string
@@ -25,10 +147,12 @@ type Uploader struct {
 }
 
 func (u *Uploader) DriverName() string {
-	return "local_file"
+	return DRIVER_NAME
 }
+
+// ObjectID 日志相对于根目录的路径
 func (u *Uploader) ObjectID() string {
-	return path.Join(u.root, u.parent, u.id)
+	return path.Join(u.parent, u.id)
 }
 func (u *Uploader) Upload(ctx context.Context, stream io.ReadCloser) error {
 	defer stream.Close()
@@ -51,20 +175,20 @@ func (u *Uploader) Upload(ctx context.Context, stream io.ReadCloser) error {
 }
 
 func (u *Uploader) createFile(ctx context.Context) (*os.File, error) {
-	fp := u.ObjectID()
+	fp := filepath.Join(u.root, filepath.FromSlash(u.ObjectID()))
 	if checkFileIsExist(fp) {
 		return os.OpenFile(fp, os.O_TRUNC|os.O_WRONLY, os.ModePerm)
 	}
 
-	if err := os.MkdirAll(path.Dir(fp), os.ModePerm); err != nil {
+	if err := os.MkdirAll(filepath.Dir(fp), os.ModePerm); err != nil {
 		return nil, err
 	}
 
 	return os.Create(fp)
 }
 
-func dateDir() string {
-	year, month, day := time.Now().Date()
+func dateDir(t time.Time) string {
+	year, month, day := t.Date()
 	return fmt.Sprintf("%d/%d/%d", year, int(month), day)
 }
 
@@ -76,3 +200,10 @@ func checkFileIsExist(filepath string) bool {
 	}
 	return exist
 }
+
+func removeIfEmpty(dir string) {
+	items, err := os.ReadDir(dir)
+	if err == nil && len(items) == 0 {
+		os.Remove(dir)
+	}
+}
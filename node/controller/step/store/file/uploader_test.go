@@ -3,8 +3,11 @@ package file_test
 import (
 	"context"
 	"io"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -14,8 +17,51 @@ import (
 func TestUpload(t *testing.T) {
 	should := assert.New(t)
 
+	wd, err := os.Getwd()
+	if !should.NoError(err) {
+		return
+	}
+
 	buffer := io.NopCloser(strings.NewReader("hello world"))
-	store := file.NewUploader("c16mhsddrei91m4ri0jg.c3iqcama0brimaq08e40.2.1")
-	err := store.Upload(context.Background(), buffer)
+	s := file.NewStore("", 0)
+	up := s.NewUploader("c16mhsddrei91m4ri0jg.c3iqcama0brimaq08e40.2.1")
+	err = up.Upload(context.Background(), buffer)
+	should.NoError(err)
+
+	// 相对路径在创建时解析, 切换工作目录后仍然可以读取
+	should.NoError(os.Chdir(t.TempDir()))
+	defer os.Chdir(wd)
+	r, err := s.Open(context.Background(), up.ObjectID())
+	if should.NoError(err) {
+		r.Close()
+	}
+}
+
+func TestStoreOpenAndRotate(t *testing.T) {
+	should := assert.New(t)
+
+	root := t.TempDir()
+	s := file.NewStore(root, 24*time.Hour)
+	up := s.NewUploader("step.1")
+	err := up.Upload(context.Background(), io.NopCloser(strings.NewReader("hello world")))
+	should.NoError(err)
+
+	r, err := s.Open(context.Background(), up.ObjectID())
+	if should.NoError(err) {
+		data, _ := io.ReadAll(r)
+		r.Close()
+		should.Equal("hello world", string(data))
+	}
+
+	// 不允许读取根目录外的文件
+	_, err = s.Open(context.Background(), "../../../etc/passwd")
+	should.Error(err)
+
+	should.NoError(s.Rotate(time.Now()))
+	_, err = os.Stat(filepath.Join(root, filepath.FromSlash(up.ObjectID())))
 	should.NoError(err)
+
+	should.NoError(s.Rotate(time.Now().Add(72 * time.Hour)))
+	_, err = s.Open(context.Background(), up.ObjectID())
+	should.Error(err)
 }
@@ -0,0 +1,106 @@
+package gridfs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	DRIVER_NAME = "gridfs"
+	// 没有配置时使用的bucket
+	DEFAULT_BUCKET = "step_logs"
+)
+
+// NewStore 日志保存在MongoDB GridFS中, 文件名为ObjectID
+// 同一个step重新上传时会生成新的版本, 读取时返回最新的版本
+func NewStore(db *mongo.Database, bucket string) *Store {
+	if bucket == "" {
+		bucket = DEFAULT_BUCKET
+	}
+	return &Store{
+		db:     db,
+		bucket: bucket,
+	}
+}
+
+// Store MongoDB GridFS存储
+type Store struct {
+	db     *mongo.Database
+	bucket string
+}
+
+func (s *Store) DriverName() string {
+	return DRIVER_NAME
+}
+
+func (s *Store) NewUploader(id string) *Uploader {
+	return &Uploader{
+		store:    s,
+		objectID: path.Join(dateDir(time.Now()), id),
+	}
+}
+
+// Open 读取日志, objectID为Uploader返回的ObjectID
+func (s *Store) Open(ctx context.Context, objectID string) (io.ReadCloser, error) {
+	b, err := s.newBucket()
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		b.SetReadDeadline(deadline)
+	}
+
+	ds, err := b.OpenDownloadStreamByName(objectID)
+	if err != nil {
+		return nil, fmt.Errorf("open gridfs file %s error, %s", objectID, err)
+	}
+	return ds, nil
+}
+
+// Bucket不是并发安全的, 每次操作都新建一个
+func (s *Store) newBucket() (*gridfs.Bucket, error) {
+	return gridfs.NewBucket(s.db, options.GridFSBucket().SetName(s.bucket))
+}
+
+type Uploader struct {
+	store    *Store
+	objectID string
+}
+
+func (u *Uploader) DriverName() string {
+	return DRIVER_NAME
+}
+
+// ObjectID 日志在GridFS中的文件名
+func (u *Uploader) ObjectID() string {
+	return u.objectID
+}
+
+func (u *Uploader) Upload(ctx context.Context, stream io.ReadCloser) error {
+	defer stream.Close()
+
+	b, err := u.store.newBucket()
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		b.SetWriteDeadline(deadline)
+	}
+
+	if _, err := b.UploadFromStream(u.objectID, stream); err != nil {
+		return fmt.Errorf("upload log to gridfs error, %s", err)
+	}
+	return nil
+}
+
+func dateDir(t time.Time) string {
+	year, month, day := t.Date()
+	return fmt.Sprintf("%d/%d/%d", year, int(month), day)
+}
@@ -0,0 +1,57 @@
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"io/ioutil"
+)
+
+// Seek 跳过日志中offset之前的内容, tail大于0时只保留最后tail行并忽略offset
+// 返回的start为读取开始时在日志中的位置, rc由返回的ReadCloser负责关闭
+func Seek(rc io.ReadCloser, offset int64, tail int) (r io.ReadCloser, start int64, err error) {
+	if tail > 0 {
+		return seekTail(rc, tail)
+	}
+
+	if offset > 0 {
+		start, err = io.CopyN(ioutil.Discard, rc, offset)
+		if err != nil && err != io.EOF {
+			rc.Close()
+			return nil, 0, err
+		}
+	}
+	return rc, start, nil
+}
+
+// 只在内存中保留最后tail行
+func seekTail(rc io.ReadCloser, tail int) (io.ReadCloser, int64, error) {
+	defer rc.Close()
+
+	var (
+		lines = make([][]byte, 0, tail)
+		total int64
+		size  int64
+	)
+	br := bufio.NewReader(rc)
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 {
+			total += int64(len(line))
+			size += int64(len(line))
+			if len(lines) == tail {
+				size -= int64(len(lines[0]))
+				lines = append(lines[:0], lines[1:]...)
+			}
+			lines = append(lines, line)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return ioutil.NopCloser(bytes.NewReader(bytes.Join(lines, nil))), total - size, nil
+}
@@ -0,0 +1,36 @@
+package store_test
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/workflow/node/controller/step/store"
+)
+
+func TestSeek(t *testing.T) {
+	should := assert.New(t)
+
+	log := "line1\nline2\nline3\n"
+
+	rc, start, err := store.Seek(ioutil.NopCloser(strings.NewReader(log)), 6, 0)
+	should.NoError(err)
+	data, _ := ioutil.ReadAll(rc)
+	should.Equal(int64(6), start)
+	should.Equal("line2\nline3\n", string(data))
+
+	rc, start, err = store.Seek(ioutil.NopCloser(strings.NewReader(log)), 0, 2)
+	should.NoError(err)
+	data, _ = ioutil.ReadAll(rc)
+	should.Equal(int64(6), start)
+	should.Equal("line2\nline3\n", string(data))
+
+	// offset超过日志长度时从结尾开始
+	rc, start, err = store.Seek(ioutil.NopCloser(strings.NewReader(log)), 100, 0)
+	should.NoError(err)
+	data, _ = ioutil.ReadAll(rc)
+	should.Equal(int64(len(log)), start)
+	should.Empty(data)
+}
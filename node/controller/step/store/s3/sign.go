@@ -0,0 +1,138 @@
+package s3
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	SIGN_ALGORITHM = "AWS4-HMAC-SHA256"
+	// 不对请求体签名, 上传大文件时无需提前计算hash
+	UNSIGNED_PAYLOAD = "UNSIGNED-PAYLOAD"
+	// 空请求体的sha256
+	EMPTY_PAYLOAD_HASH = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	AMZ_DATE_FORMAT = "20060102T150405Z"
+	AMZ_DATE_HEADER = "X-Amz-Date"
+	AMZ_HASH_HEADER = "X-Amz-Content-Sha256"
+)
+
+// SignV4 使用AWS Signature Version 4对请求签名, 请求中所有的header都参与签名
+// 参考: https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+func SignV4(req *http.Request, accessKey, secretKey, region, payloadHash string, t time.Time) {
+	t = t.UTC()
+	amzDate := t.Format(AMZ_DATE_FORMAT)
+	date := t.Format("20060102")
+	req.Header.Set(AMZ_DATE_HEADER, amzDate)
+	req.Header.Set(AMZ_HASH_HEADER, payloadHash)
+
+	signedHeaders, canonicalHeaders := canonicalHeaders(req)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uriEncode(req.URL.Path, false),
+		canonicalQuery(req),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, region)
+	stringToSign := strings.Join([]string{
+		SIGN_ALGORITHM,
+		amzDate,
+		scope,
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+secretKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		SIGN_ALGORITHM, accessKey, scope, signedHeaders, signature))
+}
+
+func canonicalHeaders(req *http.Request) (signed, canonical string) {
+	headers := map[string]string{"host": req.Host}
+	if headers["host"] == "" {
+		headers["host"] = req.URL.Host
+	}
+	for k, v := range req.Header {
+		name := strings.ToLower(k)
+		if name == "authorization" {
+			continue
+		}
+		headers[name] = strings.TrimSpace(strings.Join(v, ","))
+	}
+
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	b := strings.Builder{}
+	for _, name := range names {
+		b.WriteString(name)
+		b.WriteString(":")
+		b.WriteString(headers[name])
+		b.WriteString("\n")
+	}
+	return strings.Join(names, ";"), b.String()
+}
+
+func canonicalQuery(req *http.Request) string {
+	query := req.URL.Query()
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	items := []string{}
+	for _, k := range keys {
+		values := query[k]
+		sort.Strings(values)
+		for _, v := range values {
+			items = append(items, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	return strings.Join(items, "&")
+}
+
+// 除了A-Z, a-z, 0-9, '-', '.', '_', '~'外都需要编码, 路径中的'/'不编码
+func uriEncode(s string, encodeSlash bool) string {
+	b := strings.Builder{}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '.', c == '_', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func hexSHA256(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
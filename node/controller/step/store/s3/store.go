@@ -0,0 +1,177 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	DRIVER_NAME = "s3"
+)
+
+// Config S3兼容对象存储的配置
+type Config struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+}
+
+// NewStore 日志按天分目录保存在bucket中, 使用path style访问, 兼容MinIO
+func NewStore(conf *Config) (*Store, error) {
+	if conf.Endpoint == "" || conf.Bucket == "" {
+		return nil, fmt.Errorf("s3 endpoint and bucket required")
+	}
+	u, err := url.Parse(conf.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parse s3 endpoint %s error, %s", conf.Endpoint, err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("s3 endpoint must be like http(s)://host:port, but %s", conf.Endpoint)
+	}
+
+	region := conf.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+	return &Store{
+		endpoint:  u,
+		region:    region,
+		bucket:    conf.Bucket,
+		accessKey: conf.AccessKey,
+		secretKey: conf.SecretKey,
+		client:    http.DefaultClient,
+	}, nil
+}
+
+// Store S3兼容的对象存储
+type Store struct {
+	endpoint  *url.URL
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+}
+
+// SetHTTPClient 设置请求使用的客户端
+func (s *Store) SetHTTPClient(c *http.Client) {
+	s.client = c
+}
+
+func (s *Store) DriverName() string {
+	return DRIVER_NAME
+}
+
+func (s *Store) NewUploader(id string) *Uploader {
+	return &Uploader{
+		store:    s,
+		objectID: path.Join(dateDir(time.Now()), id),
+	}
+}
+
+// Open 读取日志, objectID为Uploader返回的ObjectID
+func (s *Store) Open(ctx context.Context, objectID string) (io.ReadCloser, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, objectID, nil)
+	if err != nil {
+		return nil, err
+	}
+	SignV4(req, s.accessKey, s.secretKey, s.region, EMPTY_PAYLOAD_HASH, time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, responseError(resp)
+	}
+	return resp.Body, nil
+}
+
+func (s *Store) put(ctx context.Context, objectID string, body io.Reader, size int64) error {
+	req, err := s.newRequest(ctx, http.MethodPut, objectID, body)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	if size == 0 {
+		req.Body = http.NoBody
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	SignV4(req, s.accessKey, s.secretKey, s.region, UNSIGNED_PAYLOAD, time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return responseError(resp)
+	}
+	return nil
+}
+
+func (s *Store) newRequest(ctx context.Context, method, objectID string, body io.Reader) (*http.Request, error) {
+	u := *s.endpoint
+	u.Path = path.Join("/", u.Path, s.bucket, path.Clean("/"+objectID))
+	return http.NewRequestWithContext(ctx, method, u.String(), body)
+}
+
+type Uploader struct {
+	store    *Store
+	objectID string
+}
+
+func (u *Uploader) DriverName() string {
+	return DRIVER_NAME
+}
+
+// ObjectID 日志在bucket中的key
+func (u *Uploader) ObjectID() string {
+	return u.objectID
+}
+
+// Upload 上传需要知道内容的长度, 先把日志写入临时文件, 结束后再上传
+func (u *Uploader) Upload(ctx context.Context, stream io.ReadCloser) error {
+	defer stream.Close()
+
+	f, err := ioutil.TempFile("", "step-log-")
+	if err != nil {
+		return fmt.Errorf("create temp file error, %s", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	size, err := io.Copy(f, stream)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	if err := u.store.put(ctx, u.objectID, f, size); err != nil {
+		return fmt.Errorf("upload log to s3 error, %s", err)
+	}
+	return nil
+}
+
+func responseError(resp *http.Response) error {
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("s3 response status %d, %s", resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+func dateDir(t time.Time) string {
+	year, month, day := t.Date()
+	return fmt.Sprintf("%d/%d/%d", year, int(month), day)
+}
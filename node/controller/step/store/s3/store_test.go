@@ -0,0 +1,80 @@
+package s3_test
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/infraboard/workflow/node/controller/step/store/s3"
+)
+
+// 内存中的S3, 只支持PUT和GET对象
+type fakeS3 struct {
+	objects map[string][]byte
+	l       sync.Mutex
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), s3.SIGN_ALGORITHM) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	f.l.Lock()
+	defer f.l.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		data, _ := ioutil.ReadAll(r.Body)
+		f.objects[r.URL.Path] = data
+	case http.MethodGet:
+		data, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("NoSuchKey"))
+			return
+		}
+		w.Write(data)
+	}
+}
+
+func TestUploadAndOpen(t *testing.T) {
+	should := assert.New(t)
+
+	fake := &fakeS3{objects: map[string][]byte{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	store, err := s3.NewStore(&s3.Config{
+		Endpoint:  server.URL,
+		Bucket:    "logs",
+		AccessKey: "ak",
+		SecretKey: "sk",
+	})
+	should.NoError(err)
+
+	up := store.NewUploader("ns01.p01.1.1")
+	should.Equal(s3.DRIVER_NAME, up.DriverName())
+	err = up.Upload(context.Background(), io.NopCloser(strings.NewReader("hello world")))
+	should.NoError(err)
+	should.Contains(fake.objects, "/logs/"+up.ObjectID())
+
+	r, err := store.Open(context.Background(), up.ObjectID())
+	if should.NoError(err) {
+		defer r.Close()
+		data, _ := ioutil.ReadAll(r)
+		should.Equal("hello world", string(data))
+	}
+
+	_, err = store.Open(context.Background(), "not_found")
+	should.Error(err)
+
+	_, err = s3.NewStore(&s3.Config{Endpoint: "127.0.0.1:9000", Bucket: "logs"})
+	should.Error(err)
+}
@@ -2,9 +2,14 @@ package store
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"sync"
 
+	"github.com/infraboard/workflow/conf"
 	"github.com/infraboard/workflow/node/controller/step/store/file"
+	"github.com/infraboard/workflow/node/controller/step/store/gridfs"
+	"github.com/infraboard/workflow/node/controller/step/store/s3"
 )
 
 // 保存Runner运行中的日志
@@ -19,12 +24,131 @@ type Uploader interface {
 	Upload(ctx context.Context, steam io.ReadCloser) error
 }
 
-func NewStore() *Store {
-	return &Store{}
+// 用于读取Uploader上传的日志
+type Reader interface {
+	DriverName() string
+	Open(ctx context.Context, objectID string) (io.ReadCloser, error)
+}
+
+// Backend 日志存储后端, 同时支持上传和读取
+type Backend interface {
+	StoreFactory
+	Reader
+}
+
+var (
+	current Backend = NewFileBackend(file.NewStore(file.DEFAULT_ROOT, 0))
+	readers         = map[string]Reader{current.DriverName(): current}
+	l       sync.RWMutex
+)
+
+// NewStore 返回配置的日志存储, 没有初始化时使用当前目录下的本地文件
+func NewStore() StoreFactory {
+	l.RLock()
+	defer l.RUnlock()
+	return current
+}
+
+// NewReader 返回driver对应的日志读取器, driver为上传时Uploader的DriverName
+func NewReader(driver string) (Reader, error) {
+	l.RLock()
+	defer l.RUnlock()
+	r, ok := readers[driver]
+	if !ok {
+		return nil, fmt.Errorf("log driver %s not configured", driver)
+	}
+	return r, nil
+}
+
+// Init 根据配置初始化日志存储
+// 配置的driver用于上传日志, 所有配置了的后端都可以用于读取日志, 用于切换driver后读取之前的日志
+func Init(cfg *conf.Config) error {
+	lc := cfg.StepLog
+	backends := []Backend{
+		NewFileBackend(file.NewStore(lc.File.Root, lc.File.MaxAge())),
+		NewGridFSBackend(gridfs.NewStore(cfg.Mongo.GetDB(), lc.GridFS.Bucket)),
+	}
+	if lc.S3.IsEnabled() {
+		s, err := s3.NewStore(&s3.Config{
+			Endpoint:  lc.S3.Endpoint,
+			Region:    lc.S3.Region,
+			Bucket:    lc.S3.Bucket,
+			AccessKey: lc.S3.AccessKey,
+			SecretKey: lc.S3.SecretKey,
+		})
+		if err != nil {
+			return err
+		}
+		backends = append(backends, NewS3Backend(s))
+	}
+
+	var selected Backend
+	rs := make(map[string]Reader, len(backends))
+	for _, b := range backends {
+		rs[b.DriverName()] = b
+		if driverMatch(lc.Driver, b.DriverName()) {
+			selected = b
+		}
+	}
+	if selected == nil {
+		return fmt.Errorf("step log driver %s not support or not configured", lc.Driver)
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	current = selected
+	readers = rs
+	return nil
+}
+
+// 配置中的driver名称和后端的DriverName不完全一样
+func driverMatch(driver, name string) bool {
+	switch driver {
+	case "", conf.STEP_LOG_DRIVER_FILE:
+		return name == file.DRIVER_NAME
+	case conf.STEP_LOG_DRIVER_S3:
+		return name == s3.DRIVER_NAME
+	case conf.STEP_LOG_DRIVER_GRIDFS:
+		return name == gridfs.DRIVER_NAME
+	}
+	return false
 }
 
-type Store struct{}
+// NewFileBackend 本地文件存储
+func NewFileBackend(s *file.Store) Backend {
+	return &fileBackend{s}
+}
+
+type fileBackend struct {
+	*file.Store
+}
+
+func (b *fileBackend) NewFileUploader(key string) Uploader {
+	return b.NewUploader(key)
+}
+
+// NewS3Backend S3兼容的对象存储
+func NewS3Backend(s *s3.Store) Backend {
+	return &s3Backend{s}
+}
+
+type s3Backend struct {
+	*s3.Store
+}
+
+func (b *s3Backend) NewFileUploader(key string) Uploader {
+	return b.NewUploader(key)
+}
+
+// NewGridFSBackend MongoDB GridFS存储
+func NewGridFSBackend(s *gridfs.Store) Backend {
+	return &gridfsBackend{s}
+}
+
+type gridfsBackend struct {
+	*gridfs.Store
+}
 
-func (s *Store) NewFileUploader(key string) Uploader {
-	return file.NewUploader(key)
+func (b *gridfsBackend) NewFileUploader(key string) Uploader {
+	return b.NewUploader(key)
 }
@@ -0,0 +1,33 @@
+package protocol
+
+import (
+	"net/http"
+
+	"github.com/infraboard/keyauth/app/micro"
+	kc "github.com/infraboard/keyauth/client"
+	"github.com/infraboard/keyauth/common/header"
+	"github.com/infraboard/mcube/exception"
+	"github.com/infraboard/mcube/http/response"
+	"github.com/infraboard/mcube/http/router"
+)
+
+// 节点接口只允许内部服务调用, 使用keyauth的服务凭证认证, 和GRPC的认证方式一样
+func newClientCredentialAuth(c *kc.Client) router.Middleware {
+	return router.MiddlewareFunc(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			clientId := r.Header.Get(header.ClientHeaderKey)
+			clientSecret := r.Header.Get(header.ClientSecretKey)
+			if clientId == "" || clientSecret == "" {
+				response.Failed(w, exception.NewUnauthorized("client_id or client_secret is \"\""))
+				return
+			}
+
+			req := micro.NewValidateClientCredentialRequest(clientId, clientSecret)
+			if _, err := c.Micro().ValidateClientCredential(r.Context(), req); err != nil {
+				response.Failed(w, exception.NewUnauthorized("service auth error, %s", err))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+}
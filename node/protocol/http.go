@@ -0,0 +1,82 @@
+package protocol
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/infraboard/mcube/http/middleware/recovery"
+	"github.com/infraboard/mcube/http/router"
+	"github.com/infraboard/mcube/http/router/httprouter"
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+
+	"github.com/infraboard/workflow/api/apps/node"
+	"github.com/infraboard/workflow/conf"
+)
+
+// NewHTTPService 节点的HTTP服务, 提供给API Server读取step日志和连接step的执行环境
+func NewHTTPService() (*HTTPService, error) {
+	c, err := conf.C().Keyauth.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	r := httprouter.New()
+	r.Use(recovery.NewWithLogger(zap.L().Named("Recovery")))
+	r.Use(newClientCredentialAuth(c))
+
+	// 日志会持续输出, 不设置写超时
+	server := &http.Server{
+		ReadHeaderTimeout: 20 * time.Second,
+		IdleTimeout:       120 * time.Second,
+		MaxHeaderBytes:    1 << 20,
+		Addr:              conf.C().HTTP.Addr(),
+		Handler:           r,
+	}
+	s := &HTTPService{
+		r:      r,
+		server: server,
+		l:      zap.L().Named("HTTP Service"),
+	}
+	s.registry(r.SubRouter(node.NODE_API_PREFIX))
+	return s, nil
+}
+
+// HTTPService http服务
+type HTTPService struct {
+	r      router.Router
+	l      logger.Logger
+	server *http.Server
+}
+
+func (s *HTTPService) registry(r router.SubRouter) {
+	h := &handler{log: zap.L().Named("Node API")}
+	r.Handle("GET", "/steps/:key/log", h.StepLog)
+	r.Handle("GET", "/steps/:key/terminal", h.StepTerminal)
+}
+
+// Start 启动服务
+func (s *HTTPService) Start() error {
+	s.l.Infof("HTTP服务启动成功, 监听地址: %s", s.server.Addr)
+	if err := s.server.ListenAndServe(); err != nil {
+		if err == http.ErrServerClosed {
+			s.l.Info("service is stopped")
+			return nil
+		}
+		return fmt.Errorf("start service error, %s", err.Error())
+	}
+	return nil
+}
+
+// Stop 停止server
+func (s *HTTPService) Stop() error {
+	s.l.Info("start graceful shutdown")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := s.server.Shutdown(ctx); err != nil {
+		s.l.Errorf("graceful shutdown timeout, force exit")
+	}
+	return nil
+}
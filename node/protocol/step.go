@@ -0,0 +1,107 @@
+package protocol
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/infraboard/mcube/exception"
+	"github.com/infraboard/mcube/http/context"
+	"github.com/infraboard/mcube/http/response"
+	"github.com/infraboard/mcube/logger"
+
+	"github.com/infraboard/workflow/api/apps/node"
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/node/controller/step/engine"
+	"github.com/infraboard/workflow/node/controller/step/runner"
+	"github.com/infraboard/workflow/node/controller/step/store"
+)
+
+const (
+	// 每次发送的日志大小
+	LOG_CHUNK_SIZE = 32 * 1024
+)
+
+type handler struct {
+	log logger.Logger
+}
+
+// StepLog 读取step的日志, step运行中时读取实时日志, 否则读取log_driver和log_path指定的持久化日志
+// 参数:
+//   offset: 从日志的该位置开始读取
+//   tail: 只读取最后N行
+//   follow: 持续读取直到step结束
+func (h *handler) StepLog(w http.ResponseWriter, r *http.Request) {
+	ctx := context.GetContext(r)
+	qs := r.URL.Query()
+
+	s := pipeline.NewDefaultStep()
+	s.Key = ctx.PS.ByName("key")
+	req := runner.NewLogRequest(s)
+	req.Offset, _ = strconv.ParseInt(qs.Get("offset"), 10, 64)
+	req.Tail, _ = strconv.Atoi(qs.Get("tail"))
+	req.Follow = qs.Get("follow") == "true"
+
+	rc, start, err := h.openLog(r, req)
+	if err != nil {
+		response.Failed(w, err)
+		return
+	}
+	defer rc.Close()
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set(node.STEP_LOG_OFFSET_HEADER, strconv.FormatInt(start, 10))
+	w.WriteHeader(http.StatusOK)
+
+	// 每次读取到日志都立即发送给调用方
+	flusher, _ := w.(http.Flusher)
+	buf := make([]byte, LOG_CHUNK_SIZE)
+	for {
+		n, err := rc.Read(buf)
+		if n > 0 {
+			if _, err := w.Write(buf[:n]); err != nil {
+				h.log.Debugf("write step %s log error, %s", s.Key, err)
+				return
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+		if err != nil {
+			if err != io.EOF {
+				h.log.Debugf("read step %s log error, %s", s.Key, err)
+			}
+			return
+		}
+	}
+}
+
+func (h *handler) openLog(r *http.Request, req *runner.LogRequest) (io.ReadCloser, int64, error) {
+	rc, err := engine.Log(r.Context(), req)
+	if err == nil {
+		start := req.Offset
+		if o, ok := rc.(interface{ Offset() int64 }); ok {
+			start = o.Offset()
+		}
+		return rc, start, nil
+	}
+	if !errors.Is(err, runner.ErrLogNotFound) {
+		return nil, 0, err
+	}
+
+	// step已经结束, 读取持久化的日志, 本地文件只有运行的节点才能读取
+	driver, path := r.URL.Query().Get("log_driver"), r.URL.Query().Get("log_path")
+	if driver == "" || path == "" {
+		return nil, 0, exception.NewNotFound("step %s log not found", req.Step.Key)
+	}
+	reader, err := store.NewReader(driver)
+	if err != nil {
+		return nil, 0, exception.NewBadRequest(err.Error())
+	}
+	rc, err = reader.Open(r.Context(), path)
+	if err != nil {
+		return nil, 0, exception.NewNotFound("open step %s log error, %s", req.Step.Key, err)
+	}
+	return store.Seek(rc, req.Offset, req.Tail)
+}
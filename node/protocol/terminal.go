@@ -0,0 +1,166 @@
+package protocol
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	hc "github.com/infraboard/mcube/http/context"
+	"github.com/infraboard/mcube/logger"
+
+	"github.com/infraboard/workflow/api/apps/node"
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/node/controller/step/engine"
+	"github.com/infraboard/workflow/node/controller/step/runner"
+)
+
+var (
+	// 升级为ws协议, 只有API Server会连接节点
+	upgrader = websocket.Upgrader{
+		HandshakeTimeout: 60 * time.Second,
+		ReadBufferSize:   8192,
+		WriteBufferSize:  8192,
+		CheckOrigin: func(r *http.Request) bool {
+			return true
+		},
+	}
+)
+
+// StepTerminal 连接到运行中的step的执行环境
+// 参数:
+//   command: 执行的命令, 可以重复传入作为命令的参数, 默认为/bin/sh
+//   tty: 是否分配终端, 默认为true
+func (h *handler) StepTerminal(w http.ResponseWriter, r *http.Request) {
+	ctx := hc.GetContext(r)
+	qs := r.URL.Query()
+
+	s := pipeline.NewDefaultStep()
+	s.Key = ctx.PS.ByName("key")
+	req := runner.NewConnectRequest(s)
+	if cmd := qs["command"]; len(cmd) > 0 {
+		req.Command = cmd
+	}
+	req.Tty = qs.Get("tty") != "false"
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		h.log.Errorf("error upgrading websocket: %s", err)
+		return
+	}
+	defer conn.Close()
+
+	connectCtx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	t := newWebsocketTerminal(conn, h.log)
+	go t.readLoop(cancel)
+	req.Stdin = t.stdin
+	req.Stdout = t
+	if req.Tty {
+		req.TerminalSizeQueue = t
+	}
+
+	code, reason := websocket.CloseNormalClosure, ""
+	if err := engine.Connect(connectCtx, req); err != nil {
+		h.log.Debugf("connect to step %s error, %s", s.Key, err)
+		code, reason = websocket.CloseInternalServerErr, closeReason(err)
+	}
+	msg := websocket.FormatCloseMessage(code, reason)
+	conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(time.Second))
+}
+
+// websocket关闭消息的原因最长123字节
+func closeReason(err error) string {
+	reason := err.Error()
+	if len(reason) > 123 {
+		reason = reason[:123]
+	}
+	return reason
+}
+
+func newWebsocketTerminal(conn *websocket.Conn, log logger.Logger) *websocketTerminal {
+	pr, pw := io.Pipe()
+	return &websocketTerminal{
+		conn:  conn,
+		log:   log,
+		stdin: pr,
+		in:    pw,
+		sizes: make(chan *runner.TerminalSize, 1),
+	}
+}
+
+// websocketTerminal 把websocket连接适配为终端的输入输出
+type websocketTerminal struct {
+	conn  *websocket.Conn
+	log   logger.Logger
+	stdin *io.PipeReader
+	in    *io.PipeWriter
+	sizes chan *runner.TerminalSize
+	wl    sync.Mutex
+}
+
+// 读取客户端的消息, 连接断开时结束输入并取消连接
+func (t *websocketTerminal) readLoop(cancel context.CancelFunc) {
+	defer func() {
+		t.in.Close()
+		close(t.sizes)
+		cancel()
+	}()
+
+	for {
+		_, data, err := t.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		msg := &node.TerminalMessage{}
+		if err := json.Unmarshal(data, msg); err != nil {
+			t.log.Debugf("unmarshal terminal message error, %s", err)
+			continue
+		}
+		switch msg.Op {
+		case node.TERMINAL_OP_STDIN:
+			if _, err := t.in.Write([]byte(msg.Data)); err != nil {
+				return
+			}
+		case node.TERMINAL_OP_RESIZE:
+			t.resize(&runner.TerminalSize{Width: msg.Cols, Height: msg.Rows})
+		default:
+			t.log.Debugf("unknown terminal op %s", msg.Op)
+		}
+	}
+}
+
+// 只保留最新的终端大小
+func (t *websocketTerminal) resize(size *runner.TerminalSize) {
+	for {
+		select {
+		case t.sizes <- size:
+			return
+		default:
+		}
+		select {
+		case <-t.sizes:
+		default:
+		}
+	}
+}
+
+// Next 实现TerminalSizeQueue, 连接断开后返回nil
+func (t *websocketTerminal) Next() *runner.TerminalSize {
+	return <-t.sizes
+}
+
+// Write 终端的输出使用二进制消息发送
+func (t *websocketTerminal) Write(p []byte) (int, error) {
+	t.wl.Lock()
+	defer t.wl.Unlock()
+	if err := t.conn.WriteMessage(websocket.BinaryMessage, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -9,18 +9,26 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/infraboard/mcube/bus"
+	"github.com/infraboard/mcube/bus/broker/nats"
 	"github.com/infraboard/mcube/logger"
 	"github.com/infraboard/mcube/logger/zap"
 	"github.com/spf13/cobra"
 
 	"github.com/infraboard/workflow/api/apps/node"
 	etcd_register "github.com/infraboard/workflow/api/apps/node/etcd"
+	pipeline_api "github.com/infraboard/workflow/api/apps/pipeline"
+	pipeline_archive "github.com/infraboard/workflow/api/apps/pipeline/archive"
 	"github.com/infraboard/workflow/conf"
+	"github.com/infraboard/workflow/scheduler/controller/archive"
+	"github.com/infraboard/workflow/scheduler/controller/cronjob"
 	node_controller "github.com/infraboard/workflow/scheduler/controller/node"
 	"github.com/infraboard/workflow/scheduler/controller/pipeline"
 	"github.com/infraboard/workflow/scheduler/controller/step"
 	"github.com/infraboard/workflow/version"
 
+	cronjob_informer "github.com/infraboard/workflow/common/informers/cronjob"
+	ci_impl "github.com/infraboard/workflow/common/informers/cronjob/etcd"
 	node_informer "github.com/infraboard/workflow/common/informers/node"
 	ni_impl "github.com/infraboard/workflow/common/informers/node/etcd"
 	pipeline_informer "github.com/infraboard/workflow/common/informers/pipeline"
@@ -48,6 +56,11 @@ var serviceCmd = &cobra.Command{
 		}
 		cfg := conf.C()
 
+		// 加载总线, 用于发布pipeline和step的生命周期事件
+		if err := loadGlobalBus(); err != nil {
+			zap.L().Warnf("load global bus error, %s", err)
+		}
+
 		// 启动服务
 		ch := make(chan os.Signal, 1)
 		signal.Notify(ch, syscall.SIGTERM, syscall.SIGINT, syscall.SIGHUP, syscall.SIGQUIT)
@@ -85,9 +98,12 @@ type service struct {
 	ni   node_informer.Informer
 	pi   pipeline_informer.Informer
 	si   step_informer.Informer
+	ci   cronjob_informer.Informer
 	pc   *pipeline.Controller
 	nc   *node_controller.Controller
 	sc   *step.Controller
+	cc   *cronjob.Controller
+	ac   *archive.Controller
 	log  logger.Logger
 	stop context.CancelFunc
 }
@@ -100,18 +116,41 @@ func newService(cfg *conf.Config) (*service, error) {
 	ni := ni_impl.NewInformer(cfg.Etcd.GetClient(), nil)
 	si := si_impl.NewInformer(cfg.Etcd.GetClient())
 	pi := pi_impl.NewInformerr(cfg.Etcd.GetClient(), nil)
+	ci := ci_impl.NewInformer(cfg.Etcd.GetClient())
 
 	nc := node_controller.NewNodeController(ni, si.Lister(), si.Recorder())
 	pc := pipeline.NewPipelineController(rn.InstanceName, ni.GetStore(), pi, si)
 	sc := step.NewStepController(rn.InstanceName, ni.GetStore(), si, pc.UpdateStepCallback)
+	cc := cronjob.NewCronJobController(rn.InstanceName, cfg.Etcd.GetClient(), ci, pi, si)
+
+	// step事件和超时只由一个实例处理: pipeline创建的step由调度该pipeline的实例处理, 单独创建的step由leader处理
+	sc.SetStepOwner(func(s *pipeline_api.Step) bool {
+		if s.IsCreateByPipeline() {
+			return pc.IsStepOwner(s)
+		}
+		return cc.IsLeader()
+	})
+
+	// 归档结束的pipeline
+	var ac *archive.Controller
+	if cfg.Archive.Enabled {
+		store, err := pipeline_archive.NewStore(cfg.Mongo.GetDB())
+		if err != nil {
+			return nil, err
+		}
+		ac = archive.NewArchiveController(rn.InstanceName, cfg.Etcd.GetClient(), pi, store, cfg.Archive.Retention())
+	}
 
 	svr := &service{
 		ni:   ni,
 		si:   si,
 		pi:   pi,
+		ci:   ci,
 		pc:   pc,
 		nc:   nc,
 		sc:   sc,
+		cc:   cc,
+		ac:   ac,
 		log:  zap.L().Named("CLI"),
 		node: rn,
 	}
@@ -136,6 +175,10 @@ func (s *service) start() error {
 	if err := s.si.Watcher().Run(ctx); err != nil {
 		return err
 	}
+	// cronjob informer
+	if err := s.ci.Watcher().Run(ctx); err != nil {
+		return err
+	}
 
 	// 启动 node controller
 	if err := s.nc.AsyncRun(ctx); err != nil {
@@ -145,6 +188,16 @@ func (s *service) start() error {
 	if err := s.pc.AsyncRun(ctx); err != nil {
 		return err
 	}
+	// 启动 cronjob controller
+	if err := s.cc.AsyncRun(ctx); err != nil {
+		return err
+	}
+	// 启动 archive controller
+	if s.ac != nil {
+		if err := s.ac.AsyncRun(ctx); err != nil {
+			return err
+		}
+	}
 	// 启动 step controller
 	if err := s.sc.Run(ctx); err != nil {
 		return err
@@ -246,6 +299,34 @@ func loadGloabl(configType string) error {
 	return nil
 }
 
+func loadGlobalBus() error {
+	log := zap.L().Named("BUS INIT")
+	bc := conf.C().Bus
+
+	switch bc.Type {
+	case "nats":
+		nc := conf.C().Nats
+		if len(nc.Servers) == 0 {
+			log.Infof("new nats broker not config: %v", nc.Servers)
+			return nil
+		}
+
+		broker, err := nats.NewBroker(nc)
+		if err != nil {
+			log.Errorf("new nats broker error, %s", err)
+			return nil
+		}
+
+		if err := broker.Connect(); err != nil {
+			return err
+		}
+		bus.SetPublisher(broker)
+	case "kafka":
+	}
+
+	return nil
+}
+
 func init() {
 	serviceCmd.Flags().StringVarP(&confType, "config-type", "t", "file", "the service config type [file/env/etcd]")
 	serviceCmd.Flags().StringVarP(&confFile, "config-file", "f", "etc/workflow.toml", "the service config from file")
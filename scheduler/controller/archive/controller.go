@@ -0,0 +1,177 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+	"github.com/infraboard/workflow/api/apps/pipeline/archive"
+
+	informer "github.com/infraboard/workflow/common/informers/pipeline"
+)
+
+const (
+	// 检查需要归档的pipeline的间隔
+	ARCHIVE_CHECK_INTERVAL = 5 * time.Minute
+	// 从etcd删除失败后撤销归档的超时时间, 不使用检查的ctx, 退出时也需要撤销
+	UNARCHIVE_TIMEOUT = 10 * time.Second
+)
+
+// NewArchiveController 把结束超过retention的pipeline和它的step从etcd归档到mongodb
+// 每个调度器只归档自己调度的pipeline
+func NewArchiveController(
+	schedulerName string,
+	client *clientv3.Client,
+	pi informer.Informer,
+	store *archive.Store,
+	retention time.Duration,
+) *Controller {
+	return &Controller{
+		schedulerName: schedulerName,
+		client:        client,
+		informer:      pi,
+		store:         store,
+		retention:     retention,
+		log:           zap.L().Named("Archive"),
+	}
+}
+
+// Controller 归档控制器
+type Controller struct {
+	schedulerName string
+	client        *clientv3.Client
+	informer      informer.Informer
+	store         *archive.Store
+	retention     time.Duration
+	log           logger.Logger
+}
+
+func (c *Controller) Debug(log logger.Logger) {
+	c.log = log
+}
+
+// AsyncRun 定期归档, 直到ctx结束
+func (c *Controller) AsyncRun(ctx context.Context) error {
+	c.log.Infof("starting archive control loop, schedule name: %s, retention: %s",
+		c.schedulerName, c.retention)
+	go c.run(ctx)
+	return nil
+}
+
+func (c *Controller) run(ctx context.Context) {
+	ticker := time.NewTicker(ARCHIVE_CHECK_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.archive(ctx)
+		}
+	}
+}
+
+func (c *Controller) archive(ctx context.Context) {
+	ps, err := c.informer.Lister().List(ctx, nil)
+	if err != nil {
+		c.log.Errorf("list pipeline for archive error, %s", err)
+		return
+	}
+
+	now := time.Now()
+	for i := range ps.Items {
+		p := ps.Items[i]
+		if !p.MatchScheduler(c.schedulerName) || !p.IsArchivable(now, c.retention) {
+			continue
+		}
+
+		if err := c.archivePipeline(ctx, p); err != nil {
+			c.log.Errorf("archive pipeline %s error, %s", p.ShortDescribe(), err)
+			continue
+		}
+		c.log.Infof("archive pipeline %s success", p.ShortDescribe())
+	}
+}
+
+// 先保存到mongodb, 再从etcd中删除
+// 删除时比较pipeline的版本, 如果归档过程中pipeline有变化(比如重试), 放弃删除, 下次再归档
+func (c *Controller) archivePipeline(ctx context.Context, p *pipeline.Pipeline) error {
+	key := p.EtcdObjectKey()
+	resp, err := c.client.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if resp.Count == 0 {
+		return nil
+	}
+
+	kv := resp.Kvs[0]
+	ins, err := pipeline.LoadPipelineFromBytes(kv.Value)
+	if err != nil {
+		return err
+	}
+	if !ins.IsArchivable(time.Now(), c.retention) {
+		return nil
+	}
+
+	stepPrefix := pipeline.StepObjectKey(ins.StepPrefix())
+	stepResp, err := c.client.Get(ctx, stepPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return err
+	}
+	steps := make([]*pipeline.Step, 0, len(stepResp.Kvs))
+	for i := range stepResp.Kvs {
+		s, err := pipeline.LoadStepFromBytes(stepResp.Kvs[i].Value)
+		if err != nil {
+			// 不能丢失step, 放弃归档, 保留在etcd中
+			return fmt.Errorf("load step %s error, %s", stepResp.Kvs[i].Key, err)
+		}
+		steps = append(steps, s)
+	}
+
+	if err := c.store.Save(ctx, ins, steps); err != nil {
+		return err
+	}
+
+	txnResp, err := c.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(key), "=", kv.ModRevision)).
+		Then(clientv3.OpDelete(key), clientv3.OpDelete(stepPrefix, clientv3.WithPrefix())).
+		Commit()
+	if err != nil {
+		c.unarchive(ins, key)
+		return err
+	}
+	if !txnResp.Succeeded {
+		c.unarchive(ins, "")
+		return fmt.Errorf("pipeline changed during archive, retry next time")
+	}
+	return nil
+}
+
+// 从etcd删除失败时撤销归档, 避免同一个pipeline同时存在于etcd和归档中
+// key不为空时先检查pipeline是否还在etcd中, 请求出错时可能已经删除成功
+func (c *Controller) unarchive(p *pipeline.Pipeline, key string) {
+	ctx, cancel := context.WithTimeout(context.Background(), UNARCHIVE_TIMEOUT)
+	defer cancel()
+
+	if key != "" {
+		resp, err := c.client.Get(ctx, key, clientv3.WithCountOnly())
+		if err != nil {
+			c.log.Errorf("check pipeline %s before unarchive error, %s", p.ShortDescribe(), err)
+			return
+		}
+		if resp.Count == 0 {
+			return
+		}
+	}
+
+	if err := c.store.Unarchive(ctx, p); err != nil {
+		c.log.Errorf("unarchive pipeline %s error, %s", p.ShortDescribe(), err)
+	}
+}
@@ -1 +1,262 @@
 package cronjob
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/infraboard/mcube/logger"
+	"github.com/infraboard/mcube/logger/zap"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/infraboard/workflow/api/apps/cronjob"
+	"github.com/infraboard/workflow/common/events"
+
+	informer "github.com/infraboard/workflow/common/informers/cronjob"
+	pipeline_informer "github.com/infraboard/workflow/common/informers/pipeline"
+	step_informer "github.com/infraboard/workflow/common/informers/step"
+)
+
+const (
+	// 定期同步所有cronjob, 更新运行中的pipeline以及清理历史记录
+	CRONJOB_RESYNC_INTERVAL = 30 * time.Second
+)
+
+// NewCronJobController cronjob controller
+// 多个调度器实例通过etcd选主, 只有leader负责按计划创建pipeline
+func NewCronJobController(
+	schedulerName string,
+	client *clientv3.Client,
+	ci informer.Informer,
+	pi pipeline_informer.Informer,
+	si step_informer.Informer,
+) *Controller {
+	wq := workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "CronJob")
+	controller := &Controller{
+		schedulerName:  schedulerName,
+		client:         client,
+		informer:       ci,
+		pipeline:       pi,
+		step:           si,
+		workqueue:      wq,
+		workerNums:     2,
+		events:         events.NewBusPublisher(schedulerName),
+		log:            zap.L().Named("CronJob"),
+		runningWorkers: make(map[string]struct{}, 2),
+	}
+
+	ci.Watcher().AddCronJobEventHandler(informer.CronJobEventHandlerFuncs{
+		AddFunc:    controller.enqueueForAdd,
+		UpdateFunc: controller.enqueueForUpdate,
+		DeleteFunc: controller.handleDelete,
+	})
+
+	return controller
+}
+
+// Controller cronjob控制器
+type Controller struct {
+	// workqueue is a rate limited work queue. This is used to queue work to be
+	// processed instead of performing it as soon as a change happens. This
+	// means we can ensure we only process a fixed amount of resources at a
+	// time, and makes it easy to ensure we are never processing the same item
+	// simultaneously in two different workers.
+	workqueue      workqueue.RateLimitingInterface
+	informer       informer.Informer
+	pipeline       pipeline_informer.Informer
+	step           step_informer.Informer
+	client         *clientv3.Client
+	events         events.Publisher
+	log            logger.Logger
+	workerNums     int
+	runningWorkers map[string]struct{}
+	wLock          sync.Mutex
+	schedulerName  string
+	leader         int32
+}
+
+func (c *Controller) Debug(log logger.Logger) {
+	c.log = log
+}
+
+// SetEventPublisher 设置生命周期事件的发布者
+func (c *Controller) SetEventPublisher(p events.Publisher) {
+	c.events = p
+}
+
+// IsLeader 当前实例是否是leader
+func (c *Controller) IsLeader() bool {
+	return atomic.LoadInt32(&c.leader) == 1
+}
+
+func (c *Controller) setLeader(isLeader bool) {
+	var v int32
+	if isLeader {
+		v = 1
+	}
+	atomic.StoreInt32(&c.leader, v)
+}
+
+func (c *Controller) Run(ctx context.Context) error {
+	return c.run(ctx, false)
+}
+
+func (c *Controller) AsyncRun(ctx context.Context) error {
+	return c.run(ctx, true)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers. It will block until stopCh
+// is closed, at which point it will shutdown the workqueue and wait for
+// workers to finish processing their current work items.
+func (c *Controller) run(ctx context.Context, async bool) error {
+	c.log.Infof("starting cronjob control loop, schedule name: %s", c.schedulerName)
+
+	// 启动worker 处理来自Informer的事件
+	for i := 0; i < c.workerNums; i++ {
+		go c.runWorker(fmt.Sprintf("worker-%d", i))
+	}
+
+	// 竞选leader, 成为leader后才开始调度
+	go c.runLeaderElection(ctx)
+
+	if async {
+		go c.waitDown(ctx)
+	} else {
+		c.waitDown(ctx)
+	}
+
+	return nil
+}
+
+func (c *Controller) waitDown(ctx context.Context) {
+	<-ctx.Done()
+	// 关闭队列
+	c.workqueue.ShutDown()
+	// 停止worker
+	c.log.Infof("cronjob controller stopping, waitting for worker stop...")
+	// 等待worker退出
+	var max int
+	for {
+		if len(c.runningWorkers) == 0 {
+			break
+		}
+		c.log.Infof("waiting worker %s exit ...", c.runningWorkerNames())
+		if max > 30 {
+			c.log.Warnf("waiting worker %s max times(30s) force exit", c.runningWorkerNames())
+			break
+		}
+		max++
+		time.Sleep(1 * time.Second)
+	}
+	c.log.Infof("cronjob controller worker stopped commplet, now workers: %s", c.runningWorkerNames())
+}
+
+// 成为leader后, 重新处理所有的cronjob
+func (c *Controller) sync(ctx context.Context) error {
+	set, err := c.informer.Lister().List(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range set.Items {
+		c.workqueue.Add(set.Items[i].MakeObjectKey())
+	}
+	c.log.Infof("%d cronjob need sync", len(set.Items))
+	return nil
+}
+
+// 定期处理所有cronjob, pipeline的状态变化不会通知到cronjob
+func (c *Controller) resync() {
+	keys := c.informer.GetStore().ListKeys()
+	for i := range keys {
+		c.workqueue.Add(keys[i])
+	}
+}
+
+// runWorker is a long-running function that will continually call the
+// processNextWorkItem function in order to read and process a message on the
+// workqueue.
+func (c *Controller) runWorker(name string) {
+	c.wLock.Lock()
+	if _, ok := c.runningWorkers[name]; ok {
+		c.wLock.Unlock()
+		c.log.Warnf("worker %s has running", name)
+		return
+	}
+	c.runningWorkers[name] = struct{}{}
+	c.log.Infof("start worker %s", name)
+	c.wLock.Unlock()
+
+	for c.processNextWorkItem() {
+	}
+
+	c.wLock.Lock()
+	delete(c.runningWorkers, name)
+	c.wLock.Unlock()
+	c.log.Infof("worker %s has stopped", name)
+}
+
+// processNextWorkItem will read a single work item off the workqueue and
+// attempt to process it, by calling the syncHandler.
+func (c *Controller) processNextWorkItem() bool {
+	obj, shutdown := c.workqueue.Get()
+	if shutdown {
+		return false
+	}
+	c.log.Debugf("get obj from queue: %s", obj)
+
+	err := func(obj interface{}) error {
+		defer c.workqueue.Done(obj)
+
+		key, ok := obj.(string)
+		if !ok {
+			c.workqueue.Forget(obj)
+			c.log.Errorf("expected string in workqueue but got %#v", obj)
+			return nil
+		}
+
+		if err := c.syncHandler(key); err != nil {
+			c.workqueue.AddRateLimited(key)
+			return fmt.Errorf("error syncing '%s': %s, requeuing", key, err.Error())
+		}
+
+		c.workqueue.Forget(obj)
+		c.log.Debugf("successfully synced '%s'", key)
+		return nil
+	}(obj)
+	if err != nil {
+		c.log.Error(err)
+	}
+	return true
+}
+
+func (c *Controller) runningWorkerNames() string {
+	c.wLock.Lock()
+	defer c.wLock.Unlock()
+	kList := make([]string, 0, len(c.runningWorkers))
+	for k := range c.runningWorkers {
+		kList = append(kList, k)
+	}
+	return strings.Join(kList, ",")
+}
+
+func (c *Controller) enqueueForAdd(cj *cronjob.CronJob) {
+	c.log.Infof("receive add cronjob: %s", cj.ShortDescribe())
+	c.workqueue.Add(cj.MakeObjectKey())
+}
+
+// 调度器自己更新状态也会触发, 状态没有变化时不会再次更新, 因此不会循环
+func (c *Controller) enqueueForUpdate(old, new *cronjob.CronJob) {
+	c.log.Debugf("receive update cronjob: %s", new.ShortDescribe())
+	c.workqueue.Add(new.MakeObjectKey())
+}
+
+// 删除后, 已经创建的pipeline继续运行, 延迟队列中的key处理时会因为找不到而跳过
+func (c *Controller) handleDelete(cj *cronjob.CronJob) {
+	c.log.Infof("receive delete cronjob: %s", cj.ShortDescribe())
+}
@@ -1 +1,260 @@
 package cronjob
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/infraboard/workflow/api/apps/cronjob"
+	"github.com/infraboard/workflow/api/apps/pipeline"
+)
+
+// syncHandler compares the actual state with the desired, and attempts to
+// converge the two. It then updates the Status block of the CronJob resource
+// with the current status of the resource.
+func (c *Controller) syncHandler(key string) error {
+	// 只有leader负责调度, 成为leader后会重新处理所有的cronjob
+	if !c.IsLeader() {
+		c.log.Debugf("%s is not cronjob leader, skip sync %s", c.schedulerName, key)
+		return nil
+	}
+
+	obj, ok, err := c.informer.GetStore().GetByKey(key)
+	if err != nil {
+		return err
+	}
+
+	// 如果不存在, 这期望行为为删除 (DEL)
+	if !ok {
+		c.log.Debugf("removed cronjob: %s, skip", key)
+		return nil
+	}
+
+	cj, isOK := obj.(*cronjob.CronJob)
+	if !isOK {
+		return fmt.Errorf("object %T invalidate, is not *cronjob.CronJob obj, ", obj)
+	}
+
+	// 不直接修改缓存中的对象
+	ins, err := cj.Clone()
+	if err != nil {
+		return err
+	}
+	return c.syncCronJob(ins, time.Now())
+}
+
+// 处理流程如下:
+// 1. 同步正在运行的pipeline, 清理超过保留数量的历史记录
+// 2. 计算到期的计划时间, 按照MissedPolicy处理错过的运行
+// 3. 按照ConcurrencyPolicy创建pipeline
+// 4. 状态有变化时更新cronjob, 并在下一次计划时间再次处理
+func (c *Controller) syncCronJob(cj *cronjob.CronJob, now time.Time) error {
+	if cj.Status == nil {
+		cj.Status = cronjob.NewDefaultCronJobStatus()
+	}
+	old := cj.Status.Clone()
+
+	owned, err := c.listPipelines(cj)
+	if err != nil {
+		return err
+	}
+	active := c.syncHistory(cj, owned)
+
+	var next time.Time
+	if cj.Suspend {
+		cj.Status.NextScheduleAt = 0
+	} else {
+		due, err := cj.DueTimes(now)
+		if err != nil {
+			return err
+		}
+
+		runs := cj.FilterMissed(due, now)
+		if skipped := len(due) - len(runs); skipped > 0 {
+			cj.Status.Message = fmt.Sprintf("skip %d missed runs, missed policy: %s", skipped, cj.MissedPolicy)
+			c.log.Warnf("cronjob %s %s", cj.ShortDescribe(), cj.Status.Message)
+		}
+
+		for i := range runs {
+			if active, err = c.runAt(cj, runs[i], owned, active); err != nil {
+				c.saveStatus(cj, old, active)
+				return err
+			}
+		}
+
+		// 错过没有运行的也算作已经处理
+		if len(due) > 0 {
+			cj.Status.LastScheduleAt = due[len(due)-1].UnixMilli()
+		}
+
+		next, err = cj.NextScheduleTime(now)
+		if err != nil {
+			return err
+		}
+		cj.Status.NextScheduleAt = 0
+		if !next.IsZero() {
+			cj.Status.NextScheduleAt = next.UnixMilli()
+		}
+	}
+
+	c.saveStatus(cj, old, active)
+
+	// 到下一次计划时间再处理
+	if !next.IsZero() {
+		c.workqueue.AddAfter(cj.MakeObjectKey(), next.Sub(now))
+	}
+	return nil
+}
+
+// 状态没有变化时不更新, 避免更新事件导致循环处理
+func (c *Controller) saveStatus(cj *cronjob.CronJob, old *cronjob.CronJobStatus, active []*pipeline.Pipeline) {
+	cj.Status.Active = make([]string, 0, len(active))
+	for i := range active {
+		cj.Status.Active = append(cj.Status.Active, active[i].Id)
+	}
+
+	if cj.Status.Equal(old) {
+		return
+	}
+	if err := c.informer.Recorder().Update(cj); err != nil {
+		c.log.Errorf("update cronjob %s status error, %s", cj.ShortDescribe(), err)
+	}
+}
+
+// 计划时间到期, 按照并发策略运行pipeline, 返回运行中的pipeline
+func (c *Controller) runAt(cj *cronjob.CronJob, scheduleAt time.Time, owned, active []*pipeline.Pipeline) (
+	[]*pipeline.Pipeline, error) {
+	// 同一个计划时间只创建一次, 比如上一次创建后更新状态失败
+	id := cj.PipelineId(scheduleAt)
+	for i := range owned {
+		if owned[i].Id == id {
+			c.log.Debugf("cronjob %s run at %s has created, skip", cj.ShortDescribe(), scheduleAt)
+			return active, nil
+		}
+	}
+
+	if len(active) > 0 {
+		switch cj.ConcurrencyPolicy {
+		case cronjob.CONCURRENCY_POLICY_FORBID:
+			cj.Status.Message = fmt.Sprintf("skip run at %s, pipeline %s is still running",
+				scheduleAt.Format(time.RFC3339), activeNames(active))
+			c.log.Infof("cronjob %s %s", cj.ShortDescribe(), cj.Status.Message)
+			return active, nil
+		case cronjob.CONCURRENCY_POLICY_REPLACE:
+			c.replace(cj, scheduleAt, active)
+			active = []*pipeline.Pipeline{}
+		}
+	}
+
+	p, err := cj.NewPipeline(scheduleAt)
+	if err != nil {
+		return active, fmt.Errorf("new cronjob %s pipeline error, %s", cj.ShortDescribe(), err)
+	}
+	if err := c.pipeline.Recorder().Update(p); err != nil {
+		return active, fmt.Errorf("create cronjob %s pipeline error, %s", cj.ShortDescribe(), err)
+	}
+	// 只有leader创建pipeline, 由leader发布创建事件
+	c.events.PublishPipeline(nil, p)
+
+	cj.Status.Message = fmt.Sprintf("run pipeline %s at %s", p.Id, scheduleAt.Format(time.RFC3339))
+	c.log.Infof("cronjob %s %s", cj.ShortDescribe(), cj.Status.Message)
+	return append(active, p), nil
+}
+
+// 结束正在运行的pipeline, 为新的运行让路
+func (c *Controller) replace(cj *cronjob.CronJob, scheduleAt time.Time, active []*pipeline.Pipeline) {
+	msg := fmt.Sprintf("replaced by cronjob %s run at %s", cj.Name, scheduleAt.Format(time.RFC3339))
+	for i := range active {
+		var steps []*pipeline.Step
+		err := c.pipeline.Recorder().Modify(active[i], func(p *pipeline.Pipeline) (bool, error) {
+			if p.IsComplete() {
+				return false, nil
+			}
+			steps = p.Terminate(pipeline.PIPELINE_STATUS_CANCELED, msg)
+			return true, nil
+		})
+		if err != nil {
+			c.log.Errorf("update replaced pipeline %s error, %s", active[i].ShortDescribe(), err)
+			continue
+		}
+
+		for j := range steps {
+			if c.step == nil {
+				break
+			}
+			if err := c.step.Recorder().Update(steps[j].Clone()); err != nil {
+				c.log.Errorf("update replaced step %s error, %s", steps[j].Key, err)
+			}
+		}
+	}
+}
+
+// 查询cronjob创建的pipeline, informer的缓存只包含启动后watch到的, 因此直接从etcd中查询
+func (c *Controller) listPipelines(cj *cronjob.CronJob) ([]*pipeline.Pipeline, error) {
+	set, err := c.pipeline.Lister().List(context.Background(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("list cronjob %s pipelines error, %s", cj.ShortDescribe(), err)
+	}
+
+	owned := []*pipeline.Pipeline{}
+	for i := range set.Items {
+		if cj.IsOwnerOf(set.Items[i]) {
+			owned = append(owned, set.Items[i])
+		}
+	}
+	return owned, nil
+}
+
+// 返回正在运行的pipeline, 已经结束的按照成功和失败分别保留指定数量, 超过的删除
+func (c *Controller) syncHistory(cj *cronjob.CronJob, owned []*pipeline.Pipeline) (active []*pipeline.Pipeline) {
+	var succeeded, failed []*pipeline.Pipeline
+	for _, p := range owned {
+		switch {
+		case !p.IsComplete():
+			active = append(active, p)
+		case p.IsFailed():
+			failed = append(failed, p)
+		default:
+			succeeded = append(succeeded, p)
+			if p.Status.EndAt > cj.Status.LastSuccessfulAt {
+				cj.Status.LastSuccessfulAt = p.Status.EndAt
+			}
+		}
+	}
+
+	sortByCreateAt(active)
+	c.cleanHistory(succeeded, cj.HistoryLimit(false))
+	c.cleanHistory(failed, cj.HistoryLimit(true))
+	return active
+}
+
+func (c *Controller) cleanHistory(items []*pipeline.Pipeline, limit int) {
+	if len(items) <= limit {
+		return
+	}
+
+	sortByCreateAt(items)
+	for _, p := range items[:len(items)-limit] {
+		c.log.Infof("delete cronjob history pipeline %s", p.ShortDescribe())
+		if err := c.pipeline.Recorder().Delete(p); err != nil {
+			c.log.Errorf("delete cronjob history pipeline %s error, %s", p.ShortDescribe(), err)
+		}
+	}
+}
+
+// 按照创建时间从旧到新排序
+func sortByCreateAt(items []*pipeline.Pipeline) {
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].CreateAt < items[j].CreateAt
+	})
+}
+
+func activeNames(items []*pipeline.Pipeline) string {
+	names := make([]string, 0, len(items))
+	for i := range items {
+		names = append(names, items[i].Id)
+	}
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,84 @@
+package cronjob
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.etcd.io/etcd/client/v3/concurrency"
+
+	"github.com/infraboard/workflow/conf"
+	"github.com/infraboard/workflow/version"
+)
+
+const (
+	// leader的租约时间, 单位秒, leader宕机后最多这么久会重新选主
+	LEADER_LEASE_TTL = 15
+	// 竞选失败后的重试间隔
+	LEADER_RETRY_INTERVAL = 5 * time.Second
+)
+
+// LeaderElectionKey cronjob controller 选主使用的key
+func LeaderElectionKey() string {
+	return fmt.Sprintf("%s/%s/election/cronjob", conf.C().Etcd.Prefix, version.ServiceName)
+}
+
+// 一直竞选leader, 直到ctx结束, 失去leader后重新竞选
+func (c *Controller) runLeaderElection(ctx context.Context) {
+	for {
+		if err := c.campaign(ctx); err != nil {
+			c.log.Errorf("cronjob leader election error, %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(LEADER_RETRY_INTERVAL):
+		}
+	}
+}
+
+// 成为leader后开始调度, 直到租约失效或者ctx结束
+func (c *Controller) campaign(ctx context.Context) error {
+	session, err := concurrency.NewSession(c.client,
+		concurrency.WithTTL(LEADER_LEASE_TTL),
+		concurrency.WithContext(ctx),
+	)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	election := concurrency.NewElection(session, LeaderElectionKey())
+	c.log.Infof("%s campaign cronjob leader ...", c.schedulerName)
+	if err := election.Campaign(ctx, c.schedulerName); err != nil {
+		return err
+	}
+
+	c.log.Infof("%s become cronjob leader", c.schedulerName)
+	c.setLeader(true)
+	defer func() {
+		c.setLeader(false)
+		c.log.Infof("%s lose cronjob leader", c.schedulerName)
+	}()
+
+	if err := c.sync(ctx); err != nil {
+		c.log.Errorf("sync cronjob error, %s", err)
+	}
+
+	ticker := time.NewTicker(CRONJOB_RESYNC_INTERVAL)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			// 主动退出, 其他实例可以立即成为leader
+			resignCtx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+			defer cancel()
+			return election.Resign(resignCtx)
+		case <-session.Done():
+			return fmt.Errorf("leader session expired")
+		case <-ticker.C:
+			c.resync()
+		}
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/infraboard/workflow/api/apps/pipeline"
 	"github.com/infraboard/workflow/common/cache"
+	"github.com/infraboard/workflow/common/events"
 	"github.com/infraboard/workflow/scheduler/algorithm"
 	"github.com/infraboard/workflow/scheduler/algorithm/roundrobin"
 
@@ -35,6 +36,7 @@ func NewPipelineController(
 		step:           si,
 		workqueue:      wq,
 		workerNums:     4,
+		events:         events.NewBusPublisher(schedulerName),
 		log:            zap.L().Named("Pipeline"),
 		runningWorkers: make(map[string]struct{}, 4),
 	}
@@ -63,6 +65,7 @@ type Controller struct {
 	workqueue      workqueue.RateLimitingInterface
 	informer       informer.Informer
 	step           step.Informer
+	events         events.Publisher
 	log            logger.Logger
 	workerNums     int
 	runningWorkers map[string]struct{}
@@ -71,6 +74,26 @@ type Controller struct {
 	schedulerName  string
 }
 
+// SetEventPublisher 设置生命周期事件的发布者
+func (c *Controller) SetEventPublisher(p events.Publisher) {
+	c.events = p
+}
+
+// IsStepOwner pipeline创建的step, 由调度该pipeline的实例负责, 比如发布step的生命周期事件
+func (c *Controller) IsStepOwner(s *pipeline.Step) bool {
+	if !s.IsCreateByPipeline() {
+		return false
+	}
+
+	key := pipeline.PipeLineObjectKey(s.GetNamespace(), s.GetPipelineId())
+	obj, ok, err := c.informer.GetStore().GetByKey(key)
+	if err != nil || !ok {
+		return false
+	}
+	p, ok := obj.(*pipeline.Pipeline)
+	return ok && p.MatchScheduler(c.schedulerName)
+}
+
 // SetPicker 设置Node挑选器
 func (c *Controller) SetPipelinePicker(picker algorithm.PipelinePicker) {
 	c.picker = picker
@@ -106,6 +129,9 @@ func (c *Controller) run(ctx context.Context, async bool) error {
 		go c.runWorker(fmt.Sprintf("worker-%d", i))
 	}
 
+	// 检查运行超时的pipeline
+	go c.runTimeoutChecker(ctx)
+
 	if async {
 		go c.waitDown(ctx)
 	} else {
@@ -292,6 +318,11 @@ func (c *Controller) handleDelete(p *pipeline.Pipeline) {
 func (c *Controller) enqueueForUpdate(old, new *pipeline.Pipeline) {
 	c.log.Infof("receive update object: old: %s, new: %s", old.ShortDescribe(), new.ShortDescribe())
 
+	// 发布开始和结束事件, 只由调度该pipeline的实例发布, 避免多个调度器实例重复发布
+	if new.MatchScheduler(c.schedulerName) {
+		c.events.PublishPipeline(old, new)
+	}
+
 	// 已经处理完成的无需处理
 	if new.IsComplete() {
 		c.log.Debugf("skip run complete pipeline %s, status: %s", new.ShortDescribe(), new.Status.Status)
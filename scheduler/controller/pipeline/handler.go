@@ -53,6 +53,12 @@ func (c *Controller) runPipeline(p *pipeline.Pipeline) error {
 		return fmt.Errorf("skip run complete pipeline %s, status: %s", p.ShortDescribe(), p.Status.Status)
 	}
 
+	// 取消中的pipeline不再调度, 取消所有未完成的step
+	if p.IsCanceling() {
+		c.cancelPipeline(p)
+		return nil
+	}
+
 	// TODO: 使用分布式锁trylock处理 多个实例竞争调度问题
 
 	// 未调度的选进行调度后, 再处理
@@ -65,8 +71,15 @@ func (c *Controller) runPipeline(p *pipeline.Pipeline) error {
 
 	// 标记开始执行, 并更新保存
 	if !p.IsRunning() {
-		p.Run()
-		if err := c.informer.Recorder().Update(p); err != nil {
+		err := c.informer.Recorder().Modify(p, func(p *pipeline.Pipeline) (bool, error) {
+			// 重新读取后可能已经被取消或者结束
+			if p.IsRunning() || p.IsCanceling() || p.IsComplete() {
+				return false, nil
+			}
+			p.Run()
+			return true, nil
+		})
+		if err != nil {
 			c.log.Errorf("update pipeline %s start status to store error, %s", p.ShortDescribe(), err)
 		} else {
 			c.log.Debugf("update pipeline %s start status to store success", p.ShortDescribe())
@@ -80,12 +93,46 @@ func (c *Controller) runPipeline(p *pipeline.Pipeline) error {
 	return c.runPipelineNextStep(steps)
 }
 
+// 正在运行的step交给节点取消, 节点上报取消结果后再次处理, 直到所有step都结束
+// pipeline保存成功后再同步step, 避免版本冲突重试时同步了过期的step
+func (c *Controller) cancelPipeline(p *pipeline.Pipeline) {
+	var steps []*pipeline.Step
+	err := c.informer.Recorder().Modify(p, func(p *pipeline.Pipeline) (bool, error) {
+		var changed bool
+		steps, changed = p.CancelSteps()
+		return changed, nil
+	})
+	if err != nil {
+		c.log.Errorf("update canceling pipeline %s error, %s", p.ShortDescribe(), err)
+		return
+	}
+
+	for i := range steps {
+		if c.step == nil {
+			break
+		}
+		c.log.Debugf("cancel pipeline %s step %s, status: %s", p.ShortDescribe(), steps[i].Key, steps[i].Status.Status)
+		if err := c.step.Recorder().Update(steps[i].Clone()); err != nil {
+			c.log.Errorf("update canceled step %s error, %s", steps[i].Key, err)
+		}
+	}
+}
+
 func (c *Controller) nextStep(p *pipeline.Pipeline) []*pipeline.Step {
 	// 找出 pipeline 下次执行需要的step
 	steps, isComplete := p.NextStep()
 	if isComplete {
-		p.Complete()
-		if err := c.informer.Recorder().Update(p); err != nil {
+		err := c.informer.Recorder().Modify(p, func(p *pipeline.Pipeline) (bool, error) {
+			if p.IsComplete() {
+				return false, nil
+			}
+			if _, ok := p.NextStep(); !ok {
+				return false, nil
+			}
+			p.Complete()
+			return true, nil
+		})
+		if err != nil {
 			c.log.Errorf("update pipeline %s end status to store error, %s", p.ShortDescribe(), err)
 		} else {
 			c.log.Debugf("pipeline is complete, update pipeline status to db success")
@@ -93,8 +140,9 @@ func (c *Controller) nextStep(p *pipeline.Pipeline) []*pipeline.Step {
 		return nil
 	}
 
-	// 找出需要同步的step
-	needSync := []*pipeline.Step{}
+	// 找出需要同步的step, 其他step需要创建
+	// 多个stage并发时, 一次调度的step中可能同时包含这两种
+	needSync, needCreate := []*pipeline.Step{}, []*pipeline.Step{}
 	for i := range steps {
 		ins := steps[i]
 
@@ -107,6 +155,7 @@ func (c *Controller) nextStep(p *pipeline.Pipeline) []*pipeline.Step {
 
 		if old == nil {
 			c.log.Debugf("step %s not found in db", ins.Key)
+			needCreate = append(needCreate, ins)
 			continue
 		}
 
@@ -114,6 +163,7 @@ func (c *Controller) nextStep(p *pipeline.Pipeline) []*pipeline.Step {
 		if ins.Status.Status.Equal(old.Status.Status) {
 			c.log.Debugf("pipeline step status: %s, etcd step status: %s, has sync",
 				ins.Status.Status, old.Status.Status)
+			needCreate = append(needCreate, ins)
 			continue
 		}
 
@@ -122,19 +172,17 @@ func (c *Controller) nextStep(p *pipeline.Pipeline) []*pipeline.Step {
 
 	// 同步step到pipeline上
 	if len(needSync) > 0 {
-		for i := range needSync {
-			c.log.Debugf("sync step %s to pipeline ...", needSync[i].Key)
-			p.UpdateStep(needSync[i])
-		}
-		if err := c.informer.Recorder().Update(p); err != nil {
+		err := c.informer.Recorder().Modify(p, func(p *pipeline.Pipeline) (bool, error) {
+			return syncSteps(p, needSync...)
+		})
+		if err != nil {
 			c.log.Errorf("update pipeline status error, %s", err)
 			return nil
 		}
 		c.log.Debugf("sync %d steps ok", len(needSync))
-		return nil
 	}
 
-	return steps
+	return needCreate
 }
 
 func (c *Controller) runPipelineNextStep(steps []*pipeline.Step) error {
@@ -171,26 +219,18 @@ func (c *Controller) schedulePipeline(p *pipeline.Pipeline) error {
 	}
 
 	c.log.Debugf("choice scheduler %s for pipeline %s", node.InstanceName, p.Id)
-	p.SetScheduleNode(node.InstanceName)
-	c.updatePipelineStatus(p)
-	return nil
-}
-
-func (c *Controller) updatePipelineStatus(p *pipeline.Pipeline) {
-	if p == nil {
-		c.log.Errorf("update pipeline is nil")
-		return
-	}
-
-	if c.informer.Recorder() == nil {
-		c.log.Errorf("pipeline informer recorder missed")
-		return
-	}
-
-	// 清除一下其他数据
-	if err := c.informer.Recorder().Update(p); err != nil {
+	err = c.informer.Recorder().Modify(p, func(p *pipeline.Pipeline) (bool, error) {
+		// 已经被其他调度器调度或者已经结束
+		if p.IsScheduled() || p.IsComplete() {
+			return false, nil
+		}
+		p.SetScheduleNode(node.InstanceName)
+		return true, nil
+	})
+	if err != nil {
 		c.log.Errorf("update scheduled pipeline error, %s", err)
 	}
+	return nil
 }
 
 // step 如果完成后, 将状态记录到Pipeline上, 并删除step
@@ -221,28 +261,33 @@ func (c *Controller) UpdateStepCallback(old, new *pipeline.Step) {
 		return
 	}
 
-	current, err := p.GetStep(new.GetPipelineStageNumber(), new.Key)
+	// 只有状态变化才需要更新, 版本冲突时在最新的pipeline上重新同步
+	err = c.informer.Recorder().Modify(p, func(p *pipeline.Pipeline) (bool, error) {
+		return syncSteps(p, new)
+	})
 	if err != nil {
-		c.log.Errorf("get current step from pipeline error, %s", err)
-		return
-	}
-
-	// 判断状态是否变化, 只有状态变化才需要更新
-	if current.IsStatusEqual(new) {
-		c.log.Infof("status not changed, skip update, current: %s, target: %s",
-			current.Status, new.Status)
-		return
-	}
-
-	if err := p.UpdateStep(new); err != nil {
-		c.log.Errorf("update pipeline step error, %s", err)
-		return
-	}
-
-	if err := c.informer.Recorder().Update(p); err != nil {
 		c.log.Errorf("update pipeline status to store error, %s", err)
 		return
 	}
 
 	c.log.Debugf("update pipeline %s step %s success", p.ShortDescribe(), new.Key)
 }
+
+// syncSteps 将step的状态同步到pipeline上, 状态没有变化的step跳过, 返回pipeline是否有变化
+func syncSteps(p *pipeline.Pipeline, steps ...*pipeline.Step) (bool, error) {
+	changed := false
+	for _, s := range steps {
+		current, err := p.GetStep(s.GetPipelineStageNumber(), s.Key)
+		if err != nil {
+			return false, fmt.Errorf("get current step from pipeline error, %s", err)
+		}
+		if current.IsStatusEqual(s) {
+			continue
+		}
+		if err := p.UpdateStep(s); err != nil {
+			return false, fmt.Errorf("update pipeline step error, %s", err)
+		}
+		changed = true
+	}
+	return changed, nil
+}
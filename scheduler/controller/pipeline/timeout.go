@@ -0,0 +1,69 @@
+package pipeline
+
+import (
+	"context"
+	"time"
+
+	"github.com/infraboard/workflow/api/apps/pipeline"
+)
+
+const (
+	// 检查pipeline超时的间隔
+	TIMEOUT_CHECK_INTERVAL = 30 * time.Second
+)
+
+// 定期检查运行超时的pipeline
+func (c *Controller) runTimeoutChecker(ctx context.Context) {
+	ticker := time.NewTicker(TIMEOUT_CHECK_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.checkTimeout(ctx)
+		}
+	}
+}
+
+// 运行超时的pipeline, 结束所有未完成的step, 并标记pipeline完成
+func (c *Controller) checkTimeout(ctx context.Context) {
+	ps, err := c.informer.Lister().List(ctx, nil)
+	if err != nil {
+		c.log.Errorf("list pipeline for timeout check error, %s", err)
+		return
+	}
+
+	now := time.Now()
+	for i := range ps.Items {
+		p := ps.Items[i]
+		// 取消中的pipeline超时后也直接结束, 避免节点没有上报取消结果时一直处于取消中
+		if !p.MatchScheduler(c.schedulerName) || !(p.IsRunning() || p.IsCanceling()) || !p.IsTimeout(now) {
+			continue
+		}
+
+		c.log.Warnf("pipeline %s run timeout, timeout is %s", p.ShortDescribe(), p.TimeoutDuration())
+		var steps []*pipeline.Step
+		err := c.informer.Recorder().Modify(p, func(p *pipeline.Pipeline) (bool, error) {
+			if !(p.IsRunning() || p.IsCanceling()) || !p.IsTimeout(now) {
+				return false, nil
+			}
+			steps = p.MarkTimeout()
+			return true, nil
+		})
+		if err != nil {
+			c.log.Errorf("update timeout pipeline %s error, %s", p.ShortDescribe(), err)
+			continue
+		}
+
+		for j := range steps {
+			if c.step == nil {
+				break
+			}
+			if err := c.step.Recorder().Update(steps[j].Clone()); err != nil {
+				c.log.Errorf("update timeout step %s error, %s", steps[j].Key, err)
+			}
+		}
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/infraboard/workflow/api/apps/pipeline"
 	"github.com/infraboard/workflow/common/cache"
+	"github.com/infraboard/workflow/common/events"
 	"github.com/infraboard/workflow/common/hooks"
 	"github.com/infraboard/workflow/common/informers/step"
 	"github.com/infraboard/workflow/scheduler/algorithm"
@@ -34,6 +35,7 @@ func NewStepController(
 		workerNums:     4,
 		cb:             cb,
 		webhook:        hooks.NewDefaultStepWebHookPusher(),
+		events:         events.NewBusPublisher(schedulerName),
 		log:            zap.L().Named("Step"),
 		runningWorkers: make(map[string]bool, 4),
 	}
@@ -68,6 +70,8 @@ type Controller struct {
 	picker         algorithm.StepPicker
 	cb             step.UpdateStepCallback
 	webhook        hooks.StepWebHookPusher
+	events         events.Publisher
+	isStepOwner    func(*pipeline.Step) bool
 	schedulerName  string
 }
 
@@ -75,6 +79,20 @@ func (c *Controller) SetWebHookPusher(p hooks.StepWebHookPusher) {
 	c.webhook = p
 }
 
+// SetEventPublisher 设置生命周期事件的发布者
+func (c *Controller) SetEventPublisher(p events.Publisher) {
+	c.events = p
+}
+
+// SetStepOwner 设置判断当前实例是否负责该step的函数, 只有负责的实例发布事件和处理超时, 未设置时全部负责
+func (c *Controller) SetStepOwner(fn func(*pipeline.Step) bool) {
+	c.isStepOwner = fn
+}
+
+func (c *Controller) isOwner(s *pipeline.Step) bool {
+	return c.isStepOwner == nil || c.isStepOwner(s)
+}
+
 // SetPicker 设置Node挑选器
 func (c *Controller) SetStepPicker(picker algorithm.StepPicker) {
 	c.picker = picker
@@ -101,6 +119,9 @@ func (c *Controller) Run(ctx context.Context) error {
 		go c.runWorker(fmt.Sprintf("worker-%d", i))
 	}
 
+	// 兜底检查运行超时的step
+	go c.runTimeoutChecker(ctx)
+
 	c.waitDown(ctx)
 	return nil
 }
@@ -282,6 +303,11 @@ func (c *Controller) enqueueForUpdate(oldObj, newObj *pipeline.Step) {
 		c.log.Errorf("send web hook error, %s", err)
 	}
 
+	// 发布调度, 运行, 结束和审核事件, 只由负责该step的实例发布, 避免多个调度器实例重复发布
+	if c.isOwner(newObj) {
+		c.events.PublishStep(oldObj, newObj)
+	}
+
 	switch newObj.CreateType {
 	case pipeline.STEP_CREATE_BY_PIPELINE:
 		// 如果是pipeline创建的，将事件传递给pipeline
@@ -40,6 +40,18 @@ func (c *Controller) addStep(s *pipeline.Step) error {
 		return fmt.Errorf("invalidate node error, %s", err)
 	}
 
+	// 执行失败的任务, 按照重试策略重新调度
+	if s.IsRetryable() {
+		return c.retryStep(s)
+	}
+
+	// 等待重试的任务, 到时间后再调度
+	if wait := s.RetryWait(); wait > 0 && !s.IsScheduled() {
+		c.log.Debugf("step %s attempt %d will retry after %s", s.Key, s.Attempt(), wait)
+		c.workqueue.AddAfter(s.MakeObjectKey(), wait)
+		return nil
+	}
+
 	// 已经调度的任务不处理
 	if s.IsScheduled() {
 		return fmt.Errorf("step %s has schedule to node %s, skip add", s.Key, s.ScheduledNodeName())
@@ -90,10 +102,24 @@ func (c *Controller) isAllow(s *pipeline.Step) bool {
 	return false
 }
 
+// 保存本次执行记录, 重置状态后等待重新调度
+func (c *Controller) retryStep(s *pipeline.Step) error {
+	c.log.Infof("step %s attempt %d %s, %s, start retry ...",
+		s.Key, s.Attempt(), s.Status.Status, s.Status.Message)
+	s.NextAttempt()
+	if err := c.informer.Recorder().Update(s.Clone()); err != nil {
+		return fmt.Errorf("update retry step %s error, %s", s.Key, err)
+	}
+	return nil
+}
+
 // Step任务调度
 func (c *Controller) scheduleStep(step *pipeline.Step) error {
 	node, err := c.picker.Pick(step)
-	if err != nil || node == nil {
+	if err == nil && node == nil {
+		err = fmt.Errorf("no excutable node")
+	}
+	if err != nil {
 		c.log.Warnf("step %s pick node error, %s", step.Name, err)
 		step.ScheduleFailed(err.Error())
 		// 清除一下其他数据
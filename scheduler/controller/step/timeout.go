@@ -0,0 +1,52 @@
+package step
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// 检查step超时的间隔
+	TIMEOUT_CHECK_INTERVAL = 30 * time.Second
+	// node会在超时时结束step, 调度器多等待一段时间再处理, 用于兜底node异常退出的情况
+	TIMEOUT_GRACE_PERIOD = 1 * time.Minute
+)
+
+// 定期检查运行超时的step
+func (c *Controller) runTimeoutChecker(ctx context.Context) {
+	ticker := time.NewTicker(TIMEOUT_CHECK_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.checkTimeout(ctx)
+		}
+	}
+}
+
+// 运行超时的step, node可能已经没法更新状态了, 由负责该step的调度器标记为失败
+func (c *Controller) checkTimeout(ctx context.Context) {
+	steps, err := c.informer.Lister().List(ctx)
+	if err != nil {
+		c.log.Errorf("list step for timeout check error, %s", err)
+		return
+	}
+
+	t := time.Now().Add(-TIMEOUT_GRACE_PERIOD)
+	for i := range steps {
+		s := steps[i]
+		if !c.isOwner(s) || !s.IsRunning() || !s.IsTimeout(t) {
+			continue
+		}
+
+		c.log.Warnf("step %s run timeout on node %s, mark failed", s.Key, s.ScheduledNodeName())
+		s.Failed("step run timeout, timeout is %s, node %s not response",
+			s.TimeoutDuration(), s.ScheduledNodeName())
+		if err := c.informer.Recorder().Update(s.Clone()); err != nil {
+			c.log.Errorf("update timeout step %s error, %s", s.Key, err)
+		}
+	}
+}